@@ -0,0 +1,18 @@
+resource "thecloud_vpc" "main" {
+  name = "main-vpc"
+}
+
+# Replace the platform's allow-all egress rule with an explicit policy.
+resource "thecloud_default_security_group" "main" {
+  vpc_id = thecloud_vpc.main.id
+
+  rule = [
+    {
+      direction = "egress"
+      protocol  = "tcp"
+      port_min  = 443
+      port_max  = 443
+      cidr      = "0.0.0.0/0"
+    },
+  ]
+}
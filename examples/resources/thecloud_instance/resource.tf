@@ -7,4 +7,18 @@ resource "thecloud_instance" "web" {
   image  = "ubuntu-22.04"
   vpc_id = thecloud_vpc.main.id
   ports  = "80:80,443:443"
+
+  labels = {
+    team = "web"
+  }
+}
+
+resource "thecloud_instance" "worker" {
+  name_prefix = "worker-"
+  image       = "ubuntu-22.04"
+  vpc_id      = thecloud_vpc.main.id
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
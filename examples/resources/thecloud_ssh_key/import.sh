@@ -0,0 +1,2 @@
+# SSH keys are imported by name
+terraform import thecloud_ssh_key.deploy deploy
@@ -0,0 +1,10 @@
+resource "thecloud_ssh_key" "deploy" {
+  name       = "deploy"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
+
+resource "thecloud_instance" "web" {
+  name         = "web-server"
+  image        = "ubuntu-22.04"
+  ssh_key_name = thecloud_ssh_key.deploy.name
+}
@@ -0,0 +1,99 @@
+// Package apierror turns errors returned by the API client into diagnostics shared by
+// the provider's resources and data sources.
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const (
+	errClient = "Client Error"
+)
+
+// apiErrorHint turns a structured API error into an actionable diagnostic.
+type apiErrorHint struct {
+	summary string
+	hint    string
+}
+
+// apiErrorCodeHints maps machine-readable API error codes to diagnostics.
+var apiErrorCodeHints = map[string]apiErrorHint{
+	"QUOTA_EXCEEDED": {
+		summary: "Quota Exceeded",
+		hint:    "The account has reached its quota for this resource. Remove unused resources or contact The Cloud support to raise the limit.",
+	},
+	"CIDR_OVERLAP": {
+		summary: "CIDR Overlap",
+		hint:    "The CIDR block overlaps an existing network. Choose a range that does not overlap other VPCs or subnets.",
+	},
+	"INVALID_CIDR": {
+		summary: "Invalid CIDR Block",
+		hint:    "Check that the CIDR block is valid and falls within the parent network's range.",
+	},
+	"ALREADY_EXISTS": {
+		summary: "Resource Already Exists",
+		hint:    "A resource with the same name already exists. Choose a different name or import the existing resource.",
+	},
+	"RESOURCE_IN_USE": {
+		summary: "Resource In Use",
+		hint:    "The resource is still referenced by other resources. Remove the dependent resources first.",
+	},
+	"RATE_LIMITED": {
+		summary: "Rate Limited",
+		hint:    "The API is throttling requests. Reduce parallelism or raise the provider's max_retries and retry_wait_max.",
+	},
+}
+
+// apiErrorStatusHints is used when the API error carries no known code.
+var apiErrorStatusHints = map[int]apiErrorHint{
+	http.StatusUnauthorized: {
+		summary: "Authentication Failed",
+		hint:    "Check the provider's api_key or the THECLOUD_API_KEY environment variable.",
+	},
+	http.StatusForbidden: {
+		summary: "Permission Denied",
+		hint:    "The API key is not allowed to perform this operation.",
+	},
+	http.StatusNotFound: {
+		summary: "Parent Resource Not Found",
+		hint:    "The API could not find a resource this request refers to. Check that referenced IDs (e.g. vpc_id, security_group_id) point to existing resources.",
+	},
+	http.StatusConflict: {
+		summary: "Resource Conflict",
+		hint:    "The resource is in a state that does not allow this operation. Wait for pending operations to finish and try again.",
+	},
+	http.StatusPreconditionFailed: {
+		summary: "Resource Modified Outside Terraform",
+		hint:    "The resource was modified outside Terraform since the last refresh. Run terraform refresh and retry.",
+	},
+	http.StatusTooManyRequests: apiErrorCodeHints["RATE_LIMITED"],
+}
+
+// AddClientError reports a failed API call. Structured API errors with a known code or
+// status get a specific summary and hint; anything else is reported as a client error.
+func AddClientError(diags *diag.Diagnostics, action string, err error) {
+	detail := fmt.Sprintf("%s, got error: %s", action, err)
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(errClient, detail)
+		return
+	}
+
+	hint, ok := apiErrorCodeHints[strings.ToUpper(apiErr.Code)]
+	if !ok {
+		hint, ok = apiErrorStatusHints[apiErr.Status]
+	}
+	if !ok {
+		diags.AddError(errClient, detail)
+		return
+	}
+
+	diags.AddError(hint.summary, detail+"\n\n"+hint.hint)
+}
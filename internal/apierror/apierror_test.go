@@ -0,0 +1,60 @@
+package apierror
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddClientError(t *testing.T) {
+	tests := map[string]struct {
+		err     error
+		summary string
+		hint    string
+	}{
+		"plain error": {
+			err:     errors.New("connection refused"),
+			summary: errClient,
+		},
+		"known code": {
+			err:     &client.APIError{Status: http.StatusUnprocessableEntity, Code: "CIDR_OVERLAP", Message: "cidr overlaps"},
+			summary: "CIDR Overlap",
+			hint:    "does not overlap",
+		},
+		"code takes precedence over status": {
+			err:     &client.APIError{Status: http.StatusForbidden, Code: "quota_exceeded", Message: "too many vpcs"},
+			summary: "Quota Exceeded",
+			hint:    "contact The Cloud support",
+		},
+		"not found on create": {
+			err:     &client.APIError{Status: http.StatusNotFound, Message: "vpc not found"},
+			summary: "Parent Resource Not Found",
+			hint:    "vpc_id",
+		},
+		"modified since last read": {
+			err:     &client.APIError{Status: http.StatusPreconditionFailed, Message: "etag mismatch"},
+			summary: "Resource Modified Outside Terraform",
+			hint:    "terraform refresh",
+		},
+		"unknown code": {
+			err:     &client.APIError{Status: http.StatusInternalServerError, Code: "BOOM", Message: "boom"},
+			summary: errClient,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			AddClientError(&diags, "Unable to create VPC", tt.err)
+
+			assert.Len(t, diags, 1)
+			assert.Equal(t, tt.summary, diags[0].Summary())
+			assert.Contains(t, diags[0].Detail(), "Unable to create VPC, got error: ")
+			assert.Contains(t, diags[0].Detail(), tt.hint)
+		})
+	}
+}
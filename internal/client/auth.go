@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithBearerToken authenticates with a bearer token instead of an API key
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.Token = token
+	}
+}
+
+// WithAPIKeyFile records the file the API key was read from. The file is read again
+// when the API answers 401 so that rotated keys are picked up without a restart.
+func WithAPIKeyFile(path string) Option {
+	return func(c *Client) {
+		c.apiKeyFile = path
+	}
+}
+
+// ReadAPIKeyFile reads an API key from a file, ignoring surrounding whitespace.
+func ReadAPIKeyFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// setAuth adds the credential headers to a request. A bearer token takes precedence
+// over an API key.
+func (c *Client) setAuth(req *http.Request) {
+	c.credMu.RLock()
+	defer c.credMu.RUnlock()
+
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+		return
+	}
+	req.Header.Set("X-API-Key", c.APIKey)
+}
+
+// reloadAPIKey re-reads the API key file and reports whether the key changed.
+func (c *Client) reloadAPIKey(ctx context.Context) bool {
+	if c.apiKeyFile == "" || c.Token != "" {
+		return false
+	}
+
+	key, err := ReadAPIKeyFile(c.apiKeyFile)
+	if err != nil {
+		tflog.Warn(ctx, "unable to re-read API key file", map[string]interface{}{"path": c.apiKeyFile, "error": err.Error()})
+		return false
+	}
+
+	c.credMu.Lock()
+	defer c.credMu.Unlock()
+
+	if key == "" || key == c.APIKey {
+		return false
+	}
+
+	tflog.Debug(ctx, "API key file changed, retrying with the new key", map[string]interface{}{"path": c.apiKeyFile})
+	c.APIKey = key
+
+	return true
+}
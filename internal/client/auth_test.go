@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientAPIKeyHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testKey, r.Header.Get("X-API-Key"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.DeleteVPC(context.Background(), testVpcID))
+}
+
+func TestClientBearerTokenHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ci-token", r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-API-Key"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "", WithBearerToken("ci-token"))
+	assert.NoError(t, c.DeleteVPC(context.Background(), testVpcID))
+}
+
+func TestClientReloadsAPIKeyFileOnUnauthorized(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("old-key\n"), 0o600))
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-API-Key"))
+		if r.Header.Get("X-API-Key") != "new-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	key, err := ReadAPIKeyFile(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "old-key", key)
+
+	c := NewClient(server.URL, key, WithAPIKeyFile(keyFile))
+
+	// The file hasn't changed, so the 401 is returned as is
+	assert.Error(t, c.DeleteVPC(context.Background(), testVpcID))
+	assert.Equal(t, []string{"old-key"}, keys)
+
+	// After rotation the request is retried once with the new key
+	keys = nil
+	assert.NoError(t, os.WriteFile(keyFile, []byte("new-key\n"), 0o600))
+	assert.NoError(t, c.DeleteVPC(context.Background(), testVpcID))
+	assert.Equal(t, []string{"old-key", "new-key"}, keys)
+	assert.Equal(t, "new-key", c.APIKey)
+}
+
+func TestClientReloadsAPIKeyFileForUploads(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("new-key\n"), 0o600))
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-API-Key"))
+		if r.Header.Get("X-API-Key") != "new-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// The retried request carries the whole form again
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "fn", r.FormValue("name"))
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": "fn-1"}}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "old-key", WithAPIKeyFile(keyFile))
+
+	fn, err := c.CreateFunction(context.Background(), "fn", "nodejs20", "index.handler", []byte("zip"))
+	assert.NoError(t, err)
+	assert.Equal(t, "fn-1", fn.ID)
+	assert.Equal(t, []string{"old-key", "new-key"}, keys)
+}
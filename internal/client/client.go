@@ -4,87 +4,331 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"mime/multipart"
+	"net"
 	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
 )
 
 const (
 	errUnexpectedStatus = "unexpected status code: %d"
 )
 
+// ErrNotFound is matched by errors for resources the API doesn't know about, e.g.
+// errors.Is(err, client.ErrNotFound).
+var ErrNotFound = errors.New("resource not found")
+
 // APIError represents the structured error from the API
 type APIError struct {
+	Status  int    `json:"status,omitempty"`
 	Type    string `json:"type"`
 	Message string `json:"message"`
 	Code    string `json:"code"`
 }
 
+// Is lets errors.Is match an API error reporting a missing resource against ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && (e.Status == http.StatusNotFound || strings.EqualFold(e.Code, "NOT_FOUND"))
+}
+
 func (e *APIError) Error() string {
-	return fmt.Sprintf("[%s] %s (code: %s)", e.Type, e.Message, e.Code)
+	msg := fmt.Sprintf("[%d] %s", e.Status, e.Message)
+	if e.Code != "" {
+		msg += fmt.Sprintf(" (code: %s)", e.Code)
+	}
+	return msg
 }
 
-// APIResponse wraps the standard API response structure
+// APIResponse wraps the standard API response structure. List responses are paginated,
+// NextPage is the cursor of the next page and empty on the last one.
 type APIResponse struct {
-	Data  json.RawMessage `json:"data,omitempty"`
-	Error *APIError       `json:"error,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+	Error    *APIError       `json:"error,omitempty"`
+	NextPage string          `json:"next_page,omitempty"`
 }
 
 // Client is the base structure for interacting with The Cloud API
 type Client struct {
 	Endpoint   string
 	APIKey     string
+	Token      string
 	HTTPClient *http.Client
+
+	apiKeyFile string
+	credMu     sync.RWMutex
+
+	standbys       []string
+	activeEndpoint atomic.Int32
+
+	defaultAvailabilityZone string
+	logsInErrors            bool
+
+	retryMax        int
+	retryWaitMin    time.Duration
+	retryWaitMax    time.Duration
+	retryMaxElapsed time.Duration
+	requestTimeout  time.Duration
+
+	logRequests bool
+	logBodies   bool
+
+	limiter *rate.Limiter
+
+	userAgent    string
+	extraHeaders map[string]string
+	tenantID     string
+
+	consistencyRetries int
+}
+
+// Option customizes a Client created by NewClient
+type Option func(*Client)
+
+// WithRetryMax sets the maximum number of retries for a single request
+func WithRetryMax(retryMax int) Option {
+	return func(c *Client) {
+		c.retryMax = retryMax
+	}
+}
+
+// WithRetryWait sets the minimum and maximum wait between retries
+func WithRetryWait(min, max time.Duration) Option {
+	return func(c *Client) {
+		c.retryWaitMin = min
+		c.retryWaitMax = max
+	}
+}
+
+// WithRetryMaxElapsed caps the total time spent retrying a single request
+func WithRetryMaxElapsed(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryMaxElapsed = d
+	}
+}
+
+// WithRequestTimeout bounds each individual request attempt
+func WithRequestTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.requestTimeout = d
+	}
+}
+
+// NormalizeEndpoint validates that the endpoint is an absolute http(s) URL and strips
+// trailing slashes so that paths can be joined onto it safely.
+func NormalizeEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid endpoint %q: missing host", endpoint)
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid endpoint %q: query and fragment are not allowed", endpoint)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
 }
 
 // NewClient creates a new API client for The Cloud
-func NewClient(endpoint, apiKey string) *Client {
+func NewClient(endpoint, apiKey string, opts ...Option) *Client {
+	if normalized, err := NormalizeEndpoint(endpoint); err == nil {
+		endpoint = normalized
+	}
+
+	c := &Client{
+		Endpoint:        endpoint,
+		APIKey:          apiKey,
+		retryMax:        5,
+		retryWaitMin:    1 * time.Second,
+		retryWaitMax:    30 * time.Second,
+		retryMaxElapsed: 5 * time.Minute,
+		userAgent:       userAgentProduct,
+
+		consistencyRetries: defaultConsistencyRetries,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 5
-	retryClient.RetryWaitMin = 1 * time.Second
-	retryClient.RetryWaitMax = 30 * time.Second
+	retryClient.RetryMax = c.retryMax
+	retryClient.RetryWaitMin = c.retryWaitMin
+	retryClient.RetryWaitMax = c.retryWaitMax
+	retryClient.CheckRetry = c.checkRetry
+	retryClient.Backoff = backoff
+	// Hand the last response back to the caller so API errors are still decoded
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
 	retryClient.Logger = nil
+	retryClient.HTTPClient.Timeout = c.requestTimeout
+
+	if t, ok := retryClient.HTTPClient.Transport.(*http.Transport); ok {
+		t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	}
+
+	if c.limiter != nil {
+		retryClient.HTTPClient.Transport = &rateLimitTransport{
+			next:    retryClient.HTTPClient.Transport,
+			limiter: c.limiter,
+		}
+	}
 
-	return &Client{
-		Endpoint:   endpoint,
-		APIKey:     apiKey,
-		HTTPClient: retryClient.StandardClient(),
+	if c.logRequests {
+		retryClient.HTTPClient.Transport = &loggingTransport{
+			next:      retryClient.HTTPClient.Transport,
+			logBodies: c.logBodies,
+			// Extra headers may carry proxy credentials
+			sensitiveHeaders: slices.Collect(maps.Keys(c.extraHeaders)),
+		}
+	}
+
+	if len(c.standbys) > 0 {
+		retryClient.HTTPClient.Transport = &failoverTransport{
+			next:   retryClient.HTTPClient.Transport,
+			client: c,
+		}
 	}
+
+	c.HTTPClient = retryClient.StandardClient()
+
+	return c
 }
 
 func (c *Client) BuildURL(path string) string {
-	return fmt.Sprintf("%s%s", c.Endpoint, path)
+	// url.JoinPath would escape the query separator, so join only the path part
+	p, query, hasQuery := strings.Cut(path, "?")
+
+	endpoint := c.currentEndpoint()
+
+	u, err := url.JoinPath(endpoint, p)
+	if err != nil {
+		u = fmt.Sprintf("%s%s", strings.TrimRight(endpoint, "/"), p)
+	}
+
+	if hasQuery {
+		u += "?" + query
+	}
+
+	return u
 }
 
 func (c *Client) do(ctx context.Context, method, path string, body interface{}, v interface{}) (int, error) {
+	status, err := c.doOnce(ctx, method, path, body, v)
+	if status == http.StatusUnauthorized && c.reloadAPIKey(ctx) {
+		return c.doOnce(ctx, method, path, body, v)
+	}
+	return status, err
+}
+
+// newRequest builds an API request with a JSON body, when there is one.
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		bodyReader = bytes.NewBuffer(b)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.BuildURL(path), bodyReader)
+	return c.newRequestWithBody(ctx, method, path, "application/json", bodyReader)
+}
+
+// newRequestWithBody builds an API request sending body as contentType.
+func (c *Client) newRequestWithBody(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(withRetryStart(ctx), method, c.BuildURL(path), body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setAuth(req)
+	req.Header.Set("Content-Type", contentType)
+	if etag := ifMatchFromContext(ctx); etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+	c.setHeaders(req)
+
+	return req, nil
+}
+
+// doUpload is do for bodies that aren't JSON, such as multipart forms and binary parts.
+// The response is decoded into v unless it is nil.
+func (c *Client) doUpload(ctx context.Context, method, path, contentType string, body []byte, v interface{}) error {
+	status, err := c.doUploadOnce(ctx, method, path, contentType, body, v)
+	if status == http.StatusUnauthorized && c.reloadAPIKey(ctx) {
+		_, err = c.doUploadOnce(ctx, method, path, contentType, body, v)
+	}
+	return err
+}
+
+func (c *Client) doUploadOnce(ctx context.Context, method, path, contentType string, body []byte, v interface{}) (int, error) {
+	req, err := c.newRequestWithBody(ctx, method, path, contentType, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return 0, err
 	}
+	defer func() {
+		io.Copy(io.Discard, resp.Body) // nolint:errcheck
+		resp.Body.Close()              // nolint:errcheck
+	}()
+
+	if resp.StatusCode >= 400 {
+		return resp.StatusCode, c.handleError(resp)
+	}
+
+	if v != nil {
+		if err := c.decodeResponse(resp, v); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
 
-	req.Header.Set("X-API-Key", c.APIKey)
-	req.Header.Set("Content-Type", "application/json")
+func (c *Client) doOnce(ctx context.Context, method, path string, body interface{}, v interface{}) (int, error) {
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return 0, err
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return 0, err
 	}
-	defer resp.Body.Close() // nolint:errcheck
+	defer func() {
+		// Drain what's left of the body so the connection can be reused
+		io.Copy(io.Discard, resp.Body) // nolint:errcheck
+		resp.Body.Close()              // nolint:errcheck
+	}()
 
-	if resp.StatusCode == http.StatusNotFound {
+	// A missing resource is reported through the status code for reads and deletes.
+	// Anywhere else a 404 means the request itself targeted something that doesn't exist.
+	if resp.StatusCode == http.StatusNotFound && (method == http.MethodGet || method == http.MethodDelete) {
 		return resp.StatusCode, nil
 	}
 
@@ -96,11 +340,73 @@ func (c *Client) do(ctx context.Context, method, path string, body interface{},
 		if err := c.decodeResponse(resp, v); err != nil {
 			return resp.StatusCode, err
 		}
+		if e, ok := v.(etagSetter); ok {
+			e.setETag(resp.Header.Get("ETag"))
+		}
 	}
 
 	return resp.StatusCode, nil
 }
 
+// delete deletes the resource at path. A resource that is already gone counts as deleted,
+// so destroys succeed when something was removed outside Terraform.
+func (c *Client) delete(ctx context.Context, path string) error {
+	_, err := c.do(ctx, "DELETE", path, nil, nil)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// rawResponse is a successful response whose body is returned as is rather than
+// decoded from the JSON envelope.
+type rawResponse struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// doRaw is do for endpoints that answer with plain text instead of the JSON envelope.
+// Error responses are still parsed as envelopes, and a 404 on a read is reported
+// through the status code like in do.
+func (c *Client) doRaw(ctx context.Context, method, path string) (*rawResponse, error) {
+	res, err := c.doRawOnce(ctx, method, path)
+	if res != nil && res.Status == http.StatusUnauthorized && c.reloadAPIKey(ctx) {
+		return c.doRawOnce(ctx, method, path)
+	}
+	return res, err
+}
+
+func (c *Client) doRawOnce(ctx context.Context, method, path string) (*rawResponse, error) {
+	req, err := c.newRequest(ctx, method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain, application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	res := &rawResponse{Status: resp.StatusCode, Header: resp.Header}
+
+	if resp.StatusCode == http.StatusNotFound && (method == http.MethodGet || method == http.MethodDelete) {
+		return res, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return res, c.handleError(resp)
+	}
+
+	res.Body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return res, fmt.Errorf("failed to read response: %w", err)
+	}
+	return res, nil
+}
+
 func (c *Client) handleError(resp *http.Response) error {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -109,18 +415,25 @@ func (c *Client) handleError(resp *http.Response) error {
 	resp.Body = io.NopCloser(bytes.NewBuffer(body))
 
 	var apiResp struct {
-		Error interface{} `json:"error"`
-	}
-	if err := json.Unmarshal(body, &apiResp); err == nil && apiResp.Error != nil {
-		switch v := apiResp.Error.(type) {
-		case string:
-			return fmt.Errorf("[%d] %s", resp.StatusCode, v)
-		case map[string]interface{}:
-			if msg, ok := v["message"].(string); ok {
-				return fmt.Errorf("[%d] %s", resp.StatusCode, msg)
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &apiResp); err == nil && len(apiResp.Error) > 0 {
+		var msg string
+		if err := json.Unmarshal(apiResp.Error, &msg); err == nil {
+			return &APIError{Status: resp.StatusCode, Message: msg}
+		}
+
+		var apiErr APIError
+		if err := json.Unmarshal(apiResp.Error, &apiErr); err == nil && (apiErr.Message != "" || apiErr.Code != "") {
+			if apiErr.Status == 0 {
+				apiErr.Status = resp.StatusCode
 			}
+			return &apiErr
 		}
 	}
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf(errUnexpectedStatus+": %w", resp.StatusCode, ErrNotFound)
+	}
 	return fmt.Errorf(errUnexpectedStatus, resp.StatusCode)
 }
 
@@ -131,6 +444,9 @@ func (c *Client) decodeResponse(resp *http.Response, v interface{}) error {
 	}
 
 	if apiResp.Error != nil {
+		if apiResp.Error.Status == 0 {
+			apiResp.Error.Status = resp.StatusCode
+		}
 		return apiResp.Error
 	}
 
@@ -139,6 +455,9 @@ func (c *Client) decodeResponse(resp *http.Response, v interface{}) error {
 			return fmt.Errorf("failed to unmarshal data: %w", err)
 		}
 	}
+	if p, ok := v.(pageSetter); ok {
+		p.setNextPage(apiResp.NextPage)
+	}
 
 	return nil
 }
@@ -149,6 +468,10 @@ type VPC struct {
 	Name      string `json:"name"`
 	CIDRBlock string `json:"cidr_block"`
 	Status    string `json:"status"`
+
+	// The platform creates a default security group and subnet along with every VPC
+	DefaultSecurityGroupID string `json:"default_security_group_id,omitempty"`
+	DefaultSubnetID        string `json:"default_subnet_id,omitempty"`
 }
 
 func (c *Client) CreateVPC(ctx context.Context, name, cidr string) (*VPC, error) {
@@ -181,28 +504,94 @@ func (c *Client) GetVPC(ctx context.Context, id string) (*VPC, error) {
 }
 
 func (c *Client) DeleteVPC(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/vpcs/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/vpcs/%s", id))
 }
 
-// Instance represents the API response for an Instance
-type Instance struct {
+// VPCPeering represents the API response for a VPC peering connection
+type VPCPeering struct {
 	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Image     string `json:"image"`
-	Ports     string `json:"ports"`
 	VpcID     string `json:"vpc_id"`
-	SubnetID  string `json:"subnet_id"`
+	PeerVpcID string `json:"peer_vpc_id"`
 	Status    string `json:"status"`
-	IPAddress string `json:"ip_address"`
+}
+
+func (c *Client) CreateVPCPeering(ctx context.Context, vpcID, peerVpcID string) (*VPCPeering, error) {
+	payload := map[string]string{
+		"vpc_id":      vpcID,
+		"peer_vpc_id": peerVpcID,
+	}
+
+	var peering VPCPeering
+	_, err := c.do(ctx, "POST", "/vpc-peerings", payload, &peering)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering, nil
+}
+
+func (c *Client) GetVPCPeering(ctx context.Context, id string) (*VPCPeering, error) {
+	var peering VPCPeering
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/vpc-peerings/%s", id), nil, &peering)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &peering, nil
+}
+
+// AcceptVPCPeering accepts a peering connection on behalf of the peer VPC.
+func (c *Client) AcceptVPCPeering(ctx context.Context, id string) (*VPCPeering, error) {
+	var peering VPCPeering
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/vpc-peerings/%s/accept", id), nil, &peering)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peering, nil
+}
+
+func (c *Client) DeleteVPCPeering(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/vpc-peerings/%s", id))
+}
+
+// Instance represents the API response for an Instance
+type Instance struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Image            string   `json:"image"`
+	Ports            string   `json:"ports"`
+	VpcID            string   `json:"vpc_id"`
+	SubnetID         string   `json:"subnet_id"`
+	InstanceSize     string   `json:"instance_size,omitempty"`
+	GPUType          string   `json:"gpu_type,omitempty"`
+	GPUCount         int      `json:"gpu_count,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+	SecurityGroupIDs []string `json:"security_group_ids,omitempty"`
+	SSHKeyName       string   `json:"ssh_key_name,omitempty"`
+	Status           string   `json:"status"`
+	IPAddress        string   `json:"ip_address"`
+
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 type LaunchInstanceRequest struct {
-	Name     string `json:"name"`
-	Image    string `json:"image"`
-	Ports    string `json:"ports"`
-	VpcID    string `json:"vpc_id"`
-	SubnetID string `json:"subnet_id"`
+	Name             string   `json:"name"`
+	Image            string   `json:"image"`
+	Ports            string   `json:"ports"`
+	VpcID            string   `json:"vpc_id"`
+	SubnetID         string   `json:"subnet_id"`
+	InstanceSize     string   `json:"instance_size,omitempty"`
+	GPUType          string   `json:"gpu_type,omitempty"`
+	GPUCount         int      `json:"gpu_count,omitempty"`
+	SecurityGroupIDs []string `json:"security_group_ids,omitempty"`
+	SSHKeyName       string   `json:"ssh_key_name,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 func (c *Client) CreateInstance(ctx context.Context, reqBody LaunchInstanceRequest) (*Instance, error) {
@@ -229,24 +618,102 @@ func (c *Client) GetInstance(ctx context.Context, id string) (*Instance, error)
 	return &instance, nil
 }
 
+// SetInstanceLabels replaces all labels of an instance. An empty map removes them.
+func (c *Client) SetInstanceLabels(ctx context.Context, id string, labels map[string]string) (*Instance, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	payload := map[string]interface{}{"labels": labels}
+
+	var instance Instance
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/instances/%s", id), payload, &instance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instance, nil
+}
+
 func (c *Client) DeleteInstance(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/instances/%s", id), nil, nil)
+	return c.delete(ctx, fmt.Sprintf("/instances/%s", id))
+}
+
+func (c *Client) StopInstance(ctx context.Context, id string) error {
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/instances/%s/stop", id), nil, nil)
 	return err
 }
 
+func (c *Client) StartInstance(ctx context.Context, id string) error {
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/instances/%s/start", id), nil, nil)
+	return err
+}
+
+func (c *Client) AttachInstanceSecurityGroup(ctx context.Context, id, securityGroupID string) error {
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/instances/%s/security-groups/%s", id, securityGroupID), nil, nil)
+	return err
+}
+
+func (c *Client) DetachInstanceSecurityGroup(ctx context.Context, id, securityGroupID string) error {
+	return c.delete(ctx, fmt.Sprintf("/instances/%s/security-groups/%s", id, securityGroupID))
+}
+
+// ConsoleOutput is the tail of an instance's serial console.
+type ConsoleOutput struct {
+	Output     string
+	CapturedAt time.Time
+}
+
+// GetInstanceConsoleOutput returns the last lines of the instance's serial console.
+// The API answers with plain text, and dates the capture with the response's Date
+// header. An instance that isn't running is rejected with a 409.
+func (c *Client) GetInstanceConsoleOutput(ctx context.Context, id string, lines int) (*ConsoleOutput, error) {
+	res, err := c.doRaw(ctx, "GET", fmt.Sprintf("/instances/%s/console?lines=%d", id, lines))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	capturedAt, err := http.ParseTime(res.Header.Get("Date"))
+	if err != nil {
+		capturedAt = time.Now()
+	}
+
+	return &ConsoleOutput{
+		Output:     lastLines(string(res.Body), lines),
+		CapturedAt: capturedAt.UTC(),
+	}, nil
+}
+
+// lastLines returns the last n lines of s, in case the API returned more than asked.
+func lastLines(s string, n int) string {
+	trimmed := strings.TrimSuffix(s, "\n")
+	lines := strings.Split(trimmed, "\n")
+	if n <= 0 || len(lines) <= n {
+		return s
+	}
+	return strings.Join(lines[len(lines)-n:], "\n") + strings.TrimPrefix(s, trimmed)
+}
+
 // Volume represents the API response for a Volume
 type Volume struct {
-	ID     string `json:"id"`
-	Name   string `json:"name"`
-	SizeGB int    `json:"size_gb"`
-	Status string `json:"status"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	SizeGB           int    `json:"size_gb"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+	Status           string `json:"status"`
 }
 
-func (c *Client) CreateVolume(ctx context.Context, name string, sizeGB int) (*Volume, error) {
+func (c *Client) CreateVolume(ctx context.Context, name string, sizeGB int, az string) (*Volume, error) {
 	payload := map[string]interface{}{
 		"name":    name,
 		"size_gb": sizeGB,
 	}
+	if az != "" {
+		payload["availability_zone"] = az
+	}
 
 	var vol Volume
 	_, err := c.do(ctx, "POST", "/volumes", payload, &vol)
@@ -272,12 +739,13 @@ func (c *Client) GetVolume(ctx context.Context, id string) (*Volume, error) {
 }
 
 func (c *Client) DeleteVolume(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/volumes/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/volumes/%s", id))
 }
 
 // SecurityGroup represents the API response for a Security Group
 type SecurityGroup struct {
+	Versioned
+
 	ID          string         `json:"id"`
 	VPCID       string         `json:"vpc_id"`
 	Name        string         `json:"name"`
@@ -327,9 +795,29 @@ func (c *Client) GetSecurityGroup(ctx context.Context, id string) (*SecurityGrou
 	return &sg, nil
 }
 
+func (c *Client) ListSecurityGroups(ctx context.Context, filters ...ListFilter) ([]SecurityGroup, error) {
+	return listFiltered(ctx, c, "/security-groups", filters, func(sg SecurityGroup) filterFields {
+		return filterFields{name: sg.Name, vpcID: sg.VPCID}
+	})
+}
+
+// GetDefaultSecurityGroup returns the security group the platform created alongside a VPC
+func (c *Client) GetDefaultSecurityGroup(ctx context.Context, vpcID string) (*SecurityGroup, error) {
+	var sg SecurityGroup
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/vpcs/%s/default-sg", vpcID), nil, &sg)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &sg, nil
+}
+
 func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/security-groups/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/security-groups/%s", id))
 }
 
 func (c *Client) AddSecurityRule(ctx context.Context, groupID string, rule SecurityRule) (*SecurityRule, error) {
@@ -343,8 +831,7 @@ func (c *Client) AddSecurityRule(ctx context.Context, groupID string, rule Secur
 }
 
 func (c *Client) RemoveSecurityRule(ctx context.Context, ruleID string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/security-groups/rules/%s", ruleID), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/security-groups/rules/%s", ruleID))
 }
 
 // LoadBalancer represents the API response for a Load Balancer
@@ -355,23 +842,36 @@ type LoadBalancer struct {
 	Port      int        `json:"port"`
 	Algorithm string     `json:"algorithm"`
 	Status    string     `json:"status"`
+	TLS       *LBTLS     `json:"tls,omitempty"`
 	Targets   []LBTarget `json:"targets,omitempty"`
 }
 
+// LBTLS describes the TLS listener of a Load Balancer. Without it the load balancer
+// serves plaintext on its port.
+type LBTLS struct {
+	CertificateID string `json:"certificate_id"`
+	MinTLSVersion string `json:"min_tls_version,omitempty"`
+}
+
 // LBTarget represents a target within a Load Balancer
 type LBTarget struct {
 	InstanceID string `json:"instance_id"`
 	Port       int    `json:"port"`
 	Weight     int    `json:"weight"`
+	Healthy    bool   `json:"healthy,omitempty"`
+	Status     string `json:"status,omitempty"`
 }
 
-func (c *Client) CreateLoadBalancer(ctx context.Context, name, vpcID string, port int, algorithm string) (*LoadBalancer, error) {
+func (c *Client) CreateLoadBalancer(ctx context.Context, name, vpcID string, port int, algorithm string, tls *LBTLS) (*LoadBalancer, error) {
 	payload := map[string]interface{}{
 		"name":      name,
 		"vpc_id":    vpcID,
 		"port":      port,
 		"algorithm": algorithm,
 	}
+	if tls != nil {
+		payload["tls"] = tls
+	}
 
 	var lb LoadBalancer
 	_, err := c.do(ctx, "POST", "/lb", payload, &lb)
@@ -382,6 +882,7 @@ func (c *Client) CreateLoadBalancer(ctx context.Context, name, vpcID string, por
 	return &lb, nil
 }
 
+// GetLoadBalancer fetches a load balancer without its targets
 func (c *Client) GetLoadBalancer(ctx context.Context, id string) (*LoadBalancer, error) {
 	var lb LoadBalancer
 	status, err := c.do(ctx, "GET", fmt.Sprintf("/lb/%s", id), nil, &lb)
@@ -393,19 +894,41 @@ func (c *Client) GetLoadBalancer(ctx context.Context, id string) (*LoadBalancer,
 		return nil, nil // nolint:nilnil
 	}
 
-	// Also fetch targets
+	return &lb, nil
+}
+
+// GetLoadBalancerWithTargets fetches a load balancer along with its registered targets
+func (c *Client) GetLoadBalancerWithTargets(ctx context.Context, id string) (*LoadBalancer, error) {
+	lb, err := c.GetLoadBalancer(ctx, id)
+	if err != nil || lb == nil {
+		return lb, err
+	}
+
 	targets, err := c.ListLBTargets(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	lb.Targets = targets
 
+	return lb, nil
+}
+
+// UpdateLoadBalancerTLS replaces the TLS listener of a load balancer, e.g. to rotate its
+// certificate. A nil tls turns the load balancer back to plaintext.
+func (c *Client) UpdateLoadBalancerTLS(ctx context.Context, id string, tls *LBTLS) (*LoadBalancer, error) {
+	payload := map[string]interface{}{"tls": tls}
+
+	var lb LoadBalancer
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/lb/%s", id), payload, &lb)
+	if err != nil {
+		return nil, err
+	}
+
 	return &lb, nil
 }
 
 func (c *Client) DeleteLoadBalancer(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/lb/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/lb/%s", id))
 }
 
 func (c *Client) AddLBTarget(ctx context.Context, lbID string, target LBTarget) error {
@@ -417,25 +940,75 @@ func (c *Client) AddLBTarget(ctx context.Context, lbID string, target LBTarget)
 }
 
 func (c *Client) RemoveLBTarget(ctx context.Context, lbID, instanceID string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/lb/%s/targets/%s", lbID, instanceID), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/lb/%s/targets/%s", lbID, instanceID))
 }
 
+// ListLBTargets lists the targets of a load balancer. A missing targets collection
+// (e.g. on a freshly created load balancer) is reported as an empty list.
 func (c *Client) ListLBTargets(ctx context.Context, lbID string) ([]LBTarget, error) {
-	var targets []LBTarget
-	_, err := c.do(ctx, "GET", fmt.Sprintf("/lb/%s/targets", lbID), nil, &targets)
+	targets, status, err := listAll[LBTarget](ctx, c, fmt.Sprintf("/lb/%s/targets", lbID))
 	if err != nil {
 		return nil, err
 	}
+	if status == http.StatusNotFound || targets == nil {
+		return []LBTarget{}, nil
+	}
 	return targets, nil
 }
 
+// Certificate represents the API response for a TLS Certificate. The private key is
+// only ever sent on create; the API never returns it.
+type Certificate struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	CertificatePEM string `json:"certificate_pem"`
+	PrivateKeyPEM  string `json:"private_key_pem,omitempty"`
+	Fingerprint    string `json:"fingerprint,omitempty"`
+	Expiry         string `json:"expiry,omitempty"`
+}
+
+func (c *Client) CreateCertificate(ctx context.Context, name, certificatePEM, privateKeyPEM string) (*Certificate, error) {
+	payload := map[string]string{
+		"name":            name,
+		"certificate_pem": certificatePEM,
+		"private_key_pem": privateKeyPEM,
+	}
+
+	var cert Certificate
+	_, err := c.do(ctx, "POST", "/certificates", payload, &cert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+func (c *Client) GetCertificate(ctx context.Context, id string) (*Certificate, error) {
+	var cert Certificate
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/certificates/%s", id), nil, &cert)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &cert, nil
+}
+
+func (c *Client) DeleteCertificate(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/certificates/%s", id))
+}
+
 // Secret represents the API response for a Secret
 type Secret struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Value       string `json:"value,omitempty"`
+	ValueSHA256 string `json:"value_sha256,omitempty"`
 	Description string `json:"description"`
+	Version     int64  `json:"version"`
 }
 
 func (c *Client) CreateSecret(ctx context.Context, name, value, description string) (*Secret, error) {
@@ -454,6 +1027,26 @@ func (c *Client) CreateSecret(ctx context.Context, name, value, description stri
 	return &secret, nil
 }
 
+// GenerateSecretRequest creates a secret whose value is generated by the API.
+type GenerateSecretRequest struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Length         int    `json:"length,omitempty"`
+	IncludeSpecial bool   `json:"include_special"`
+}
+
+// GenerateSecret creates a secret with a random value. The returned Secret is the only
+// response that carries the generated value.
+func (c *Client) GenerateSecret(ctx context.Context, req GenerateSecretRequest) (*Secret, error) {
+	var secret Secret
+	_, err := c.do(ctx, "POST", "/secrets/generate", req, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
 func (c *Client) GetSecret(ctx context.Context, id string) (*Secret, error) {
 	var secret Secret
 	status, err := c.do(ctx, "GET", fmt.Sprintf("/secrets/%s", id), nil, &secret)
@@ -468,9 +1061,100 @@ func (c *Client) GetSecret(ctx context.Context, id string) (*Secret, error) {
 	return &secret, nil
 }
 
+func (c *Client) ListSecrets(ctx context.Context, filters ...ListFilter) ([]Secret, error) {
+	return listFiltered(ctx, c, "/secrets", filters, func(s Secret) filterFields {
+		return filterFields{name: s.Name}
+	})
+}
+
+// GetSecretValue returns the current value of a secret. Reading a value needs a separate
+// permission from reading the secret's metadata; without it the API answers 403.
+func (c *Client) GetSecretValue(ctx context.Context, id string) (string, error) {
+	var res struct {
+		Value string `json:"value"`
+	}
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/secrets/%s/value", id), nil, &res)
+	if err != nil {
+		return "", err
+	}
+
+	if status == http.StatusNotFound {
+		return "", fmt.Errorf("secret %s not found", id)
+	}
+
+	return res.Value, nil
+}
+
+// UpdateSecret stores a new version of the secret. The ID stays the same. An empty
+// value only updates the description.
+// UpdateSecret updates the description of a secret and, unless value is nil, stores value
+// as its new version. An empty value is sent like any other.
+func (c *Client) UpdateSecret(ctx context.Context, id string, value *string, description string) (*Secret, error) {
+	payload := map[string]string{
+		"description": description,
+	}
+	if value != nil {
+		payload["value"] = *value
+	}
+
+	var secret Secret
+	_, err := c.do(ctx, "PUT", fmt.Sprintf("/secrets/%s", id), payload, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
 func (c *Client) DeleteSecret(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/secrets/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/secrets/%s", id))
+}
+
+// SSHKey represents the API response for an SSH key pair
+type SSHKey struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	PublicKey   string `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+func (c *Client) CreateSSHKey(ctx context.Context, name, publicKey string) (*SSHKey, error) {
+	payload := map[string]string{
+		"name":       name,
+		"public_key": publicKey,
+	}
+
+	var key SSHKey
+	_, err := c.do(ctx, "POST", "/ssh-keys", payload, &key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (c *Client) GetSSHKey(ctx context.Context, id string) (*SSHKey, error) {
+	var key SSHKey
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/ssh-keys/%s", id), nil, &key)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &key, nil
+}
+
+func (c *Client) ListSSHKeys(ctx context.Context, filters ...ListFilter) ([]SSHKey, error) {
+	return listFiltered(ctx, c, "/ssh-keys", filters, func(k SSHKey) filterFields {
+		return filterFields{name: k.Name}
+	})
+}
+
+func (c *Client) DeleteSSHKey(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/ssh-keys/%s", id))
 }
 
 // APIKey represents the API response for an API Key
@@ -495,28 +1179,42 @@ func (c *Client) CreateAPIKey(ctx context.Context, name string) (*APIKey, error)
 	return &key, nil
 }
 
-func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
-	var keys []APIKey
-	_, err := c.do(ctx, "GET", "/auth/keys", nil, &keys)
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	var key APIKey
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/auth/keys/%s", id), nil, &key)
 	if err != nil {
 		return nil, err
 	}
-	return keys, nil
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &key, nil
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	keys, _, err := listAll[APIKey](ctx, c, "/auth/keys")
+	return keys, err
 }
 
 func (c *Client) RevokeAPIKey(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/auth/keys/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/auth/keys/%s", id))
 }
 
 // ScalingGroup represents the API response for an Auto-Scaling Group
 type ScalingGroup struct {
+	Versioned
+
 	ID             string `json:"id"`
 	Name           string `json:"name"`
 	VpcID          string `json:"vpc_id"`
 	LoadBalancerID string `json:"load_balancer_id,omitempty"`
 	Image          string `json:"image"`
 	Ports          string `json:"ports"`
+	InstanceSize   string `json:"instance_size,omitempty"`
+	GPUType        string `json:"gpu_type,omitempty"`
+	GPUCount       int    `json:"gpu_count,omitempty"`
 	MinInstances   int    `json:"min_instances"`
 	MaxInstances   int    `json:"max_instances"`
 	DesiredCount   int    `json:"desired_count"`
@@ -547,36 +1245,52 @@ func (c *Client) GetScalingGroup(ctx context.Context, id string) (*ScalingGroup,
 	return &group, nil
 }
 
+func (c *Client) ListScalingGroups(ctx context.Context, filters ...ListFilter) ([]ScalingGroup, error) {
+	return listFiltered(ctx, c, "/autoscaling/groups", filters, func(g ScalingGroup) filterFields {
+		return filterFields{name: g.Name, vpcID: g.VpcID, status: g.Status}
+	})
+}
+
 func (c *Client) DeleteScalingGroup(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/autoscaling/groups/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/autoscaling/groups/%s", id))
 }
 
-func (c *Client) ListVPCs(ctx context.Context) ([]VPC, error) {
-	var vpcs []VPC
-	_, err := c.do(ctx, "GET", "/vpcs", nil, &vpcs)
-	if err != nil {
-		return nil, err
-	}
-	return vpcs, nil
+// ScalingGroupInstance represents an instance currently running in a Scaling Group
+type ScalingGroupInstance struct {
+	ID        string `json:"id"`
+	IPAddress string `json:"ip_address"`
+	Status    string `json:"status"`
 }
 
-func (c *Client) ListInstances(ctx context.Context) ([]Instance, error) {
-	var instances []Instance
-	_, err := c.do(ctx, "GET", "/instances", nil, &instances)
+// ListScalingGroupInstances lists the instances currently in a scaling group. A group
+// that doesn't exist (any more) has no instances.
+func (c *Client) ListScalingGroupInstances(ctx context.Context, id string) ([]ScalingGroupInstance, error) {
+	instances, status, err := listAll[ScalingGroupInstance](ctx, c, fmt.Sprintf("/autoscaling/groups/%s/instances", id))
 	if err != nil {
 		return nil, err
 	}
+	if status == http.StatusNotFound || instances == nil {
+		return []ScalingGroupInstance{}, nil
+	}
 	return instances, nil
 }
 
-func (c *Client) ListVolumes(ctx context.Context) ([]Volume, error) {
-	var volumes []Volume
-	_, err := c.do(ctx, "GET", "/volumes", nil, &volumes)
-	if err != nil {
-		return nil, err
-	}
-	return volumes, nil
+func (c *Client) ListVPCs(ctx context.Context, filters ...ListFilter) ([]VPC, error) {
+	return listFiltered(ctx, c, "/vpcs", filters, func(v VPC) filterFields {
+		return filterFields{name: v.Name, vpcID: v.ID, status: v.Status}
+	})
+}
+
+func (c *Client) ListInstances(ctx context.Context, filters ...ListFilter) ([]Instance, error) {
+	return listFiltered(ctx, c, "/instances", filters, func(i Instance) filterFields {
+		return filterFields{name: i.Name, vpcID: i.VpcID, status: i.Status}
+	})
+}
+
+func (c *Client) ListVolumes(ctx context.Context, filters ...ListFilter) ([]Volume, error) {
+	return listFiltered(ctx, c, "/volumes", filters, func(v Volume) filterFields {
+		return filterFields{name: v.Name, status: v.Status, noVpcID: true}
+	})
 }
 
 // Subnet represents the API response for a Subnet
@@ -586,6 +1300,7 @@ type Subnet struct {
 	Name             string `json:"name"`
 	CIDRBlock        string `json:"cidr_block"`
 	AvailabilityZone string `json:"availability_zone"`
+	Status           string `json:"status,omitempty"`
 }
 
 func (c *Client) CreateSubnet(ctx context.Context, vpcID, name, cidr, az string) (*Subnet, error) {
@@ -618,26 +1333,25 @@ func (c *Client) GetSubnet(ctx context.Context, id string) (*Subnet, error) {
 	return &subnet, nil
 }
 
-func (c *Client) ListSubnets(ctx context.Context, vpcID string) ([]Subnet, error) {
-	var subnets []Subnet
-	_, err := c.do(ctx, "GET", fmt.Sprintf("/vpcs/%s/subnets", vpcID), nil, &subnets)
-	if err != nil {
-		return nil, err
-	}
-	return subnets, nil
+func (c *Client) ListSubnets(ctx context.Context, vpcID string, filters ...ListFilter) ([]Subnet, error) {
+	return listFiltered(ctx, c, fmt.Sprintf("/vpcs/%s/subnets", vpcID), filters, func(s Subnet) filterFields {
+		return filterFields{name: s.Name, vpcID: s.VPCID, status: s.Status}
+	})
 }
 
 func (c *Client) DeleteSubnet(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/subnets/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/subnets/%s", id))
 }
 
 // Snapshot represents the API response for a Snapshot
 type Snapshot struct {
-	ID          string `json:"id"`
-	VolumeID    string `json:"volume_id"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
+	ID            string `json:"id"`
+	VolumeID      string `json:"volume_id"`
+	Description   string `json:"description"`
+	SizeGB        int    `json:"size_gb"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	CreatedAt     string `json:"created_at"`
 }
 
 func (c *Client) CreateSnapshot(ctx context.Context, volumeID, description string) (*Snapshot, error) {
@@ -670,44 +1384,163 @@ func (c *Client) GetSnapshot(ctx context.Context, id string) (*Snapshot, error)
 }
 
 func (c *Client) ListSnapshots(ctx context.Context) ([]Snapshot, error) {
-	var snapshots []Snapshot
-	_, err := c.do(ctx, "GET", "/snapshots", nil, &snapshots)
+	snapshots, _, err := listAll[Snapshot](ctx, c, "/snapshots")
+	return snapshots, err
+}
+
+// RestoreSnapshot creates a new volume from a snapshot. A zero sizeGB keeps the size of
+// the snapshotted volume.
+func (c *Client) RestoreSnapshot(ctx context.Context, snapshotID, name string, sizeGB int) (*Volume, error) {
+	payload := map[string]interface{}{
+		"name": name,
+	}
+	if sizeGB > 0 {
+		payload["size_gb"] = sizeGB
+	}
+
+	var vol Volume
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/snapshots/%s/restore", snapshotID), payload, &vol)
 	if err != nil {
 		return nil, err
 	}
-	return snapshots, nil
+
+	return &vol, nil
 }
 
 func (c *Client) DeleteSnapshot(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/snapshots/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/snapshots/%s", id))
+}
+
+// SnapshotPolicy represents the API response for a scheduled snapshot policy
+type SnapshotPolicy struct {
+	Versioned
+
+	ID             string `json:"id,omitempty"`
+	VolumeID       string `json:"volume_id"`
+	Schedule       string `json:"schedule"`
+	RetentionCount int    `json:"retention_count"`
+	Enabled        bool   `json:"enabled"`
+}
+
+// UpdateSnapshotPolicyRequest holds the settings of a snapshot policy that can change in
+// place. The update is conditional on ETag when it's set.
+type UpdateSnapshotPolicyRequest struct {
+	Versioned
+
+	Schedule       string `json:"schedule"`
+	RetentionCount int    `json:"retention_count"`
+	Enabled        bool   `json:"enabled"`
+}
+
+func (c *Client) CreateSnapshotPolicy(ctx context.Context, policy SnapshotPolicy) (*SnapshotPolicy, error) {
+	var res SnapshotPolicy
+	_, err := c.do(ctx, "POST", "/snapshots/policies", policy, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetSnapshotPolicy(ctx context.Context, id string) (*SnapshotPolicy, error) {
+	var res SnapshotPolicy
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/snapshots/policies/%s", id), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+	return &res, nil
+}
+
+func (c *Client) UpdateSnapshotPolicy(ctx context.Context, id string, req UpdateSnapshotPolicyRequest) (*SnapshotPolicy, error) {
+	var res SnapshotPolicy
+	_, err := c.do(withIfMatch(ctx, req.ETag), "PATCH", fmt.Sprintf("/snapshots/policies/%s", id), req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteSnapshotPolicy(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/snapshots/policies/%s", id))
 }
 
 // Database represents the API response for a Database
 type Database struct {
-	ID               string `json:"id"`
-	Name             string `json:"name"`
-	Engine           string `json:"engine"`
-	Version          string `json:"version"`
-	VpcID            string `json:"vpc_id,omitempty"`
-	Status           string `json:"status"`
-	Port             int    `json:"port"`
-	Username         string `json:"username"`
-	ConnectionString string `json:"connection_string,omitempty"`
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Engine           string   `json:"engine"`
+	Version          string   `json:"version"`
+	VpcID            string   `json:"vpc_id,omitempty"`
+	Status           string   `json:"status"`
+	Host             string   `json:"host,omitempty"`
+	Port             int      `json:"port"`
+	Username         string   `json:"username"`
+	ConnectionString string   `json:"connection_string,omitempty"`
+	AllowedCIDRs     []string `json:"allowed_cidrs,omitempty"`
+	InstanceClass    string   `json:"instance_class,omitempty"`
+	StorageGB        int      `json:"storage_gb,omitempty"`
+	// MaintenanceWindow is the effective window as ddd:hh24:mi-ddd:hh24:mi in UTC.
+	MaintenanceWindow string `json:"maintenance_window,omitempty"`
+	// Password is only returned on creation, when the API generated it.
+	Password string `json:"password,omitempty"`
 }
 
-func (c *Client) CreateDatabase(ctx context.Context, name, engine, version, vpcID string) (*Database, error) {
-	payload := map[string]interface{}{
-		"name":    name,
-		"engine":  engine,
-		"version": version,
+// ResolvedHost returns the host clients connect to. API versions that don't report it
+// separately only have it in the connection string.
+func (d *Database) ResolvedHost() string {
+	if d.Host != "" {
+		return d.Host
 	}
-	if vpcID != "" {
-		payload["vpc_id"] = vpcID
+	return connectionStringHost(d.ConnectionString)
+}
+
+// Address returns the credential-free host:port of the database, or "" when the host
+// isn't known.
+func (d *Database) Address() string {
+	return hostPort(d.ResolvedHost(), d.Port)
+}
+
+// connectionStringHost returns the host of a URL-style connection string, or "" when
+// it doesn't parse.
+func connectionStringHost(connectionString string) string {
+	u, err := url.Parse(connectionString)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+func hostPort(host string, port int) string {
+	if host == "" {
+		return ""
+	}
+	if port == 0 {
+		return host
 	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+type CreateDatabaseRequest struct {
+	Name          string
+	Engine        string
+	Version       string
+	VpcID         string
+	InstanceClass string
+	StorageGB     int
+	// Password is generated by the API when empty.
+	Password string
+	// AllowedCIDRs nil leaves the API default, which allows the whole VPC; an empty
+	// slice denies all client access.
+	AllowedCIDRs []string
+	// MaintenanceWindow is chosen by the API when empty.
+	MaintenanceWindow string
+}
 
+func (c *Client) CreateDatabase(ctx context.Context, req CreateDatabaseRequest) (*Database, error) {
 	var database Database
-	_, err := c.do(ctx, "POST", "/databases", payload, &database)
+	_, err := c.do(ctx, "POST", "/databases", req.payload(), &database)
 	if err != nil {
 		return nil, err
 	}
@@ -715,6 +1548,33 @@ func (c *Client) CreateDatabase(ctx context.Context, name, engine, version, vpcI
 	return &database, nil
 }
 
+func (req CreateDatabaseRequest) payload() map[string]interface{} {
+	payload := map[string]interface{}{
+		"name":    req.Name,
+		"engine":  req.Engine,
+		"version": req.Version,
+	}
+	if req.VpcID != "" {
+		payload["vpc_id"] = req.VpcID
+	}
+	if req.InstanceClass != "" {
+		payload["instance_class"] = req.InstanceClass
+	}
+	if req.StorageGB != 0 {
+		payload["storage_gb"] = req.StorageGB
+	}
+	if req.Password != "" {
+		payload["password"] = req.Password
+	}
+	if req.AllowedCIDRs != nil {
+		payload["allowed_cidrs"] = req.AllowedCIDRs
+	}
+	if req.MaintenanceWindow != "" {
+		payload["maintenance_window"] = req.MaintenanceWindow
+	}
+	return payload
+}
+
 func (c *Client) GetDatabase(ctx context.Context, id string) (*Database, error) {
 	var database Database
 	status, err := c.do(ctx, "GET", fmt.Sprintf("/databases/%s", id), nil, &database)
@@ -738,61 +1598,158 @@ func (c *Client) GetDatabase(ctx context.Context, id string) (*Database, error)
 	return &database, nil
 }
 
-func (c *Client) ListDatabases(ctx context.Context) ([]Database, error) {
-	var databases []Database
-	_, err := c.do(ctx, "GET", "/databases", nil, &databases)
-	if err != nil {
-		return nil, err
+func (c *Client) ListDatabases(ctx context.Context, filters ...ListFilter) ([]Database, error) {
+	return listFiltered(ctx, c, "/databases", filters, func(d Database) filterFields {
+		return filterFields{name: d.Name, vpcID: d.VpcID, status: d.Status}
+	})
+}
+
+// SetDatabaseNetworkACL replaces the list of client CIDRs allowed to connect to the
+// database. An empty list denies all access except platform management.
+func (c *Client) SetDatabaseNetworkACL(ctx context.Context, id string, allowedCIDRs []string) error {
+	if allowedCIDRs == nil {
+		allowedCIDRs = []string{}
+	}
+
+	payload := map[string]interface{}{
+		"allowed_cidrs": allowedCIDRs,
 	}
-	return databases, nil
+
+	_, err := c.do(ctx, "PUT", fmt.Sprintf("/databases/%s/network-acl", id), payload, nil)
+	return err
 }
 
-func (c *Client) DeleteDatabase(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/databases/%s", id), nil, nil)
+// ResizeDatabase changes the instance class and storage of a database. Empty values are
+// left as they are. The resize runs asynchronously; poll GetDatabase for its status.
+func (c *Client) ResizeDatabase(ctx context.Context, id, instanceClass string, storageGB int) error {
+	payload := map[string]interface{}{}
+	if instanceClass != "" {
+		payload["instance_class"] = instanceClass
+	}
+	if storageGB != 0 {
+		payload["storage_gb"] = storageGB
+	}
+
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/databases/%s/resize", id), payload, nil)
 	return err
 }
 
-// ElasticIP represents the API response for an Elastic IP
-type ElasticIP struct {
-	ID         string `json:"id"`
-	PublicIP   string `json:"public_ip"`
-	InstanceID string `json:"instance_id,omitempty"`
-	Status     string `json:"status"`
+// ResetDatabasePassword sets a new master password for a database.
+func (c *Client) ResetDatabasePassword(ctx context.Context, id, password string) error {
+	payload := map[string]string{
+		"password": password,
+	}
+
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/databases/%s/reset-password", id), payload, nil)
+	return err
 }
 
-func (c *Client) AllocateElasticIP(ctx context.Context) (*ElasticIP, error) {
-	var eip ElasticIP
-	_, err := c.do(ctx, "POST", "/elastic-ips", nil, &eip)
+// SetDatabaseMaintenanceWindow changes the weekly window in which the platform may
+// upgrade the database, as ddd:hh24:mi-ddd:hh24:mi.
+func (c *Client) SetDatabaseMaintenanceWindow(ctx context.Context, id, window string) error {
+	payload := map[string]interface{}{
+		"maintenance_window": window,
+	}
+
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/databases/%s", id), payload, nil)
+	return err
+}
+
+func (c *Client) DeleteDatabase(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/databases/%s", id))
+}
+
+// DatabaseBackup represents the API response for a Database Backup
+type DatabaseBackup struct {
+	ID          string `json:"id"`
+	DatabaseID  string `json:"database_id"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	SizeGB      int    `json:"size_gb"`
+	CreatedAt   string `json:"created_at"`
+}
+
+func (c *Client) CreateDatabaseBackup(ctx context.Context, databaseID, description string) (*DatabaseBackup, error) {
+	payload := map[string]string{}
+	if description != "" {
+		payload["description"] = description
+	}
+
+	var backup DatabaseBackup
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/databases/%s/backups", databaseID), payload, &backup)
 	if err != nil {
 		return nil, err
 	}
-	return &eip, nil
+
+	return &backup, nil
 }
 
-func (c *Client) GetElasticIP(ctx context.Context, id string) (*ElasticIP, error) {
-	var eip ElasticIP
-	status, err := c.do(ctx, "GET", fmt.Sprintf("/elastic-ips/%s", id), nil, &eip)
+func (c *Client) GetDatabaseBackup(ctx context.Context, databaseID, backupID string) (*DatabaseBackup, error) {
+	var backup DatabaseBackup
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/databases/%s/backups/%s", databaseID, backupID), nil, &backup)
 	if err != nil {
 		return nil, err
 	}
+
 	if status == http.StatusNotFound {
-		return nil, nil
+		return nil, nil // nolint:nilnil
 	}
-	return &eip, nil
+
+	return &backup, nil
 }
 
-func (c *Client) ListElasticIPs(ctx context.Context) ([]ElasticIP, error) {
-	var eips []ElasticIP
-	_, err := c.do(ctx, "GET", "/elastic-ips", nil, &eips)
+func (c *Client) DeleteDatabaseBackup(ctx context.Context, databaseID, backupID string) error {
+	return c.delete(ctx, fmt.Sprintf("/databases/%s/backups/%s", databaseID, backupID))
+}
+
+// RestoreDatabaseBackup creates a new database from a backup of another. The restore runs
+// asynchronously; poll GetDatabase on the returned database for its status.
+func (c *Client) RestoreDatabaseBackup(ctx context.Context, databaseID, backupID string, req CreateDatabaseRequest) (*Database, error) {
+	var database Database
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/databases/%s/backups/%s/restore", databaseID, backupID), req.payload(), &database)
 	if err != nil {
 		return nil, err
 	}
-	return eips, nil
-}
+
+	return &database, nil
+}
+
+// ElasticIP represents the API response for an Elastic IP
+type ElasticIP struct {
+	ID         string `json:"id"`
+	PublicIP   string `json:"public_ip"`
+	InstanceID string `json:"instance_id,omitempty"`
+	Status     string `json:"status"`
+}
+
+func (c *Client) AllocateElasticIP(ctx context.Context) (*ElasticIP, error) {
+	var eip ElasticIP
+	_, err := c.do(ctx, "POST", "/elastic-ips", nil, &eip)
+	if err != nil {
+		return nil, err
+	}
+	return &eip, nil
+}
+
+func (c *Client) GetElasticIP(ctx context.Context, id string) (*ElasticIP, error) {
+	var eip ElasticIP
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/elastic-ips/%s", id), nil, &eip)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	return &eip, nil
+}
+
+func (c *Client) ListElasticIPs(ctx context.Context) ([]ElasticIP, error) {
+	eips, _, err := listAll[ElasticIP](ctx, c, "/elastic-ips")
+	return eips, err
+}
 
 func (c *Client) ReleaseElasticIP(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/elastic-ips/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/elastic-ips/%s", id))
 }
 
 func (c *Client) AssociateElasticIP(ctx context.Context, id string, instanceID string) (*ElasticIP, error) {
@@ -816,6 +1773,48 @@ func (c *Client) DisassociateElasticIP(ctx context.Context, id string) (*Elastic
 	return &eip, nil
 }
 
+// NATGateway represents the API response for a NAT Gateway
+type NATGateway struct {
+	ID          string `json:"id"`
+	SubnetID    string `json:"subnet_id"`
+	ElasticIPID string `json:"elastic_ip_id"`
+	PrivateIP   string `json:"private_ip"`
+	Status      string `json:"status"`
+}
+
+func (c *Client) CreateNATGateway(ctx context.Context, subnetID, elasticIPID string) (*NATGateway, error) {
+	payload := map[string]string{
+		"subnet_id":     subnetID,
+		"elastic_ip_id": elasticIPID,
+	}
+
+	var nat NATGateway
+	_, err := c.do(ctx, "POST", "/nat-gateways", payload, &nat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nat, nil
+}
+
+func (c *Client) GetNATGateway(ctx context.Context, id string) (*NATGateway, error) {
+	var nat NATGateway
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/nat-gateways/%s", id), nil, &nat)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &nat, nil
+}
+
+func (c *Client) DeleteNATGateway(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/nat-gateways/%s", id))
+}
+
 // DNSZone represents the API response for a DNS Zone
 type DNSZone struct {
 	ID          string `json:"id"`
@@ -852,21 +1851,31 @@ func (c *Client) GetDNSZone(ctx context.Context, id string) (*DNSZone, error) {
 }
 
 func (c *Client) ListDNSZones(ctx context.Context) ([]DNSZone, error) {
-	var zones []DNSZone
-	_, err := c.do(ctx, "GET", "/dns/zones", nil, &zones)
+	zones, _, err := listAll[DNSZone](ctx, c, "/dns/zones")
+	return zones, err
+}
+
+// UpdateDNSZone changes the description of a DNS Zone. An empty description clears it.
+func (c *Client) UpdateDNSZone(ctx context.Context, id, description string) (*DNSZone, error) {
+	payload := map[string]string{
+		"description": description,
+	}
+	var zone DNSZone
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/dns/zones/%s", id), payload, &zone)
 	if err != nil {
 		return nil, err
 	}
-	return zones, nil
+	return &zone, nil
 }
 
 func (c *Client) DeleteDNSZone(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/dns/zones/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/dns/zones/%s", id))
 }
 
 // DNSRecord represents the API response for a DNS Record
 type DNSRecord struct {
+	Versioned
+
 	ID       string `json:"id"`
 	ZoneID   string `json:"zone_id"`
 	Name     string `json:"name"`
@@ -898,17 +1907,13 @@ func (c *Client) GetDNSRecord(ctx context.Context, id string) (*DNSRecord, error
 }
 
 func (c *Client) ListDNSRecords(ctx context.Context, zoneID string) ([]DNSRecord, error) {
-	var records []DNSRecord
-	_, err := c.do(ctx, "GET", fmt.Sprintf("/dns/zones/%s/records", zoneID), nil, &records)
-	if err != nil {
-		return nil, err
-	}
-	return records, nil
+	records, _, err := listAll[DNSRecord](ctx, c, fmt.Sprintf("/dns/zones/%s/records", zoneID))
+	return records, err
 }
 
 func (c *Client) UpdateDNSRecord(ctx context.Context, id string, record DNSRecord) (*DNSRecord, error) {
 	var res DNSRecord
-	_, err := c.do(ctx, "PUT", fmt.Sprintf("/dns/records/%s", id), record, &res)
+	_, err := c.do(withIfMatch(ctx, record.ETag), "PUT", fmt.Sprintf("/dns/records/%s", id), record, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -916,24 +1921,32 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, id string, record DNSRecor
 }
 
 func (c *Client) DeleteDNSRecord(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/dns/records/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/dns/records/%s", id))
 }
 
 // Cluster represents the API response for a K8s Cluster
 type Cluster struct {
-	ID                 string   `json:"id"`
-	Name               string   `json:"name"`
-	VpcID              string   `json:"vpc_id"`
-	Version            string   `json:"version"`
-	WorkerCount        int      `json:"worker_count"`
-	Status             string   `json:"status"`
-	PodCIDR            string   `json:"pod_cidr"`
-	ServiceCIDR        string   `json:"service_cidr"`
-	NetworkIsolation   bool     `json:"network_isolation"`
-	HAEnabled          bool     `json:"ha_enabled"`
-	APIServerLBAddress string   `json:"api_server_lb_address,omitempty"`
-	ControlPlaneIPs    []string `json:"control_plane_ips"`
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	VpcID              string              `json:"vpc_id"`
+	Version            string              `json:"version"`
+	WorkerCount        int                 `json:"worker_count"`
+	Status             string              `json:"status"`
+	PodCIDR            string              `json:"pod_cidr"`
+	ServiceCIDR        string              `json:"service_cidr"`
+	NetworkIsolation   bool                `json:"network_isolation"`
+	HAEnabled          bool                `json:"ha_enabled"`
+	APIServerLBAddress string              `json:"api_server_lb_address,omitempty"`
+	ControlPlaneIPs    []string            `json:"control_plane_ips"`
+	Autoscaling        *ClusterAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// ClusterAutoscaling describes the worker autoscaler of a Cluster. While it is enabled
+// the autoscaler keeps the worker count between MinWorkers and MaxWorkers.
+type ClusterAutoscaling struct {
+	Enabled    bool `json:"enabled"`
+	MinWorkers int  `json:"min_workers,omitempty"`
+	MaxWorkers int  `json:"max_workers,omitempty"`
 }
 
 type CreateClusterRequest struct {
@@ -966,18 +1979,14 @@ func (c *Client) GetCluster(ctx context.Context, id string) (*Cluster, error) {
 	return &cluster, nil
 }
 
-func (c *Client) ListClusters(ctx context.Context) ([]Cluster, error) {
-	var clusters []Cluster
-	_, err := c.do(ctx, "GET", "/clusters", nil, &clusters)
-	if err != nil {
-		return nil, err
-	}
-	return clusters, nil
+func (c *Client) ListClusters(ctx context.Context, filters ...ListFilter) ([]Cluster, error) {
+	return listFiltered(ctx, c, "/clusters", filters, func(cl Cluster) filterFields {
+		return filterFields{name: cl.Name, vpcID: cl.VpcID, status: cl.Status}
+	})
 }
 
 func (c *Client) DeleteCluster(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/clusters/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/clusters/%s", id))
 }
 
 func (c *Client) ScaleCluster(ctx context.Context, id string, workers int) error {
@@ -992,15 +2001,83 @@ func (c *Client) UpgradeCluster(ctx context.Context, id string, version string)
 	return err
 }
 
+func (c *Client) UpdateClusterAutoscaling(ctx context.Context, id string, autoscaling ClusterAutoscaling) error {
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/clusters/%s/autoscaling", id), autoscaling, nil)
+	return err
+}
+
+// NodePool represents the API response for a group of worker nodes in a Cluster
+type NodePool struct {
+	ID          string            `json:"id,omitempty"`
+	ClusterID   string            `json:"cluster_id,omitempty"`
+	Name        string            `json:"name"`
+	WorkerCount int               `json:"worker_count"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Status      string            `json:"status,omitempty"`
+}
+
+func (c *Client) CreateNodePool(ctx context.Context, clusterID string, pool NodePool) (*NodePool, error) {
+	var res NodePool
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/clusters/%s/node-pools", clusterID), pool, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetNodePool(ctx context.Context, clusterID, poolID string) (*NodePool, error) {
+	var res NodePool
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, poolID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+	return &res, nil
+}
+
+func (c *Client) ListNodePools(ctx context.Context, clusterID string) ([]NodePool, error) {
+	pools, status, err := listAll[NodePool](ctx, c, fmt.Sprintf("/clusters/%s/node-pools", clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || pools == nil {
+		return []NodePool{}, nil
+	}
+	return pools, nil
+}
+
+// UpdateNodePoolRequest scales a node pool and replaces its labels. Labels is always
+// sent, so an empty map removes every label from the pool.
+type UpdateNodePoolRequest struct {
+	WorkerCount *int              `json:"worker_count,omitempty"`
+	Labels      map[string]string `json:"labels"`
+}
+
+func (c *Client) UpdateNodePool(ctx context.Context, clusterID, poolID string, req UpdateNodePoolRequest) (*NodePool, error) {
+	var res NodePool
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, poolID), req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteNodePool(ctx context.Context, clusterID, poolID string) error {
+	return c.delete(ctx, fmt.Sprintf("/clusters/%s/node-pools/%s", clusterID, poolID))
+}
+
 // GlobalLB represents the API response for a Global Load Balancer
 type GlobalLB struct {
-	ID            string             `json:"id"`
-	Name          string             `json:"name"`
-	Hostname      string             `json:"hostname"`
-	Policy        string             `json:"routing_policy"`
-	Status        string             `json:"status"`
-	HealthCheck   GlobalHealthCheck  `json:"health_check"`
-	Endpoints     []GlobalEndpoint   `json:"endpoints,omitempty"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Hostname    string            `json:"hostname"`
+	Policy      string            `json:"routing_policy"`
+	Status      string            `json:"status"`
+	HealthCheck GlobalHealthCheck `json:"health_check"`
+	Endpoints   []GlobalEndpoint  `json:"endpoints,omitempty"`
+	GlobalIPID  string            `json:"global_ip_id,omitempty"`
 }
 
 type GlobalHealthCheck struct {
@@ -1029,6 +2106,7 @@ type CreateGlobalLBRequest struct {
 	Hostname    string            `json:"hostname"`
 	Policy      string            `json:"policy"`
 	HealthCheck GlobalHealthCheck `json:"health_check"`
+	GlobalIPID  string            `json:"global_ip_id,omitempty"`
 }
 
 func (c *Client) CreateGlobalLB(ctx context.Context, req CreateGlobalLBRequest) (*GlobalLB, error) {
@@ -1053,17 +2131,83 @@ func (c *Client) GetGlobalLB(ctx context.Context, id string) (*GlobalLB, error)
 }
 
 func (c *Client) ListGlobalLBs(ctx context.Context) ([]GlobalLB, error) {
-	var glbs []GlobalLB
-	_, err := c.do(ctx, "GET", "/global-lb", nil, &glbs)
+	glbs, _, err := listAll[GlobalLB](ctx, c, "/global-lb")
+	return glbs, err
+}
+
+// UpdateGlobalLBRequest holds the fields of a Global LB that can change in place. Nil
+// fields are left unchanged; an empty GlobalIPID unbinds the global IP.
+type UpdateGlobalLBRequest struct {
+	Policy      *string            `json:"routing_policy,omitempty"`
+	HealthCheck *GlobalHealthCheck `json:"health_check,omitempty"`
+	GlobalIPID  *string            `json:"global_ip_id,omitempty"`
+}
+
+func (c *Client) UpdateGlobalLB(ctx context.Context, id string, req UpdateGlobalLBRequest) (*GlobalLB, error) {
+	var glb GlobalLB
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/global-lb/%s", id), req, &glb)
 	if err != nil {
 		return nil, err
 	}
-	return glbs, nil
+	return &glb, nil
 }
 
 func (c *Client) DeleteGlobalLB(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/global-lb/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/global-lb/%s", id))
+}
+
+// GlobalIP represents the API response for a Global IP, an anycast address that can be
+// bound to a Global LB.
+type GlobalIP struct {
+	ID          string `json:"id"`
+	Address     string `json:"address"`
+	Description string `json:"description,omitempty"`
+	GlobalLBID  string `json:"global_lb_id,omitempty"`
+	Status      string `json:"status"`
+}
+
+func (c *Client) AllocateGlobalIP(ctx context.Context, description string) (*GlobalIP, error) {
+	payload := map[string]interface{}{}
+	if description != "" {
+		payload["description"] = description
+	}
+	var ip GlobalIP
+	_, err := c.do(ctx, "POST", "/global-ips", payload, &ip)
+	if err != nil {
+		return nil, err
+	}
+	return &ip, nil
+}
+
+func (c *Client) GetGlobalIP(ctx context.Context, id string) (*GlobalIP, error) {
+	var ip GlobalIP
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/global-ips/%s", id), nil, &ip)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+	return &ip, nil
+}
+
+// UpdateGlobalIPDescription changes the description of a global IP.
+func (c *Client) UpdateGlobalIPDescription(ctx context.Context, id, description string) (*GlobalIP, error) {
+	payload := map[string]interface{}{
+		"description": description,
+	}
+	var ip GlobalIP
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/global-ips/%s", id), payload, &ip)
+	if err != nil {
+		return nil, err
+	}
+	return &ip, nil
+}
+
+// ReleaseGlobalIP releases a global IP. The API rejects the request with a conflict while
+// the IP is bound to a Global LB.
+func (c *Client) ReleaseGlobalIP(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/global-ips/%s", id))
 }
 
 type AddGlobalEndpointRequest struct {
@@ -1071,7 +2215,7 @@ type AddGlobalEndpointRequest struct {
 	TargetType string `json:"target_type"`
 	TargetID   string `json:"target_id,omitempty"`
 	TargetIP   string `json:"target_ip,omitempty"`
-	Weight     int    `json:"weight,omitempty"`
+	Weight     *int   `json:"weight,omitempty"`
 	Priority   int    `json:"priority,omitempty"`
 }
 
@@ -1084,16 +2228,30 @@ func (c *Client) AddGlobalEndpoint(ctx context.Context, glbID string, req AddGlo
 	return &ep, nil
 }
 
-func (c *Client) RemoveGlobalEndpoint(ctx context.Context, glbID, epID string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/global-lb/%s/endpoints/%s", glbID, epID), nil, nil)
+// UpdateGlobalEndpointRequest holds the fields of a Global LB endpoint that can change in
+// place. Nil fields are left unchanged.
+type UpdateGlobalEndpointRequest struct {
+	Weight   *int `json:"weight,omitempty"`
+	Priority *int `json:"priority,omitempty"`
+}
+
+func (c *Client) UpdateGlobalEndpoint(ctx context.Context, glbID, epID string, req UpdateGlobalEndpointRequest) (*GlobalEndpoint, error) {
+	var ep GlobalEndpoint
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/global-lb/%s/endpoints/%s", glbID, epID), req, &ep)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return &ep, nil
+}
+
+func (c *Client) RemoveGlobalEndpoint(ctx context.Context, glbID, epID string) error {
+	return c.delete(ctx, fmt.Sprintf("/global-lb/%s/endpoints/%s", glbID, epID))
 }
 
 // GatewayRoute represents the API response for a Gateway Route
 type GatewayRoute struct {
+	Versioned
+
 	ID          string   `json:"id"`
 	Name        string   `json:"name"`
 	PathPrefix  string   `json:"path_prefix"`
@@ -1102,6 +2260,12 @@ type GatewayRoute struct {
 	StripPrefix bool     `json:"strip_prefix"`
 	RateLimit   int      `json:"rate_limit"`
 	Priority    int      `json:"priority"`
+	// Position is the route's place in the order the gateway evaluates routes, from 1.
+	Position int `json:"position,omitempty"`
+
+	AuthRequired     bool              `json:"auth_required"`
+	AllowedAPIKeyIDs []string          `json:"allowed_api_key_ids"`
+	RequestHeaders   map[string]string `json:"request_headers"`
 }
 
 type CreateRouteRequest struct {
@@ -1112,6 +2276,10 @@ type CreateRouteRequest struct {
 	StripPrefix bool     `json:"strip_prefix,omitempty"`
 	RateLimit   int      `json:"rate_limit,omitempty"`
 	Priority    int      `json:"priority,omitempty"`
+
+	AuthRequired     bool              `json:"auth_required,omitempty"`
+	AllowedAPIKeyIDs []string          `json:"allowed_api_key_ids,omitempty"`
+	RequestHeaders   map[string]string `json:"request_headers,omitempty"`
 }
 
 func (c *Client) CreateGatewayRoute(ctx context.Context, req CreateRouteRequest) (*GatewayRoute, error) {
@@ -1137,18 +2305,25 @@ func (c *Client) GetGatewayRoute(ctx context.Context, id string) (*GatewayRoute,
 	return nil, nil
 }
 
-func (c *Client) ListGatewayRoutes(ctx context.Context) ([]GatewayRoute, error) {
-	var routes []GatewayRoute
-	_, err := c.do(ctx, "GET", "/gateway/routes", nil, &routes)
+func (c *Client) ListGatewayRoutes(ctx context.Context, filters ...ListFilter) ([]GatewayRoute, error) {
+	return listFiltered(ctx, c, "/gateway/routes", filters, func(r GatewayRoute) filterFields {
+		return filterFields{name: r.Name, noVpcID: true, noStatus: true}
+	})
+}
+
+// UpdateGatewayRoute replaces a route's settings. The update is conditional on route.ETag
+// when it's set.
+func (c *Client) UpdateGatewayRoute(ctx context.Context, id string, route GatewayRoute) (*GatewayRoute, error) {
+	var res GatewayRoute
+	_, err := c.do(withIfMatch(ctx, route.ETag), "PUT", fmt.Sprintf("/gateway/routes/%s", id), route, &res)
 	if err != nil {
 		return nil, err
 	}
-	return routes, nil
+	return &res, nil
 }
 
 func (c *Client) DeleteGatewayRoute(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/gateway/routes/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/gateway/routes/%s", id))
 }
 
 // Function represents the API response for a serverless Function
@@ -1160,43 +2335,67 @@ type Function struct {
 	CodePath  string    `json:"code_path"`
 	Status    string    `json:"status"`
 	CreatedAt time.Time `json:"created_at"`
+	// Version is incremented each time new code is deployed.
+	Version int `json:"version"`
 }
 
 func (c *Client) CreateFunction(ctx context.Context, name, runtime, handler string, code []byte) (*Function, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+	fields := map[string]string{
+		"name":    name,
+		"runtime": runtime,
+		"handler": handler,
+	}
+	return c.sendFunctionCode(ctx, "POST", "/functions", fields, code)
+}
 
-	_ = writer.WriteField("name", name)
-	_ = writer.WriteField("runtime", runtime)
-	_ = writer.WriteField("handler", handler)
+// UpdateFunctionCode replaces the code of a function with a new zip archive.
+func (c *Client) UpdateFunctionCode(ctx context.Context, id string, code []byte) (*Function, error) {
+	return c.sendFunctionCode(ctx, "PUT", fmt.Sprintf("/functions/%s/code", id), nil, code)
+}
 
-	part, err := writer.CreateFormFile("code", "code.zip")
-	if err != nil {
-		return nil, err
+// UpdateFunction changes the runtime and handler of a function.
+func (c *Client) UpdateFunction(ctx context.Context, id, runtime, handler string) (*Function, error) {
+	payload := map[string]interface{}{
+		"runtime": runtime,
+		"handler": handler,
 	}
-	_, _ = part.Write(code)
-	_ = writer.Close()
-
-	req, err := http.NewRequestWithContext(ctx, "POST", c.BuildURL("/functions"), body)
+	var res Function
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/functions/%s", id), payload, &res)
 	if err != nil {
 		return nil, err
 	}
+	return &res, nil
+}
 
-	req.Header.Set("X-API-Key", c.APIKey)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
+// sendFunctionCode uploads a zip archive as multipart form data, along with fields.
+func (c *Client) sendFunctionCode(ctx context.Context, method, path string, fields map[string]string, code []byte) (*Function, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
 
-	resp, err := c.HTTPClient.Do(req)
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("code", "code.zip")
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		return nil, c.handleError(resp)
+	if _, err := part.Write(code); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
 	}
 
 	var res Function
-	if err := c.decodeResponse(resp, &res); err != nil {
+	if err := c.doUpload(ctx, method, path, writer.FormDataContentType(), body.Bytes(), &res); err != nil {
 		return nil, err
 	}
 
@@ -1215,82 +2414,271 @@ func (c *Client) GetFunction(ctx context.Context, id string) (*Function, error)
 	return &res, nil
 }
 
-func (c *Client) ListFunctions(ctx context.Context) ([]Function, error) {
-	var res []Function
-	_, err := c.do(ctx, "GET", "/functions", nil, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
+func (c *Client) ListFunctions(ctx context.Context, filters ...ListFilter) ([]Function, error) {
+	return listFiltered(ctx, c, "/functions", filters, func(fn Function) filterFields {
+		return filterFields{name: fn.Name, status: fn.Status, noVpcID: true}
+	})
 }
 
 func (c *Client) DeleteFunction(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/functions/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/functions/%s", id))
 }
 
-// Cache represents the API response for a managed Cache
-type Cache struct {
-	ID               string `json:"id"`
-	Name             string `json:"name"`
-	Engine           string `json:"engine"`
-	Version          string `json:"version"`
-	VpcID            string `json:"vpc_id,omitempty"`
-	Status           string `json:"status"`
-	Port             int    `json:"port"`
-	MemoryMB         int    `json:"memory_mb"`
-	ConnectionString string `json:"connection_string,omitempty"`
+// FunctionInvocation is the result of a synchronous Function invocation. Error is set
+// when the function itself failed.
+type FunctionInvocation struct {
+	StatusCode int             `json:"status_code"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	DurationMS int64           `json:"duration_ms"`
+	Error      string          `json:"error,omitempty"`
 }
 
-func (c *Client) CreateCache(ctx context.Context, name, version string, memoryMB int, vpcID string) (*Cache, error) {
-	payload := map[string]interface{}{
-		"name":      name,
-		"version":   version,
-		"memory_mb": memoryMB,
+// BodyString returns the function's response as text. A function may answer with
+// JSON or with plain text, which the API passes through as a JSON string.
+func (i *FunctionInvocation) BodyString() string {
+	var text string
+	if err := json.Unmarshal(i.Body, &text); err == nil {
+		return text
 	}
-	if vpcID != "" {
-		payload["vpc_id"] = vpcID
+	return string(i.Body)
+}
+
+// InvokeFunction runs a function synchronously with the given payload and waits for its response.
+func (c *Client) InvokeFunction(ctx context.Context, id, payload string) (*FunctionInvocation, error) {
+	body := map[string]string{"payload": payload}
+	var res FunctionInvocation
+	// Invoking runs the function, so a failed attempt isn't retried
+	_, err := c.do(withoutRetry(ctx), "POST", fmt.Sprintf("/functions/%s/invoke", id), body, &res)
+	if err != nil {
+		return nil, err
 	}
-	var res Cache
-	_, err := c.do(ctx, "POST", "/caches", payload, &res)
+	return &res, nil
+}
+
+// FunctionTrigger represents the API response for a trigger invoking a Function.
+// Path, Methods and URL apply to http triggers; QueueID and BatchSize to queue triggers.
+type FunctionTrigger struct {
+	ID         string   `json:"id,omitempty"`
+	FunctionID string   `json:"function_id,omitempty"`
+	Type       string   `json:"type"`
+	Path       string   `json:"path,omitempty"`
+	Methods    []string `json:"methods,omitempty"`
+	QueueID    string   `json:"queue_id,omitempty"`
+	BatchSize  int      `json:"batch_size,omitempty"`
+	URL        string   `json:"url,omitempty"`
+}
+
+func (c *Client) CreateFunctionTrigger(ctx context.Context, functionID string, trigger FunctionTrigger) (*FunctionTrigger, error) {
+	var res FunctionTrigger
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/functions/%s/triggers", functionID), trigger, &res)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (c *Client) GetCache(ctx context.Context, id string) (*Cache, error) {
-	var res Cache
-	status, err := c.do(ctx, "GET", fmt.Sprintf("/caches/%s", id), nil, &res)
+func (c *Client) GetFunctionTrigger(ctx context.Context, functionID, triggerID string) (*FunctionTrigger, error) {
+	var res FunctionTrigger
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/functions/%s/triggers/%s", functionID, triggerID), nil, &res)
 	if err != nil {
 		return nil, err
 	}
 	if status == http.StatusNotFound {
-		return nil, nil
+		return nil, nil // nolint:nilnil
 	}
-	// Also fetch connection string
-	var connResp struct {
-		ConnectionString string `json:"connection_string"`
+	return &res, nil
+}
+
+func (c *Client) DeleteFunctionTrigger(ctx context.Context, functionID, triggerID string) error {
+	return c.delete(ctx, fmt.Sprintf("/functions/%s/triggers/%s", functionID, triggerID))
+}
+
+// FunctionAlias represents the API response for a named alias of a Function. Routing
+// maps function versions to the percentage of invocations they receive.
+type FunctionAlias struct {
+	Name       string         `json:"name"`
+	FunctionID string         `json:"function_id,omitempty"`
+	Routing    map[string]int `json:"routing"`
+	InvokeURL  string         `json:"invoke_url,omitempty"`
+}
+
+func (c *Client) CreateFunctionAlias(ctx context.Context, functionID, name string, routing map[string]int) (*FunctionAlias, error) {
+	payload := map[string]interface{}{
+		"name":    name,
+		"routing": routing,
 	}
-	_, err = c.do(ctx, "GET", fmt.Sprintf("/caches/%s/connection", id), nil, &connResp)
-	if err == nil {
-		res.ConnectionString = connResp.ConnectionString
+	var res FunctionAlias
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/functions/%s/aliases", functionID), payload, &res)
+	if err != nil {
+		return nil, err
 	}
 	return &res, nil
 }
 
-func (c *Client) ListCaches(ctx context.Context) ([]Cache, error) {
-	var res []Cache
-	_, err := c.do(ctx, "GET", "/caches", nil, &res)
+func (c *Client) GetFunctionAlias(ctx context.Context, functionID, name string) (*FunctionAlias, error) {
+	var res FunctionAlias
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/functions/%s/aliases/%s", functionID, name), nil, &res)
 	if err != nil {
 		return nil, err
 	}
-	return res, nil
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+	return &res, nil
 }
 
-func (c *Client) DeleteCache(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/caches/%s", id), nil, nil)
-	return err
+// UpdateFunctionAliasRouting replaces the traffic split of an alias.
+func (c *Client) UpdateFunctionAliasRouting(ctx context.Context, functionID, name string, routing map[string]int) (*FunctionAlias, error) {
+	payload := map[string]interface{}{
+		"routing": routing,
+	}
+	var res FunctionAlias
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/functions/%s/aliases/%s", functionID, name), payload, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteFunctionAlias(ctx context.Context, functionID, name string) error {
+	return c.delete(ctx, fmt.Sprintf("/functions/%s/aliases/%s", functionID, name))
+}
+
+// Cache represents the API response for a managed Cache
+type Cache struct {
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	Engine           string      `json:"engine"`
+	Version          string      `json:"version"`
+	VpcID            string      `json:"vpc_id,omitempty"`
+	Status           string      `json:"status"`
+	Host             string      `json:"host,omitempty"`
+	Port             int         `json:"port"`
+	MemoryMB         int         `json:"memory_mb"`
+	ConnectionString string      `json:"connection_string,omitempty"`
+	ReplicaCount     int         `json:"replica_count"`
+	ClusterMode      bool        `json:"cluster_mode"`
+	Nodes            []CacheNode `json:"nodes,omitempty"`
+	// MaintenanceWindow is the effective window as ddd:hh24:mi-ddd:hh24:mi in UTC.
+	MaintenanceWindow string `json:"maintenance_window,omitempty"`
+}
+
+// ResolvedHost returns the host clients connect to. With replicas, it's the primary
+// node. API versions that don't report it separately only have it in the connection
+// string.
+func (c *Cache) ResolvedHost() string {
+	if c.Host != "" {
+		return c.Host
+	}
+	return connectionStringHost(c.ConnectionString)
+}
+
+// Address returns the credential-free host:port of the cache, or "" when the host
+// isn't known.
+func (c *Cache) Address() string {
+	return hostPort(c.ResolvedHost(), c.Port)
+}
+
+// CacheNode is a node of a Cache
+type CacheNode struct {
+	ID       string `json:"id"`
+	Role     string `json:"role"`
+	Endpoint string `json:"endpoint"`
+}
+
+// CreateCacheOptions holds the optional settings of a new Cache. A nil
+// ReplicaCount or empty MaintenanceWindow leaves the platform default in place.
+type CreateCacheOptions struct {
+	ReplicaCount      *int
+	ClusterMode       bool
+	MaintenanceWindow string
+}
+
+func (c *Client) CreateCache(ctx context.Context, name, version string, memoryMB int, vpcID string, opts CreateCacheOptions) (*Cache, error) {
+	payload := map[string]interface{}{
+		"name":      name,
+		"version":   version,
+		"memory_mb": memoryMB,
+	}
+	if vpcID != "" {
+		payload["vpc_id"] = vpcID
+	}
+	if opts.ReplicaCount != nil {
+		payload["replica_count"] = *opts.ReplicaCount
+	}
+	if opts.ClusterMode {
+		payload["cluster_mode"] = true
+	}
+	if opts.MaintenanceWindow != "" {
+		payload["maintenance_window"] = opts.MaintenanceWindow
+	}
+	var res Cache
+	_, err := c.do(ctx, "POST", "/caches", payload, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetCache(ctx context.Context, id string) (*Cache, error) {
+	var res Cache
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/caches/%s", id), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	// Also fetch connection string
+	var connResp struct {
+		ConnectionString string `json:"connection_string"`
+	}
+	_, err = c.do(ctx, "GET", fmt.Sprintf("/caches/%s/connection", id), nil, &connResp)
+	if err == nil {
+		res.ConnectionString = connResp.ConnectionString
+	}
+	return &res, nil
+}
+
+func (c *Client) ListCaches(ctx context.Context) ([]Cache, error) {
+	res, _, err := listAll[Cache](ctx, c, "/caches")
+	return res, err
+}
+
+func (c *Client) DeleteCache(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/caches/%s", id))
+}
+
+// SetCacheReplicaCount changes the number of replicas of a cache.
+func (c *Client) SetCacheReplicaCount(ctx context.Context, id string, replicaCount int) error {
+	payload := map[string]interface{}{
+		"replica_count": replicaCount,
+	}
+
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/caches/%s", id), payload, nil)
+	return err
+}
+
+// SetCacheMaintenanceWindow changes the weekly window in which the platform may
+// upgrade the cache, as ddd:hh24:mi-ddd:hh24:mi.
+func (c *Client) SetCacheMaintenanceWindow(ctx context.Context, id, window string) error {
+	payload := map[string]interface{}{
+		"maintenance_window": window,
+	}
+
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/caches/%s", id), payload, nil)
+	return err
+}
+
+// ResizeCache changes the memory allocation of a cache. The resize runs asynchronously;
+// poll GetCache for its status.
+func (c *Client) ResizeCache(ctx context.Context, id string, memoryMB int) error {
+	payload := map[string]interface{}{
+		"memory_mb": memoryMB,
+	}
+
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/caches/%s/resize", id), payload, nil)
+	return err
 }
 
 func (c *Client) FlushCache(ctx context.Context, id string) error {
@@ -1300,6 +2688,8 @@ func (c *Client) FlushCache(ctx context.Context, id string) error {
 
 // Queue represents the API response for a managed Queue
 type Queue struct {
+	Versioned
+
 	ID                string `json:"id"`
 	Name              string `json:"name"`
 	ARN               string `json:"arn"`
@@ -1307,12 +2697,34 @@ type Queue struct {
 	RetentionDays     int    `json:"retention_days"`
 	MaxMessageSize    int    `json:"max_message_size"`
 	Status            string `json:"status"`
+
+	RedrivePolicy *RedrivePolicy `json:"redrive_policy,omitempty"`
+}
+
+// RedrivePolicy moves messages that have been received MaxReceiveCount times without
+// being deleted to the dead-letter queue.
+type RedrivePolicy struct {
+	DeadLetterQueueID string `json:"dead_letter_queue_id"`
+	MaxReceiveCount   int    `json:"max_receive_count"`
 }
 
 type CreateQueueOptions struct {
-	VisibilityTimeout *int `json:"visibility_timeout,omitempty"`
-	RetentionDays     *int `json:"retention_days,omitempty"`
-	MaxMessageSize    *int `json:"max_message_size,omitempty"`
+	VisibilityTimeout *int           `json:"visibility_timeout,omitempty"`
+	RetentionDays     *int           `json:"retention_days,omitempty"`
+	MaxMessageSize    *int           `json:"max_message_size,omitempty"`
+	RedrivePolicy     *RedrivePolicy `json:"redrive_policy,omitempty"`
+}
+
+// UpdateQueueOptions holds the settings of a queue that can change in place. Nil
+// settings are left unchanged, except RedrivePolicy, which is removed when nil. The
+// update is conditional on ETag when it's set.
+type UpdateQueueOptions struct {
+	Versioned
+
+	VisibilityTimeout *int           `json:"visibility_timeout,omitempty"`
+	RetentionDays     *int           `json:"retention_days,omitempty"`
+	MaxMessageSize    *int           `json:"max_message_size,omitempty"`
+	RedrivePolicy     *RedrivePolicy `json:"redrive_policy"`
 }
 
 func (c *Client) CreateQueue(ctx context.Context, name string, opts CreateQueueOptions) (*Queue, error) {
@@ -1328,6 +2740,9 @@ func (c *Client) CreateQueue(ctx context.Context, name string, opts CreateQueueO
 	if opts.MaxMessageSize != nil {
 		payload["max_message_size"] = *opts.MaxMessageSize
 	}
+	if opts.RedrivePolicy != nil {
+		payload["redrive_policy"] = opts.RedrivePolicy
+	}
 	var res Queue
 	_, err := c.do(ctx, "POST", "/queues", payload, &res)
 	if err != nil {
@@ -1348,18 +2763,23 @@ func (c *Client) GetQueue(ctx context.Context, id string) (*Queue, error) {
 	return &res, nil
 }
 
-func (c *Client) ListQueues(ctx context.Context) ([]Queue, error) {
-	var res []Queue
-	_, err := c.do(ctx, "GET", "/queues", nil, &res)
+func (c *Client) ListQueues(ctx context.Context, filters ...ListFilter) ([]Queue, error) {
+	return listFiltered(ctx, c, "/queues", filters, func(q Queue) filterFields {
+		return filterFields{name: q.Name, status: q.Status}
+	})
+}
+
+func (c *Client) UpdateQueue(ctx context.Context, id string, opts UpdateQueueOptions) (*Queue, error) {
+	var res Queue
+	_, err := c.do(withIfMatch(ctx, opts.ETag), "PATCH", fmt.Sprintf("/queues/%s", id), opts, &res)
 	if err != nil {
 		return nil, err
 	}
-	return res, nil
+	return &res, nil
 }
 
 func (c *Client) DeleteQueue(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/queues/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/queues/%s", id))
 }
 
 func (c *Client) PurgeQueue(ctx context.Context, id string) error {
@@ -1367,6 +2787,45 @@ func (c *Client) PurgeQueue(ctx context.Context, id string) error {
 	return err
 }
 
+// QueueRedriveTask is a move of the messages in a queue, usually a dead-letter queue,
+// to another queue. Tasks are kept as history once they finish and can't be changed.
+type QueueRedriveTask struct {
+	ID                 string `json:"id"`
+	SourceQueueID      string `json:"source_queue_id"`
+	DestinationQueueID string `json:"destination_queue_id"`
+	Status             string `json:"status"`
+	MovedMessageCount  int    `json:"moved_message_count"`
+	TotalMessageCount  int    `json:"total_message_count"`
+	FailureReason      string `json:"failure_reason,omitempty"`
+	CreatedAt          string `json:"created_at"`
+	CompletedAt        string `json:"completed_at,omitempty"`
+}
+
+// StartQueueRedrive starts moving the messages of the source queue to the destination
+// queue. The move runs in the background; poll the task with GetQueueRedriveTask.
+func (c *Client) StartQueueRedrive(ctx context.Context, sourceQueueID, destinationQueueID string) (*QueueRedriveTask, error) {
+	payload := map[string]string{"destination_queue_id": destinationQueueID}
+	var res QueueRedriveTask
+	// A retried start could move the messages twice
+	_, err := c.do(withoutRetry(ctx), "POST", fmt.Sprintf("/queues/%s/redrive", sourceQueueID), payload, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) GetQueueRedriveTask(ctx context.Context, sourceQueueID, taskID string) (*QueueRedriveTask, error) {
+	var res QueueRedriveTask
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/queues/%s/redrive/%s", sourceQueueID, taskID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	return &res, nil
+}
+
 // Tenant represents the API response for a Tenant
 type Tenant struct {
 	ID        string    `json:"id"`
@@ -1392,20 +2851,143 @@ func (c *Client) CreateTenant(ctx context.Context, name, slug string) (*Tenant,
 }
 
 func (c *Client) GetTenant(ctx context.Context, id string) (*Tenant, error) {
-	// API doesn't seem to have direct GET /tenants/:id, usually handled by Listing or context
-	// For Terraform purposes, we might need a way to fetch specific tenant if supported
-	return nil, fmt.Errorf("getting specific tenant by ID not supported by API yet")
+	var res Tenant
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/tenants/%s", id), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusNotFound {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &res, nil
+}
+
+// UpdateTenantRequest holds the tenant fields to change. Empty fields are left as they are.
+type UpdateTenantRequest struct {
+	Name string `json:"name,omitempty"`
+	Plan string `json:"plan,omitempty"`
+}
+
+func (c *Client) UpdateTenant(ctx context.Context, id string, req UpdateTenantRequest) (*Tenant, error) {
+	var res Tenant
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/tenants/%s", id), req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) DeleteTenant(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/tenants/%s", id))
 }
 
 func (c *Client) ListTenants(ctx context.Context) ([]Tenant, error) {
-	var res []Tenant
-	_, err := c.do(ctx, "GET", "/tenants", nil, &res)
+	res, _, err := listAll[Tenant](ctx, c, "/tenants")
+	return res, err
+}
+
+// TenantMember represents the API response for a member of a Tenant
+type TenantMember struct {
+	ID        string `json:"id"`
+	TenantID  string `json:"tenant_id"`
+	UserID    string `json:"user_id"`
+	UserEmail string `json:"user_email"`
+	Role      string `json:"role"`
+}
+
+func (c *Client) AddTenantMember(ctx context.Context, tenantID, userEmail, role string) (*TenantMember, error) {
+	payload := map[string]string{
+		"user_email": userEmail,
+		"role":       role,
+	}
+	var res TenantMember
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/tenants/%s/members", tenantID), payload, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListTenantMembers returns the members of a tenant, or nil when the tenant doesn't exist.
+func (c *Client) ListTenantMembers(ctx context.Context, tenantID string) ([]TenantMember, error) {
+	res, status, err := listAll[TenantMember](ctx, c, fmt.Sprintf("/tenants/%s/members", tenantID))
 	if err != nil {
 		return nil, err
 	}
+
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+
 	return res, nil
 }
 
+func (c *Client) UpdateTenantMember(ctx context.Context, tenantID, memberID, role string) (*TenantMember, error) {
+	payload := map[string]string{
+		"role": role,
+	}
+	var res TenantMember
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/tenants/%s/members/%s", tenantID, memberID), payload, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) RemoveTenantMember(ctx context.Context, tenantID, memberID string) error {
+	return c.delete(ctx, fmt.Sprintf("/tenants/%s/members/%s", tenantID, memberID))
+}
+
+// AuditEvent represents the API response for an entry in the audit log. Details is the
+// event's action-specific payload, passed through as JSON.
+type AuditEvent struct {
+	ID           string          `json:"id"`
+	Timestamp    string          `json:"timestamp"`
+	Actor        string          `json:"actor"`
+	Action       string          `json:"action"`
+	ResourceType string          `json:"resource_type"`
+	ResourceID   string          `json:"resource_id"`
+	Details      json.RawMessage `json:"details,omitempty"`
+}
+
+// AuditEventFilter narrows ListAuditEvents. Empty fields are not sent, and a zero Limit
+// lists every matching event.
+type AuditEventFilter struct {
+	Since        time.Time
+	ResourceType string
+	Action       string
+	Limit        int
+}
+
+func (f AuditEventFilter) query() string {
+	v := url.Values{}
+	if !f.Since.IsZero() {
+		v.Set("since", f.Since.UTC().Format(time.RFC3339))
+	}
+	if f.ResourceType != "" {
+		v.Set("resource_type", f.ResourceType)
+	}
+	if f.Action != "" {
+		v.Set("action", f.Action)
+	}
+	if f.Limit > 0 {
+		v.Set("limit", fmt.Sprint(f.Limit))
+	}
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// ListAuditEvents lists audit log events matching filter, following pages until
+// filter.Limit events have been read.
+func (c *Client) ListAuditEvents(ctx context.Context, filter AuditEventFilter) ([]AuditEvent, error) {
+	res, _, err := listUpTo[AuditEvent](ctx, c, "/audit/events"+filter.query(), filter.Limit)
+	return res, err
+}
+
 // Deployment represents the API response for a container Deployment
 type Deployment struct {
 	ID           string `json:"id"`
@@ -1415,6 +2997,18 @@ type Deployment struct {
 	CurrentCount int    `json:"current_count"`
 	Ports        string `json:"ports"`
 	Status       string `json:"status"`
+	Revision     int    `json:"revision"`
+
+	Env         map[string]string      `json:"env,omitempty"`
+	HealthCheck *DeploymentHealthCheck `json:"health_check,omitempty"`
+}
+
+// DeploymentHealthCheck is an HTTP probe the containers of a deployment must pass.
+type DeploymentHealthCheck struct {
+	Path                string `json:"path"`
+	Port                int    `json:"port"`
+	InitialDelaySeconds int    `json:"initial_delay_seconds,omitempty"`
+	PeriodSeconds       int    `json:"period_seconds,omitempty"`
 }
 
 type CreateDeploymentRequest struct {
@@ -1422,6 +3016,17 @@ type CreateDeploymentRequest struct {
 	Image    string `json:"image"`
 	Replicas int    `json:"replicas"`
 	Ports    string `json:"ports,omitempty"`
+
+	Env         map[string]string      `json:"env,omitempty"`
+	HealthCheck *DeploymentHealthCheck `json:"health_check,omitempty"`
+}
+
+// RolloutDeploymentRequest holds the container settings a rollout applies.
+type RolloutDeploymentRequest struct {
+	Image       string                 `json:"image"`
+	Ports       string                 `json:"ports"`
+	Env         map[string]string      `json:"env"`
+	HealthCheck *DeploymentHealthCheck `json:"health_check"`
 }
 
 func (c *Client) CreateDeployment(ctx context.Context, req CreateDeploymentRequest) (*Deployment, error) {
@@ -1445,18 +3050,14 @@ func (c *Client) GetDeployment(ctx context.Context, id string) (*Deployment, err
 	return &res, nil
 }
 
-func (c *Client) ListDeployments(ctx context.Context) ([]Deployment, error) {
-	var res []Deployment
-	_, err := c.do(ctx, "GET", "/containers/deployments", nil, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
+func (c *Client) ListDeployments(ctx context.Context, filters ...ListFilter) ([]Deployment, error) {
+	return listFiltered(ctx, c, "/containers/deployments", filters, func(dep Deployment) filterFields {
+		return filterFields{name: dep.Name, status: dep.Status, noVpcID: true}
+	})
 }
 
 func (c *Client) DeleteDeployment(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/containers/deployments/%s", id), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/containers/deployments/%s", id))
 }
 
 func (c *Client) ScaleDeployment(ctx context.Context, id string, replicas int) error {
@@ -1465,23 +3066,38 @@ func (c *Client) ScaleDeployment(ctx context.Context, id string, replicas int) e
 	return err
 }
 
+// RolloutDeployment replaces a deployment's containers with ones using the settings in
+// req. The API does this a few containers at a time and bumps the revision. A nil Env or
+// HealthCheck removes them.
+func (c *Client) RolloutDeployment(ctx context.Context, id string, req RolloutDeploymentRequest) (*Deployment, error) {
+	var res Deployment
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/containers/deployments/%s/rollout", id), req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
 // Image represents the API response for a machine Image
 type Image struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	OS          string `json:"os"`
-	Version     string `json:"version"`
-	IsPublic    bool   `json:"is_public"`
-	Status      string `json:"status"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	OS           string `json:"os"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+	IsPublic     bool   `json:"is_public"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"created_at,omitempty"`
 }
 
 type RegisterImageRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	OS          string `json:"os"`
-	Version     string `json:"version"`
-	IsPublic    bool   `json:"is_public,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	OS           string `json:"os"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture,omitempty"`
+	IsPublic     bool   `json:"is_public,omitempty"`
 }
 
 func (c *Client) RegisterImage(ctx context.Context, req RegisterImageRequest) (*Image, error) {
@@ -1493,36 +3109,50 @@ func (c *Client) RegisterImage(ctx context.Context, req RegisterImageRequest) (*
 	return &res, nil
 }
 
-func (c *Client) UploadImage(ctx context.Context, id string, code []byte) error {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+// imageUploadPartSize is the size of each part of an image upload. Only one part is
+// held in memory at a time.
+var imageUploadPartSize int64 = 64 << 20
 
-	part, err := writer.CreateFormFile("file", "image.qcow2")
-	if err != nil {
-		return err
-	}
-	_, _ = part.Write(code)
-	_ = writer.Close()
+// UploadImage streams an image file of the given size to the API in parts, then
+// completes the upload. progress, if set, is called after each part with the number of
+// bytes uploaded so far.
+func (c *Client) UploadImage(ctx context.Context, id string, file io.Reader, size int64, progress func(uploaded, total int64)) error {
+	buf := make([]byte, imageUploadPartSize)
+	var uploaded int64
+	parts := 0
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.BuildURL(fmt.Sprintf("/images/%s/upload", id)), body)
-	if err != nil {
-		return err
-	}
+	for {
+		n, err := io.ReadFull(file, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("reading image part %d: %w", parts+1, err)
+		}
 
-	req.Header.Set("X-API-Key", c.APIKey)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
+		parts++
+		if err := c.uploadImagePart(ctx, id, parts, buf[:n]); err != nil {
+			return fmt.Errorf("uploading image part %d: %w", parts, err)
+		}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+		uploaded += int64(n)
+		if progress != nil {
+			progress(uploaded, size)
+		}
 
-	if resp.StatusCode >= 400 {
-		return c.handleError(resp)
+		if n < len(buf) {
+			break
+		}
 	}
 
-	return nil
+	payload := map[string]interface{}{"parts": parts}
+	_, err := c.do(ctx, "POST", fmt.Sprintf("/images/%s/upload/complete", id), payload, nil)
+	return err
+}
+
+func (c *Client) uploadImagePart(ctx context.Context, id string, part int, data []byte) error {
+	path := fmt.Sprintf("/images/%s/upload?part=%d", id, part)
+	return c.doUpload(ctx, "PUT", path, "application/octet-stream", data, nil)
 }
 
 func (c *Client) GetImage(ctx context.Context, id string) (*Image, error) {
@@ -1537,18 +3167,83 @@ func (c *Client) GetImage(ctx context.Context, id string) (*Image, error) {
 	return &res, nil
 }
 
-func (c *Client) ListImages(ctx context.Context) ([]Image, error) {
-	var res []Image
-	_, err := c.do(ctx, "GET", "/images", nil, &res)
+func (c *Client) ListImages(ctx context.Context, filters ...ListFilter) ([]Image, error) {
+	return listFiltered(ctx, c, "/images", filters, func(img Image) filterFields {
+		return filterFields{name: img.Name, status: img.Status, os: img.OS, version: img.Version}
+	})
+}
+
+func (c *Client) DeleteImage(ctx context.Context, id string) error {
+	return c.delete(ctx, fmt.Sprintf("/images/%s", id))
+}
+
+// FindImage resolves an image reference that may either be an image ID or an image name
+func (c *Client) FindImage(ctx context.Context, ref string) (*Image, error) {
+	image, err := c.GetImage(ctx, ref)
+	if err != nil || image != nil {
+		return image, err
+	}
+
+	images, err := c.ListImages(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return res, nil
+	for _, img := range images {
+		if img.Name == ref {
+			return &img, nil
+		}
+	}
+	return nil, nil
 }
 
-func (c *Client) DeleteImage(ctx context.Context, id string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/images/%s", id), nil, nil)
-	return err
+// InstanceSize represents the API response for an instance size
+type InstanceSize struct {
+	Name          string   `json:"name"`
+	VCPUs         int      `json:"vcpus"`
+	MemoryMB      int      `json:"memory_mb"`
+	Architectures []string `json:"architectures"`
+}
+
+func (c *Client) GetInstanceSize(ctx context.Context, name string) (*InstanceSize, error) {
+	var res InstanceSize
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/instance-sizes/%s", name), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// GPUType represents the API response for a GPU accelerator type
+type GPUType struct {
+	Name         string                `json:"name"`
+	MemoryGB     int                   `json:"memory_gb"`
+	Availability []GPUTypeAvailability `json:"availability"`
+}
+
+// GPUTypeAvailability is the number of GPUs of a type available in a zone
+type GPUTypeAvailability struct {
+	Region           string `json:"region"`
+	AvailabilityZone string `json:"availability_zone"`
+	Available        int    `json:"available"`
+}
+
+// ListGPUTypes lists the GPU types that can be attached to instances. It returns nil
+// when the API does not list GPU types.
+func (c *Client) ListGPUTypes(ctx context.Context) ([]GPUType, error) {
+	res, status, err := listAll[GPUType](ctx, c, "/gpu-types")
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if res == nil {
+		res = []GPUType{}
+	}
+	return res, nil
 }
 
 // Bucket represents the API response for a Storage Bucket
@@ -1559,6 +3254,7 @@ type Bucket struct {
 	VersioningEnabled bool   `json:"versioning_enabled"`
 	EncryptionEnabled bool   `json:"encryption_enabled"`
 	CreatedAt         string `json:"created_at"`
+	Endpoint          string `json:"endpoint,omitempty"`
 }
 
 func (c *Client) CreateBucket(ctx context.Context, name string, isPublic bool) (*Bucket, error) {
@@ -1589,17 +3285,12 @@ func (c *Client) GetBucket(ctx context.Context, name string) (*Bucket, error) {
 }
 
 func (c *Client) ListBuckets(ctx context.Context) ([]Bucket, error) {
-	var buckets []Bucket
-	_, err := c.do(ctx, "GET", "/storage/buckets", nil, &buckets)
-	if err != nil {
-		return nil, err
-	}
-	return buckets, nil
+	buckets, _, err := listAll[Bucket](ctx, c, "/storage/buckets")
+	return buckets, err
 }
 
 func (c *Client) DeleteBucket(ctx context.Context, name string) error {
-	_, err := c.do(ctx, "DELETE", fmt.Sprintf("/storage/buckets/%s", name), nil, nil)
-	return err
+	return c.delete(ctx, fmt.Sprintf("/storage/buckets/%s", name))
 }
 
 func (c *Client) SetBucketVersioning(ctx context.Context, name string, enabled bool) error {
@@ -1607,3 +3298,119 @@ func (c *Client) SetBucketVersioning(ctx context.Context, name string, enabled b
 	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/storage/buckets/%s/versioning", name), payload, nil)
 	return err
 }
+
+// SetBucketAccess makes a bucket public or private.
+func (c *Client) SetBucketAccess(ctx context.Context, name string, isPublic bool) error {
+	payload := map[string]interface{}{"is_public": isPublic}
+	_, err := c.do(ctx, "PATCH", fmt.Sprintf("/storage/buckets/%s", name), payload, nil)
+	return err
+}
+
+// BucketCORSRule allows cross-origin requests to a Bucket.
+type BucketCORSRule struct {
+	AllowedOrigins []string `json:"allowed_origins"`
+	AllowedMethods []string `json:"allowed_methods"`
+	AllowedHeaders []string `json:"allowed_headers,omitempty"`
+	MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"`
+}
+
+type bucketCORS struct {
+	Rules []BucketCORSRule `json:"rules"`
+}
+
+// GetBucketCORS returns the CORS rules of a bucket, in evaluation order.
+func (c *Client) GetBucketCORS(ctx context.Context, name string) ([]BucketCORSRule, error) {
+	var res bucketCORS
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/storage/buckets/%s/cors", name), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || res.Rules == nil {
+		return []BucketCORSRule{}, nil
+	}
+	return res.Rules, nil
+}
+
+// PutBucketCORS replaces all CORS rules of a bucket. An empty list removes them.
+func (c *Client) PutBucketCORS(ctx context.Context, name string, rules []BucketCORSRule) error {
+	if rules == nil {
+		rules = []BucketCORSRule{}
+	}
+	_, err := c.do(ctx, "PUT", fmt.Sprintf("/storage/buckets/%s/cors", name), bucketCORS{Rules: rules}, nil)
+	return err
+}
+
+// BucketLifecycleRule expires objects in a Bucket. Zero day counts mean the
+// corresponding expiration is not set.
+type BucketLifecycleRule struct {
+	ID                              string `json:"id"`
+	Prefix                          string `json:"prefix,omitempty"`
+	ExpirationDays                  int    `json:"expiration_days,omitempty"`
+	NoncurrentVersionExpirationDays int    `json:"noncurrent_version_expiration_days,omitempty"`
+	Enabled                         bool   `json:"enabled"`
+}
+
+type bucketLifecycle struct {
+	Rules []BucketLifecycleRule `json:"rules"`
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket. A bucket without a
+// lifecycle configuration has no rules.
+func (c *Client) GetBucketLifecycle(ctx context.Context, name string) ([]BucketLifecycleRule, error) {
+	var res bucketLifecycle
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/storage/buckets/%s/lifecycle", name), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || res.Rules == nil {
+		return []BucketLifecycleRule{}, nil
+	}
+	return res.Rules, nil
+}
+
+// PutBucketLifecycle replaces all lifecycle rules of a bucket. An empty list removes them.
+func (c *Client) PutBucketLifecycle(ctx context.Context, name string, rules []BucketLifecycleRule) error {
+	if rules == nil {
+		rules = []BucketLifecycleRule{}
+	}
+	_, err := c.do(ctx, "PUT", fmt.Sprintf("/storage/buckets/%s/lifecycle", name), bucketLifecycle{Rules: rules}, nil)
+	return err
+}
+
+// BucketWebsite serves the objects of a Bucket as a static website. Endpoint is set by
+// the API.
+type BucketWebsite struct {
+	IndexDocument string `json:"index_document"`
+	ErrorDocument string `json:"error_document,omitempty"`
+	Endpoint      string `json:"endpoint,omitempty"`
+}
+
+// GetBucketWebsite returns the website configuration of a bucket, or nil when website
+// hosting is not enabled.
+func (c *Client) GetBucketWebsite(ctx context.Context, name string) (*BucketWebsite, error) {
+	var res BucketWebsite
+	status, err := c.do(ctx, "GET", fmt.Sprintf("/storage/buckets/%s/website", name), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound || res.IndexDocument == "" {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// PutBucketWebsite enables website hosting on a bucket or replaces its configuration.
+func (c *Client) PutBucketWebsite(ctx context.Context, name string, website BucketWebsite) (*BucketWebsite, error) {
+	website.Endpoint = ""
+	var res BucketWebsite
+	_, err := c.do(ctx, "PUT", fmt.Sprintf("/storage/buckets/%s/website", name), website, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteBucketWebsite disables website hosting on a bucket.
+func (c *Client) DeleteBucketWebsite(ctx context.Context, name string) error {
+	return c.delete(ctx, fmt.Sprintf("/storage/buckets/%s/website", name))
+}
@@ -3,9 +3,14 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -63,6 +68,9 @@ func TestClientGetVPC(t *testing.T) {
 			Name:      testVpcName,
 			CIDRBlock: testCIDR,
 			Status:    "available",
+
+			DefaultSecurityGroupID: "sg-default",
+			DefaultSubnetID:        "subnet-default",
 		})
 		assert.NoError(t, err)
 		err = json.NewEncoder(w).Encode(APIResponse{
@@ -78,6 +86,8 @@ func TestClientGetVPC(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, vpc)
 	assert.Equal(t, testVpcID, vpc.ID)
+	assert.Equal(t, "sg-default", vpc.DefaultSecurityGroupID)
+	assert.Equal(t, "subnet-default", vpc.DefaultSubnetID)
 }
 
 func TestClientGetVPCNotFound(t *testing.T) {
@@ -93,6 +103,128 @@ func TestClientGetVPCNotFound(t *testing.T) {
 	assert.Nil(t, vpc)
 }
 
+func TestClientGetAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/auth/keys/key-123", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		data, err := json.Marshal(APIKey{ID: "key-123", Name: "ci", CreatedAt: "2024-01-01T00:00:00Z"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	key, err := c.GetAPIKey(context.Background(), "key-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, key)
+	assert.Equal(t, "ci", key.Name)
+}
+
+func TestClientGetAPIKeyNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	key, err := c.GetAPIKey(context.Background(), "non-existent")
+
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func TestClientGetTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tenants/tenant-123", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		data, err := json.Marshal(Tenant{ID: "tenant-123", Name: "Acme", Slug: "acme", Plan: "free"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	tenant, err := c.GetTenant(context.Background(), "tenant-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, tenant)
+	assert.Equal(t, "acme", tenant.Slug)
+}
+
+func TestClientUpdateTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tenants/tenant-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"name": "Acme Corp"}, body)
+
+		data, err := json.Marshal(Tenant{ID: "tenant-123", Name: "Acme Corp", Slug: "acme", Plan: "free"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	tenant, err := c.UpdateTenant(context.Background(), "tenant-123", UpdateTenantRequest{Name: "Acme Corp"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme Corp", tenant.Name)
+}
+
+func TestClientListTenantMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tenants/tenant-123/members" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		data, err := json.Marshal([]TenantMember{{ID: "member-1", TenantID: "tenant-123", UserEmail: "alice@example.com", Role: "admin"}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	members, err := c.ListTenantMembers(context.Background(), "tenant-123")
+
+	assert.NoError(t, err)
+	assert.Len(t, members, 1)
+	assert.Equal(t, "admin", members[0].Role)
+
+	// A deleted tenant has no members
+	members, err = c.ListTenantMembers(context.Background(), "deleted-tenant")
+
+	assert.NoError(t, err)
+	assert.Nil(t, members)
+}
+
+func TestClientUpdateTenantMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tenants/tenant-123/members/member-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"role": "member"}, body)
+
+		data, err := json.Marshal(TenantMember{ID: "member-1", TenantID: "tenant-123", Role: "member"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	member, err := c.UpdateTenantMember(context.Background(), "tenant-123", "member-1", "member")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "member", member.Role)
+}
+
 func TestClientDeleteVPC(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/vpcs/"+testVpcID, r.URL.Path)
@@ -131,3 +263,1561 @@ func TestClientError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid cidr")
 }
+
+func TestClientStructuredError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		err := json.NewEncoder(w).Encode(APIResponse{
+			Error: &APIError{
+				Type:    "validation_error",
+				Message: "cidr overlaps vpc-456",
+				Code:    "CIDR_OVERLAP",
+			},
+		})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	_, err := c.CreateVPC(context.Background(), testVpcName, testCIDR)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+	assert.Equal(t, "CIDR_OVERLAP", apiErr.Code)
+	assert.Equal(t, "validation_error", apiErr.Type)
+	assert.Equal(t, "[422] cidr overlaps vpc-456 (code: CIDR_OVERLAP)", err.Error())
+}
+
+func TestClientStringError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "forbidden"}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	_, err := c.GetVPC(context.Background(), testVpcID)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusForbidden, apiErr.Status)
+	assert.Equal(t, "forbidden", apiErr.Message)
+}
+
+func TestClientNotFoundOnCreate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "vpc not found"}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	_, err := c.CreateSubnet(context.Background(), testVpcID, "subnet", "10.0.1.0/24", "")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+}
+
+func TestClientGetInstanceConsoleOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		switch r.URL.Path {
+		case "/instances/inst-1/console":
+			assert.Equal(t, "2", r.URL.Query().Get("lines"))
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set("Date", "Tue, 02 Jan 2024 15:04:05 GMT")
+			w.Write([]byte("booting\nmounting /\nkernel panic\n")) // nolint:errcheck
+		case "/instances/inst-2/console":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error": {"message": "instance is stopped", "code": "INSTANCE_STOPPED"}}`)) // nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	out, err := c.GetInstanceConsoleOutput(context.Background(), "inst-1", 2)
+	assert.NoError(t, err)
+	if assert.NotNil(t, out) {
+		// The API returned more lines than asked for
+		assert.Equal(t, "mounting /\nkernel panic\n", out.Output)
+		assert.Equal(t, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), out.CapturedAt)
+	}
+
+	_, err = c.GetInstanceConsoleOutput(context.Background(), "inst-2", 2)
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusConflict, apiErr.Status)
+		assert.Equal(t, "INSTANCE_STOPPED", apiErr.Code)
+	}
+
+	out, err = c.GetInstanceConsoleOutput(context.Background(), "missing", 2)
+	assert.NoError(t, err)
+	assert.Nil(t, out)
+}
+
+func TestClientListLBTargetsHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/lb/lb-123/targets", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.WriteHeader(http.StatusOK)
+		err := json.NewEncoder(w).Encode(APIResponse{
+			Data: json.RawMessage(`[{"instance_id": "inst-1", "port": 80, "weight": 100, "healthy": true, "status": "healthy"}]`),
+		})
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	targets, err := c.ListLBTargets(context.Background(), "lb-123")
+
+	assert.NoError(t, err)
+	assert.Len(t, targets, 1)
+	assert.True(t, targets[0].Healthy)
+	assert.Equal(t, "healthy", targets[0].Status)
+}
+
+func TestClientGetLoadBalancerWithTargetsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lb/lb-123":
+			data, err := json.Marshal(LoadBalancer{ID: "lb-123", Name: "test-lb", Port: 80})
+			assert.NoError(t, err)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		case "/lb/lb-123/targets":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	lb, err := c.GetLoadBalancerWithTargets(context.Background(), "lb-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, lb)
+	assert.NotNil(t, lb.Targets)
+	assert.Empty(t, lb.Targets)
+}
+
+func TestClientGetLoadBalancerSkipsTargets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/lb/lb-123", r.URL.Path)
+		data, err := json.Marshal(LoadBalancer{ID: "lb-123", Name: "test-lb", Port: 80})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	lb, err := c.GetLoadBalancer(context.Background(), "lb-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "lb-123", lb.ID)
+	assert.Nil(t, lb.Targets)
+}
+
+func TestClientListLBTargetsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	targets, err := c.ListLBTargets(context.Background(), "lb-123")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, targets)
+	assert.Empty(t, targets)
+}
+
+func TestClientGetDefaultSecurityGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/vpcs/vpc-123/default-sg", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		data, err := json.Marshal(SecurityGroup{
+			ID:    "sg-default",
+			VPCID: testVpcID,
+			Name:  "default",
+			Rules: []SecurityRule{{ID: "rule-1", Direction: "egress", Protocol: "all", CIDR: "0.0.0.0/0"}},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	sg, err := c.GetDefaultSecurityGroup(context.Background(), testVpcID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sg-default", sg.ID)
+	assert.Len(t, sg.Rules, 1)
+}
+
+func TestClientUpdateSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/secrets/secret-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		var payload map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "rotated", payload["value"])
+		assert.Equal(t, "db password", payload["description"])
+
+		data, err := json.Marshal(Secret{ID: "secret-1", Name: "DB_PASSWORD", Description: "db password", Version: 2})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	value := "rotated"
+	secret, err := c.UpdateSecret(context.Background(), "secret-1", &value, "db password")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-1", secret.ID)
+	assert.Equal(t, int64(2), secret.Version)
+}
+
+func TestClientUpdateSecretValue(t *testing.T) {
+	var payloads []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+
+		data, err := json.Marshal(Secret{ID: "secret-1", Version: 2})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	// An empty value is sent, only a nil one keeps the stored value
+	empty := ""
+	_, err := c.UpdateSecret(context.Background(), "secret-1", &empty, "")
+	assert.NoError(t, err)
+	_, err = c.UpdateSecret(context.Background(), "secret-1", nil, "")
+	assert.NoError(t, err)
+
+	if assert.Len(t, payloads, 2) {
+		value, ok := payloads[0]["value"]
+		assert.True(t, ok)
+		assert.Equal(t, "", value)
+		_, ok = payloads[1]["value"]
+		assert.False(t, ok)
+	}
+}
+
+func TestClientSetDatabaseNetworkACL(t *testing.T) {
+	var payloads []map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/databases/db-1/network-acl", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		var payload map[string][]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.SetDatabaseNetworkACL(context.Background(), "db-1", []string{"10.0.1.0/24"}))
+	assert.NoError(t, c.SetDatabaseNetworkACL(context.Background(), "db-1", nil))
+
+	assert.Len(t, payloads, 2)
+	assert.Equal(t, []string{"10.0.1.0/24"}, payloads[0]["allowed_cidrs"])
+
+	// Deny all is sent as an empty list, not null
+	assert.NotNil(t, payloads[1]["allowed_cidrs"])
+	assert.Empty(t, payloads[1]["allowed_cidrs"])
+}
+
+func TestClientResizeDatabase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/databases/db-123/resize", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		// Only the values being changed are sent
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"storage_gb": float64(50)}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.ResizeDatabase(context.Background(), "db-123", "", 50)
+
+	assert.NoError(t, err)
+}
+
+func TestClientRestoreDatabaseBackup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/databases/db-123/backups/bk-456/restore", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "restored", body["name"])
+		assert.Equal(t, "db.small", body["instance_class"])
+
+		data, err := json.Marshal(Database{ID: "db-789", Name: "restored", Status: "restoring"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	db, err := c.RestoreDatabaseBackup(context.Background(), "db-123", "bk-456", CreateDatabaseRequest{
+		Name:          "restored",
+		Engine:        "postgres",
+		Version:       "14",
+		InstanceClass: "db.small",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "db-789", db.ID)
+}
+
+func TestClientResizeCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/caches/cache-123/resize", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"memory_mb": float64(1024)}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.ResizeCache(context.Background(), "cache-123", 1024)
+
+	assert.NoError(t, err)
+}
+
+func TestClientCreateCacheOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/caches", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(2), body["replica_count"])
+		assert.Equal(t, true, body["cluster_mode"])
+
+		data, err := json.Marshal(Cache{
+			ID:           "cache-123",
+			ReplicaCount: 2,
+			ClusterMode:  true,
+			Nodes: []CacheNode{
+				{ID: "node-1", Role: "primary", Endpoint: "10.0.0.1:6379"},
+				{ID: "node-2", Role: "replica", Endpoint: "10.0.0.2:6379"},
+			},
+		})
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	replicas := 2
+	cache, err := c.CreateCache(context.Background(), "cache", "7.0", 512, "", CreateCacheOptions{ReplicaCount: &replicas, ClusterMode: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, cache.Nodes, 2)
+	assert.Equal(t, "primary", cache.Nodes[0].Role)
+}
+
+func TestClientSetCacheReplicaCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/caches/cache-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"replica_count": float64(0)}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.SetCacheReplicaCount(context.Background(), "cache-123", 0)
+
+	assert.NoError(t, err)
+}
+
+func TestClientSetMaintenanceWindow(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		paths = append(paths, r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"maintenance_window": "sun:03:00-sun:04:00"}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.SetDatabaseMaintenanceWindow(context.Background(), "db-123", "sun:03:00-sun:04:00"))
+	assert.NoError(t, c.SetCacheMaintenanceWindow(context.Background(), "cache-123", "sun:03:00-sun:04:00"))
+	assert.Equal(t, []string{"/databases/db-123", "/caches/cache-123"}, paths)
+}
+
+func TestDatabaseAndCacheAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		db          Database
+		wantHost    string
+		wantAddress string
+	}{
+		{"host from api", Database{Host: "db-123.internal", Port: 5432, ConnectionString: "postgres://admin:pw@10.0.1.5:5432/orders"}, "db-123.internal", "db-123.internal:5432"},
+		{"host from connection string", Database{Port: 5432, ConnectionString: "postgres://admin:pw@10.0.1.5:5432/orders"}, "10.0.1.5", "10.0.1.5:5432"},
+		{"ipv6 host", Database{Host: "fd00::5", Port: 5432}, "fd00::5", "[fd00::5]:5432"},
+		{"no port", Database{Host: "db-123.internal"}, "db-123.internal", "db-123.internal"},
+		{"unknown host", Database{Port: 5432}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantHost, tt.db.ResolvedHost())
+			assert.Equal(t, tt.wantAddress, tt.db.Address())
+
+			cache := Cache{Host: tt.db.Host, Port: tt.db.Port, ConnectionString: tt.db.ConnectionString}
+			assert.Equal(t, tt.wantHost, cache.ResolvedHost())
+			assert.Equal(t, tt.wantAddress, cache.Address())
+		})
+	}
+}
+
+func TestClientResetDatabasePassword(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/databases/db-123/reset-password", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"password": "n3w-passw0rd"}, body)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.ResetDatabasePassword(context.Background(), "db-123", "n3w-passw0rd")
+
+	assert.NoError(t, err)
+}
+
+func TestClientGetSecretValue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		switch r.URL.Path {
+		case "/secrets/secret-1/value":
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: json.RawMessage(`{"value":"s3cret"}`)}))
+		case "/secrets/secret-2/value":
+			w.WriteHeader(http.StatusForbidden)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]string{"type": "forbidden", "message": "missing secrets:read_value"},
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	value, err := c.GetSecretValue(context.Background(), "secret-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", value)
+
+	_, err = c.GetSecretValue(context.Background(), "secret-2")
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusForbidden, apiErr.Status)
+
+	_, err = c.GetSecretValue(context.Background(), "secret-3")
+	assert.Error(t, err)
+}
+
+func TestClientListAvailabilityZones(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		data, err := json.Marshal([]AvailabilityZone{{Name: "us-east-1a", Status: "available"}, {Name: "us-east-1b", Status: "impaired"}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	zones, err := c.ListAvailabilityZones(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, zones, 2)
+	assert.Equal(t, "us-east-1b", zones[1].Name)
+	assert.Equal(t, "impaired", zones[1].Status)
+}
+
+func TestAvailabilityZonePattern(t *testing.T) {
+	for _, zone := range []string{"us-east-1a", "eu-central-2c", "ap-southeast-10b"} {
+		assert.True(t, AvailabilityZonePattern.MatchString(zone), zone)
+	}
+	for _, zone := range []string{"us-east-1", "us-east1a", "US-EAST-1A", "useast-1a", "us-east-1a "} {
+		assert.False(t, AvailabilityZonePattern.MatchString(zone), zone)
+	}
+}
+
+func TestClientListGPUTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gpu-types", r.URL.Path)
+
+		data, err := json.Marshal([]GPUType{{
+			Name:         "nvidia-a100",
+			MemoryGB:     80,
+			Availability: []GPUTypeAvailability{{Region: "us-east-1", AvailabilityZone: "us-east-1a", Available: 16}},
+		}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	gpuTypes, err := c.ListGPUTypes(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, gpuTypes, 1)
+	assert.Equal(t, 16, gpuTypes[0].Availability[0].Available)
+}
+
+func TestClientListGPUTypesUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	gpuTypes, err := c.ListGPUTypes(context.Background())
+
+	assert.NoError(t, err)
+	assert.Nil(t, gpuTypes)
+}
+
+func TestClientTrailingSlashEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/vpcs/"+testVpcID, r.URL.Path)
+
+		data, err := json.Marshal(VPC{ID: testVpcID, Name: testVpcName})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL+"//", testKey)
+	vpc, err := c.GetVPC(context.Background(), testVpcID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, vpc)
+	assert.Equal(t, testVpcID, vpc.ID)
+}
+
+func TestNormalizeEndpoint(t *testing.T) {
+	valid := map[string]string{
+		"https://api.thecloud.dev":      "https://api.thecloud.dev",
+		"https://api.thecloud.dev/":     "https://api.thecloud.dev",
+		"http://localhost:8080//":       "http://localhost:8080",
+		"https://api.thecloud.dev/v1/":  "https://api.thecloud.dev/v1",
+		" https://api.thecloud.dev/v1 ": "https://api.thecloud.dev/v1",
+	}
+	for in, want := range valid {
+		got, err := NormalizeEndpoint(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	invalid := []string{
+		"",
+		"api.thecloud.dev",
+		"ftp://api.thecloud.dev",
+		"https://",
+		"https://api.thecloud.dev/?debug=1",
+		"://bad",
+	}
+	for _, in := range invalid {
+		_, err := NormalizeEndpoint(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRequestTimeout(20*time.Millisecond), WithRetryMax(0))
+	err := c.DeleteVPC(context.Background(), testVpcID)
+
+	assert.Error(t, err)
+}
+
+func TestClientBucketLifecycle(t *testing.T) {
+	rules := []BucketLifecycleRule{{ID: "logs", Prefix: "logs/", ExpirationDays: 30, Enabled: true}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storage/buckets/assets/lifecycle", r.URL.Path)
+
+		switch r.Method {
+		case "PUT":
+			var body bucketLifecycle
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, rules, body.Rules)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			data, err := json.Marshal(bucketLifecycle{Rules: rules})
+			assert.NoError(t, err)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.PutBucketLifecycle(context.Background(), "assets", rules))
+
+	got, err := c.GetBucketLifecycle(context.Background(), "assets")
+	assert.NoError(t, err)
+	assert.Equal(t, rules, got)
+}
+
+func TestClientGetBucketLifecycleNotConfigured(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	got, err := c.GetBucketLifecycle(context.Background(), "assets")
+
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	assert.NotNil(t, got)
+}
+
+func TestClientSetBucketAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storage/buckets/assets", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"is_public": true}, body)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.SetBucketAccess(context.Background(), "assets", true)
+
+	assert.NoError(t, err)
+}
+
+func TestClientBucketCORS(t *testing.T) {
+	rules := []BucketCORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: 600}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/storage/buckets/assets/cors", r.URL.Path)
+
+		switch r.Method {
+		case "PUT":
+			var body bucketCORS
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, rules, body.Rules)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			data, err := json.Marshal(bucketCORS{Rules: rules})
+			assert.NoError(t, err)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.PutBucketCORS(context.Background(), "assets", rules))
+
+	got, err := c.GetBucketCORS(context.Background(), "assets")
+	assert.NoError(t, err)
+	assert.Equal(t, rules, got)
+}
+
+func TestClientUpdateFunctionCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/functions/fn-123/code", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		file, _, err := r.FormFile("code")
+		assert.NoError(t, err)
+		code, err := io.ReadAll(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "zip-bytes", string(code))
+
+		data, err := json.Marshal(Function{ID: "fn-123", Status: "deploying"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	fn, err := c.UpdateFunctionCode(context.Background(), "fn-123", []byte("zip-bytes"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "deploying", fn.Status)
+}
+
+func TestClientUpdateFunction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/functions/fn-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"runtime": "python3.12", "handler": "main.handle"}, body)
+
+		data, err := json.Marshal(Function{ID: "fn-123", Runtime: "python3.12", Handler: "main.handle"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	fn, err := c.UpdateFunction(context.Background(), "fn-123", "python3.12", "main.handle")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "main.handle", fn.Handler)
+}
+
+func TestClientUpdateDNSZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/dns/zones/zone-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"description": "internal services"}, body)
+
+		data, err := json.Marshal(DNSZone{ID: "zone-123", Name: "example.internal", Description: "internal services", Status: "active"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	zone, err := c.UpdateDNSZone(context.Background(), "zone-123", "internal services")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "internal services", zone.Description)
+}
+
+func TestClientUpdateGlobalLB(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/global-lb/glb-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		// Only the fields being changed are sent
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"routing_policy": "FAILOVER"}, body)
+
+		data, err := json.Marshal(GlobalLB{ID: "glb-123", Policy: "FAILOVER", Status: "active"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	policy := "FAILOVER"
+	glb, err := c.UpdateGlobalLB(context.Background(), "glb-123", UpdateGlobalLBRequest{Policy: &policy})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "FAILOVER", glb.Policy)
+}
+
+func TestClientUnbindGlobalIP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// An empty ID is sent to unbind rather than omitted
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"global_ip_id": ""}, body)
+
+		data, err := json.Marshal(GlobalLB{ID: "glb-123", Status: "active"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	unbind := ""
+	_, err := c.UpdateGlobalLB(context.Background(), "glb-123", UpdateGlobalLBRequest{GlobalIPID: &unbind})
+
+	assert.NoError(t, err)
+}
+
+func TestClientGlobalIP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/global-ips":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"description": "edge"}, body)
+
+			data, err := json.Marshal(GlobalIP{ID: "gip-1", Address: "198.51.100.7", Description: "edge", Status: "available"})
+			assert.NoError(t, err)
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		case r.Method == "DELETE" && r.URL.Path == "/global-ips/gip-1":
+			w.WriteHeader(http.StatusConflict)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Error: &APIError{Code: "RESOURCE_IN_USE", Message: "global ip is bound"}}))
+		case r.Method == "GET" && r.URL.Path == "/global-ips/gip-2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+	ctx := context.Background()
+
+	ip, err := c.AllocateGlobalIP(ctx, "edge")
+	assert.NoError(t, err)
+	assert.Equal(t, "198.51.100.7", ip.Address)
+
+	var apiErr *APIError
+	err = c.ReleaseGlobalIP(ctx, "gip-1")
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, http.StatusConflict, apiErr.Status)
+	}
+
+	missing, err := c.GetGlobalIP(ctx, "gip-2")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestClientUpdateGlobalEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/global-lb/glb-123/endpoints/ep-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		// A zero weight is sent rather than omitted
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"weight": float64(0)}, body)
+
+		data, err := json.Marshal(GlobalEndpoint{ID: "ep-1", Weight: 0, Priority: 1, Healthy: true})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	weight := 0
+	ep, err := c.UpdateGlobalEndpoint(context.Background(), "glb-123", "ep-1", UpdateGlobalEndpointRequest{Weight: &weight})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, ep.Weight)
+	assert.Equal(t, 1, ep.Priority)
+}
+
+func TestClientCreateGatewayRouteAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gateway/routes", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["auth_required"])
+		assert.Equal(t, []interface{}{"key-1"}, body["allowed_api_key_ids"])
+		assert.Equal(t, map[string]interface{}{"X-Tenant": "acme"}, body["request_headers"])
+
+		data, err := json.Marshal(GatewayRoute{ID: "route-123", AuthRequired: true, AllowedAPIKeyIDs: []string{"key-1"}, RequestHeaders: map[string]string{"X-Tenant": "acme"}})
+		assert.NoError(t, err)
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	route, err := c.CreateGatewayRoute(context.Background(), CreateRouteRequest{
+		Name:             "api",
+		PathPrefix:       "/api",
+		TargetURL:        "http://backend:8080",
+		AuthRequired:     true,
+		AllowedAPIKeyIDs: []string{"key-1"},
+		RequestHeaders:   map[string]string{"X-Tenant": "acme"},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, route.AuthRequired)
+	assert.Equal(t, map[string]string{"X-Tenant": "acme"}, route.RequestHeaders)
+}
+
+func TestClientUpdateGatewayRoute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gateway/routes/route-123", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+
+		var body GatewayRoute
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "/api", body.PathPrefix)
+		assert.Equal(t, 50, body.RateLimit)
+
+		body.ID = "route-123"
+		data, err := json.Marshal(body)
+		assert.NoError(t, err)
+		w.Header().Set("ETag", `"v2"`)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	route := GatewayRoute{Name: "api", PathPrefix: "/api", TargetURL: "http://backend:8080", Methods: []string{"GET"}, RateLimit: 50}
+	route.ETag = `"v1"`
+	res, err := c.UpdateGatewayRoute(context.Background(), "route-123", route)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50, res.RateLimit)
+	assert.Equal(t, `"v2"`, res.ETag)
+}
+
+func TestClientRolloutDeployment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/containers/deployments/dep-123/rollout", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"image":        "nginx:1.27",
+			"ports":        "80:8080",
+			"env":          map[string]interface{}{"LOG_LEVEL": "debug"},
+			"health_check": map[string]interface{}{"path": "/healthz", "port": float64(8080)},
+		}, body)
+
+		data, err := json.Marshal(Deployment{ID: "dep-123", Image: "nginx:1.27", Ports: "80:8080", Status: "updating", Revision: 2})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	dep, err := c.RolloutDeployment(context.Background(), "dep-123", RolloutDeploymentRequest{
+		Image:       "nginx:1.27",
+		Ports:       "80:8080",
+		Env:         map[string]string{"LOG_LEVEL": "debug"},
+		HealthCheck: &DeploymentHealthCheck{Path: "/healthz", Port: 8080},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dep.Revision)
+}
+
+func TestClientUpdateQueue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/queues/q-123", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"visibility_timeout": float64(60),
+			"redrive_policy":     map[string]interface{}{"dead_letter_queue_id": "q-dlq", "max_receive_count": float64(5)},
+		}, body)
+
+		data, err := json.Marshal(Queue{ID: "q-123", VisibilityTimeout: 60, RedrivePolicy: &RedrivePolicy{DeadLetterQueueID: "q-dlq", MaxReceiveCount: 5}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	timeout := 60
+	opts := UpdateQueueOptions{
+		VisibilityTimeout: &timeout,
+		RedrivePolicy:     &RedrivePolicy{DeadLetterQueueID: "q-dlq", MaxReceiveCount: 5},
+	}
+	opts.ETag = `"v1"`
+	q, err := c.UpdateQueue(context.Background(), "q-123", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 60, q.VisibilityTimeout)
+	assert.Equal(t, "q-dlq", q.RedrivePolicy.DeadLetterQueueID)
+}
+
+func TestClientUpdateQueueRemovesRedrivePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"redrive_policy": nil}, body)
+
+		data, err := json.Marshal(Queue{ID: "q-123"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	q, err := c.UpdateQueue(context.Background(), "q-123", UpdateQueueOptions{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, q.RedrivePolicy)
+}
+
+func TestClientStartQueueRedrive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/queues/q-dlq/redrive", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"destination_queue_id": "q-123"}, body)
+
+		data, err := json.Marshal(QueueRedriveTask{ID: "rd-1", SourceQueueID: "q-dlq", DestinationQueueID: "q-123", Status: "pending"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	task, err := c.StartQueueRedrive(context.Background(), "q-dlq", "q-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "rd-1", task.ID)
+	assert.Equal(t, "pending", task.Status)
+}
+
+func TestClientRestoreSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/snapshots/snap-123/restore", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"name": "restored", "size_gb": float64(20)}, body)
+
+		data, err := json.Marshal(Volume{ID: "vol-456", Name: "restored", SizeGB: 20, Status: "AVAILABLE"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	vol, err := c.RestoreSnapshot(context.Background(), "snap-123", "restored", 20)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "vol-456", vol.ID)
+	assert.Equal(t, 20, vol.SizeGB)
+}
+
+func TestClientUpdateSnapshotPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/snapshots/policies/sp-123", r.URL.Path)
+		assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"schedule": "0 4 * * *", "retention_count": float64(14), "enabled": false}, body)
+
+		data, err := json.Marshal(SnapshotPolicy{ID: "sp-123", VolumeID: "vol-1", Schedule: "0 4 * * *", RetentionCount: 14})
+		assert.NoError(t, err)
+		w.Header().Set("ETag", `"v2"`)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	req := UpdateSnapshotPolicyRequest{Schedule: "0 4 * * *", RetentionCount: 14}
+	req.ETag = `"v1"`
+	policy, err := c.UpdateSnapshotPolicy(context.Background(), "sp-123", req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 14, policy.RetentionCount)
+	assert.Equal(t, `"v2"`, policy.ETag)
+}
+
+func TestClientStopStartInstance(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		calls = append(calls, r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	assert.NoError(t, c.StopInstance(context.Background(), "inst-123"))
+	assert.NoError(t, c.StartInstance(context.Background(), "inst-123"))
+
+	assert.Equal(t, []string{"/instances/inst-123/stop", "/instances/inst-123/start"}, calls)
+}
+
+func TestClientCreateNATGateway(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/nat-gateways", r.URL.Path)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"subnet_id": "subnet-1", "elastic_ip_id": "eip-1"}, body)
+
+		data, err := json.Marshal(NATGateway{ID: "nat-1", SubnetID: "subnet-1", ElasticIPID: "eip-1", Status: "pending"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	nat, err := c.CreateNATGateway(context.Background(), "subnet-1", "eip-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "nat-1", nat.ID)
+	assert.Equal(t, "pending", nat.Status)
+}
+
+func TestClientAcceptVPCPeering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/vpc-peerings/pcx-1/accept", r.URL.Path)
+
+		data, err := json.Marshal(VPCPeering{ID: "pcx-1", VpcID: "vpc-a", PeerVpcID: "vpc-b", Status: "provisioning"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	peering, err := c.AcceptVPCPeering(context.Background(), "pcx-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "provisioning", peering.Status)
+}
+
+func TestClientDeleteNotFound(t *testing.T) {
+	bodies := []string{
+		`{"error":{"type":"not_found","message":"instance not found","code":"NOT_FOUND"}}`,
+		`{"error":"vpc not found"}`,
+		`not found`,
+	}
+	for _, body := range bodies {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(body))
+		}))
+
+		c := NewClient(server.URL, testKey, WithRetryMax(0))
+		assert.NoError(t, c.DeleteVPC(context.Background(), testVpcID), body)
+		assert.NoError(t, c.DeleteInstance(context.Background(), "inst-1"), body)
+
+		server.Close()
+	}
+}
+
+func TestErrNotFound(t *testing.T) {
+	assert.ErrorIs(t, &APIError{Status: http.StatusNotFound, Message: "gone"}, ErrNotFound)
+	assert.ErrorIs(t, fmt.Errorf("wrapped: %w", &APIError{Status: http.StatusBadRequest, Code: "NOT_FOUND"}), ErrNotFound)
+	assert.NotErrorIs(t, &APIError{Status: http.StatusConflict, Message: "in use"}, ErrNotFound)
+}
+
+func TestClientListScalingGroupInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/autoscaling/groups/asg-gone/instances" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "/autoscaling/groups/asg-1/instances", r.URL.Path)
+
+		data, err := json.Marshal([]ScalingGroupInstance{{ID: "inst-1", IPAddress: "10.0.1.5", Status: "running"}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	instances, err := c.ListScalingGroupInstances(context.Background(), "asg-1")
+	assert.NoError(t, err)
+	assert.Len(t, instances, 1)
+	assert.Equal(t, "10.0.1.5", instances[0].IPAddress)
+
+	instances, err = c.ListScalingGroupInstances(context.Background(), "asg-gone")
+	assert.NoError(t, err)
+	assert.NotNil(t, instances)
+	assert.Empty(t, instances)
+}
+
+func TestClientUpdateNodePool(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/clusters/cl-1/node-pools/np-1", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(5), body["worker_count"])
+		assert.Equal(t, map[string]interface{}{}, body["labels"])
+
+		data, err := json.Marshal(NodePool{ID: "np-1", ClusterID: "cl-1", Name: "workers", WorkerCount: 5, Status: "scaling"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	workers := 5
+	pool, err := c.UpdateNodePool(context.Background(), "cl-1", "np-1", UpdateNodePoolRequest{WorkerCount: &workers, Labels: map[string]string{}})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, pool.WorkerCount)
+	assert.Equal(t, "scaling", pool.Status)
+}
+
+func TestClientUpdateLoadBalancerTLS(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/lb/lb-123", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+
+		data, err := json.Marshal(LoadBalancer{ID: "lb-123", Port: 443, Status: "ACTIVE"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	_, err := c.UpdateLoadBalancerTLS(context.Background(), "lb-123", &LBTLS{CertificateID: "cert-2", MinTLSVersion: "1.3"})
+	assert.NoError(t, err)
+
+	// Removing TLS sends an explicit null
+	_, err = c.UpdateLoadBalancerTLS(context.Background(), "lb-123", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{
+		{"tls": map[string]interface{}{"certificate_id": "cert-2", "min_tls_version": "1.3"}},
+		{"tls": nil},
+	}, bodies)
+}
+
+func TestClientFunctionTrigger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/functions/fn-123/triggers":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"type": "http", "path": "/orders", "methods": []interface{}{"POST"}}, body)
+
+			data, err := json.Marshal(FunctionTrigger{ID: "trg-1", Type: "http", Path: "/orders", URL: "https://fn.example.com/orders"})
+			assert.NoError(t, err)
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		case r.Method == "GET" && r.URL.Path == "/functions/fn-123/triggers/trg-2":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "DELETE" && r.URL.Path == "/functions/fn-123/triggers/trg-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	ctx := context.Background()
+
+	trigger, err := c.CreateFunctionTrigger(ctx, "fn-123", FunctionTrigger{Type: "http", Path: "/orders", Methods: []string{"POST"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://fn.example.com/orders", trigger.URL)
+
+	missing, err := c.GetFunctionTrigger(ctx, "fn-123", "trg-2")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.NoError(t, c.DeleteFunctionTrigger(ctx, "fn-123", "trg-1"))
+}
+
+func TestClientFunctionAlias(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/functions/fn-123/aliases":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"name": "live", "routing": map[string]interface{}{"1": float64(90), "2": float64(10)}}, body)
+
+			data, err := json.Marshal(FunctionAlias{Name: "live", Routing: map[string]int{"1": 90, "2": 10}, InvokeURL: "https://fn.example.com/live"})
+			assert.NoError(t, err)
+			w.WriteHeader(http.StatusCreated)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		case r.Method == "PATCH" && r.URL.Path == "/functions/fn-123/aliases/live":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"routing": map[string]interface{}{"2": float64(100)}}, body)
+
+			data, err := json.Marshal(FunctionAlias{Name: "live", Routing: map[string]int{"2": 100}})
+			assert.NoError(t, err)
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+		case r.Method == "GET" && r.URL.Path == "/functions/fn-123/aliases/canary":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "DELETE" && r.URL.Path == "/functions/fn-123/aliases/live":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	ctx := context.Background()
+
+	alias, err := c.CreateFunctionAlias(ctx, "fn-123", "live", map[string]int{"1": 90, "2": 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://fn.example.com/live", alias.InvokeURL)
+
+	alias, err = c.UpdateFunctionAliasRouting(ctx, "fn-123", "live", map[string]int{"2": 100})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"2": 100}, alias.Routing)
+
+	missing, err := c.GetFunctionAlias(ctx, "fn-123", "canary")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.NoError(t, c.DeleteFunctionAlias(ctx, "fn-123", "live"))
+}
+
+func TestClientInvokeFunction(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want string
+	}{
+		"json response":  {body: `{"ok":true}`, want: `{"ok":true}`},
+		"plain response": {body: `"healthy"`, want: "healthy"},
+		"no response":    {body: ``, want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/functions/fn-123/invoke", r.URL.Path)
+				assert.Equal(t, "POST", r.Method)
+
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"payload": `{"ping":1}`}, body)
+
+				data := `{"status_code":200,"duration_ms":42}`
+				if tt.body != "" {
+					data = fmt.Sprintf(`{"status_code":200,"duration_ms":42,"body":%s}`, tt.body)
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: json.RawMessage(data)}))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, testKey)
+			inv, err := c.InvokeFunction(context.Background(), "fn-123", `{"ping":1}`)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 200, inv.StatusCode)
+			assert.Equal(t, int64(42), inv.DurationMS)
+			assert.Equal(t, tt.want, inv.BodyString())
+		})
+	}
+}
+
+func TestClientUploadImageInParts(t *testing.T) {
+	defer func(size int64) { imageUploadPartSize = size }(imageUploadPartSize)
+	imageUploadPartSize = 4
+
+	var parts []string
+	completed := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/images/img-123/upload":
+			assert.Equal(t, fmt.Sprint(len(parts)+1), r.URL.Query().Get("part"))
+			assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+			data, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			parts = append(parts, string(data))
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "POST" && r.URL.Path == "/images/img-123/upload/complete":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"parts": float64(3)}, body)
+			completed = true
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	var progress []int64
+	c := NewClient(server.URL, testKey)
+	err := c.UploadImage(context.Background(), "img-123", strings.NewReader("0123456789"), 10, func(uploaded, total int64) {
+		assert.Equal(t, int64(10), total)
+		progress = append(progress, uploaded)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0123", "4567", "89"}, parts)
+	assert.Equal(t, []int64{4, 8, 10}, progress)
+	assert.True(t, completed)
+}
+
+func TestClientUploadImagePartFails(t *testing.T) {
+	defer func(size int64) { imageUploadPartSize = size }(imageUploadPartSize)
+	imageUploadPartSize = 4
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("part") == "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "corrupt part"}))
+			return
+		}
+		if r.URL.Path != "/images/img-123/upload" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	err := c.UploadImage(context.Background(), "img-123", strings.NewReader("0123456789"), 10, nil)
+
+	assert.ErrorContains(t, err, "uploading image part 2")
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+}
+
+func TestClientListAuditEvents(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/audit/events", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+		queries = append(queries, r.URL.RawQuery)
+
+		data := `[{"id":"ev-1","actor":"alice","action":"create","details":{"size":"small"}},{"id":"ev-2","actor":"bob","action":"create"}]`
+		next := "p2"
+		if r.URL.Query().Get("cursor") == "p2" {
+			data = `[{"id":"ev-3","actor":"alice","action":"create"},{"id":"ev-4","actor":"alice","action":"create"}]`
+			next = "p3"
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: json.RawMessage(data), NextPage: next}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	events, err := c.ListAuditEvents(context.Background(), AuditEventFilter{
+		Since:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		ResourceType: "instance",
+		Action:       "create",
+		Limit:        3,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, "ev-3", events[2].ID)
+	assert.JSONEq(t, `{"size":"small"}`, string(events[0].Details))
+	assert.Empty(t, events[1].Details)
+
+	// Paging stops once the limit is reached
+	assert.Equal(t, []string{
+		"action=create&limit=3&resource_type=instance&since=2026-01-02T03%3A04%3A05Z",
+		"action=create&limit=3&resource_type=instance&since=2026-01-02T03%3A04%3A05Z&cursor=p2",
+	}, queries)
+}
+
+func TestClientSetInstanceLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/instances/i-1", r.URL.Path)
+		assert.Equal(t, "PATCH", r.Method)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		// A nil map is sent as an empty one so every label is removed
+		assert.Equal(t, map[string]interface{}{"labels": map[string]interface{}{}}, body)
+
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: json.RawMessage(`{"id":"i-1"}`)}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	instance, err := c.SetInstanceLabels(context.Background(), "i-1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1", instance.ID)
+	assert.Empty(t, instance.Labels)
+}
+
+func TestClientBucketWebsite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/storage/buckets/assets/website":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"index_document": "index.html"}, body)
+
+			data := `{"index_document":"index.html","endpoint":"assets.website.thecloud.dev"}`
+			assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: json.RawMessage(data)}))
+		case r.Method == "GET" && r.URL.Path == "/storage/buckets/plain/website":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "DELETE" && r.URL.Path == "/storage/buckets/assets/website":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	ctx := context.Background()
+
+	website, err := c.PutBucketWebsite(ctx, "assets", BucketWebsite{IndexDocument: "index.html"})
+	assert.NoError(t, err)
+	assert.Equal(t, "assets.website.thecloud.dev", website.Endpoint)
+
+	missing, err := c.GetBucketWebsite(ctx, "plain")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.NoError(t, c.DeleteBucketWebsite(ctx, "assets"))
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultConsistencyRetries is the number of times a just created resource that reads as
+// missing is read again.
+const defaultConsistencyRetries = 3
+
+// consistencyRetryWindow is the time over which the retries of GetConsistent are spread.
+var consistencyRetryWindow = 5 * time.Second
+
+// WithConsistencyRetries sets how many times GetConsistent reads a just created resource
+// again when the API reports it missing. Zero disables the retries.
+func WithConsistencyRetries(retries int) Option {
+	return func(c *Client) {
+		c.consistencyRetries = retries
+	}
+}
+
+// GetConsistent calls get until it finds the resource. Reads are eventually consistent,
+// so for a second or two after a create the API may report the new resource as missing.
+// A resource still missing after the configured retries is reported missing (nil).
+// Only use this for resources known to exist recently, as a resource that is really gone
+// delays the caller for the whole retry window.
+func GetConsistent[T any](ctx context.Context, c *Client, get func(ctx context.Context) (*T, error)) (*T, error) {
+	res, err := get(ctx)
+	if err != nil || res != nil || c.consistencyRetries <= 0 {
+		return res, err
+	}
+
+	wait := consistencyRetryWindow / time.Duration(c.consistencyRetries)
+	for attempt := 1; attempt <= c.consistencyRetries; attempt++ {
+		tflog.Debug(ctx, "just created resource not found, reading it again", map[string]interface{}{
+			"attempt": attempt,
+			"wait_ms": wait.Milliseconds(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		res, err = get(ctx)
+		if err != nil || res != nil {
+			return res, err
+		}
+	}
+
+	return nil, nil // nolint:nilnil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetConsistent(t *testing.T) {
+	defer func(d time.Duration) { consistencyRetryWindow = d }(consistencyRetryWindow)
+	consistencyRetryWindow = 30 * time.Millisecond
+
+	tests := map[string]struct {
+		retries   int
+		missing   int
+		wantFound bool
+		wantCalls int
+	}{
+		"found at once":         {retries: 3, missing: 0, wantFound: true, wantCalls: 1},
+		"found after retries":   {retries: 3, missing: 2, wantFound: true, wantCalls: 3},
+		"missing after retries": {retries: 3, missing: 10, wantFound: false, wantCalls: 4},
+		"retries disabled":      {retries: 0, missing: 1, wantFound: false, wantCalls: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls <= tt.missing {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"id":"sg-1","name":"web"}}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, testKey, WithRetryMax(0), WithConsistencyRetries(tt.retries))
+			sg, err := GetConsistent(context.Background(), c, func(ctx context.Context) (*SecurityGroup, error) {
+				return c.GetSecurityGroup(ctx, "sg-1")
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFound, sg != nil)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeploymentLogEntry is a line logged by one of a deployment's containers.
+type DeploymentLogEntry struct {
+	Timestamp time.Time
+	Replica   string
+	Message   string
+}
+
+// DeploymentLogsOptions narrows the log lines returned by GetDeploymentLogs.
+// Zero values leave the limit to the API.
+type DeploymentLogsOptions struct {
+	// Tail is the number of most recent lines to return.
+	Tail int
+	// Since only returns lines logged within this long of now.
+	Since time.Duration
+}
+
+// WithLogsInErrors makes resources add the recent logs of a failed rollout to its error
+func WithLogsInErrors(enabled bool) Option {
+	return func(c *Client) {
+		c.logsInErrors = enabled
+	}
+}
+
+// IncludeLogsInErrors reports whether rollout errors should include the deployment's logs.
+func (c *Client) IncludeLogsInErrors() bool {
+	return c.logsInErrors
+}
+
+// GetDeploymentLogs returns the log lines of a deployment's containers, oldest first.
+// The API answers with plain text, one "<timestamp> <replica> <message>" line per entry.
+// A deployment that doesn't exist returns nil, one that hasn't logged anything an empty slice.
+func (c *Client) GetDeploymentLogs(ctx context.Context, id string, opts DeploymentLogsOptions) ([]DeploymentLogEntry, error) {
+	query := url.Values{}
+	if opts.Tail > 0 {
+		query.Set("tail", strconv.Itoa(opts.Tail))
+	}
+	if opts.Since > 0 {
+		query.Set("since", opts.Since.String())
+	}
+
+	path := fmt.Sprintf("/containers/deployments/%s/logs", id)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	res, err := c.doRaw(ctx, "GET", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status == http.StatusNotFound {
+		return nil, nil
+	}
+
+	entries := parseDeploymentLogs(string(res.Body))
+	if opts.Tail > 0 && len(entries) > opts.Tail {
+		entries = entries[len(entries)-opts.Tail:]
+	}
+	return entries, nil
+}
+
+// parseDeploymentLogs splits a logs response into entries. Lines that don't start with a
+// timestamp and replica, such as continuations of a multi-line message, are kept with
+// only their message set.
+func parseDeploymentLogs(body string) []DeploymentLogEntry {
+	entries := []DeploymentLogEntry{}
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) == 3 {
+			if ts, err := time.Parse(time.RFC3339Nano, fields[0]); err == nil {
+				entries = append(entries, DeploymentLogEntry{Timestamp: ts.UTC(), Replica: fields[1], Message: fields[2]})
+				continue
+			}
+		}
+
+		entries = append(entries, DeploymentLogEntry{Message: line})
+	}
+	return entries
+}
+
+// FormatDeploymentLogs renders entries back into the lines the API returned them as.
+func FormatDeploymentLogs(entries []DeploymentLogEntry) string {
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.Timestamp.IsZero() {
+			lines = append(lines, e.Message)
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", e.Timestamp.Format(time.RFC3339), e.Replica, e.Message))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientGetDeploymentLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		switch r.URL.Path {
+		case "/containers/deployments/dep-1/logs":
+			assert.Equal(t, "2", r.URL.Query().Get("tail"))
+			assert.Equal(t, "5m0s", r.URL.Query().Get("since"))
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("2024-01-02T15:04:05Z web-0 starting\n" + // nolint:errcheck
+				"2024-01-02T15:04:06.5Z web-1 panic: listen tcp :80: bind: address already in use\n" +
+				"\tgoroutine 1 [running]:\n"))
+		case "/containers/deployments/dep-2/logs":
+			assert.Empty(t, r.URL.RawQuery)
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"message": "forbidden", "code": "FORBIDDEN"}}`)) // nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	entries, err := c.GetDeploymentLogs(context.Background(), "dep-1", DeploymentLogsOptions{Tail: 2, Since: 5 * time.Minute})
+	assert.NoError(t, err)
+	// The API returned more lines than asked for, the last continuing the one before it
+	assert.Equal(t, []DeploymentLogEntry{
+		{
+			Timestamp: time.Date(2024, 1, 2, 15, 4, 6, 500000000, time.UTC),
+			Replica:   "web-1",
+			Message:   "panic: listen tcp :80: bind: address already in use",
+		},
+		{Message: "\tgoroutine 1 [running]:"},
+	}, entries)
+	assert.Equal(t, "2024-01-02T15:04:06Z web-1 panic: listen tcp :80: bind: address already in use\n\tgoroutine 1 [running]:", FormatDeploymentLogs(entries))
+
+	_, err = c.GetDeploymentLogs(context.Background(), "dep-2", DeploymentLogsOptions{})
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, http.StatusForbidden, apiErr.Status)
+	}
+
+	entries, err = c.GetDeploymentLogs(context.Background(), "missing", DeploymentLogsOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, entries)
+}
+
+func TestParseDeploymentLogsEmpty(t *testing.T) {
+	assert.Empty(t, parseDeploymentLogs(""))
+	assert.Empty(t, parseDeploymentLogs("\n"))
+}
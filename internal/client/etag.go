@@ -0,0 +1,34 @@
+package client
+
+import "context"
+
+// Versioned records the entity tag of the version of an object the API returned. Objects
+// embedding it can be updated conditionally, so concurrent changes aren't overwritten.
+type Versioned struct {
+	ETag string `json:"-"`
+}
+
+func (v *Versioned) setETag(etag string) {
+	v.ETag = etag
+}
+
+// etagSetter is implemented by response objects that record the ETag header.
+type etagSetter interface {
+	setETag(etag string)
+}
+
+type ifMatchKey struct{}
+
+// withIfMatch makes requests sent with ctx conditional on the object still being at etag.
+// An empty etag leaves the request unconditional.
+func withIfMatch(ctx context.Context, etag string) context.Context {
+	if etag == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+func ifMatchFromContext(ctx context.Context) string {
+	etag, _ := ctx.Value(ifMatchKey{}).(string)
+	return etag
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// versionedDNSRecordServer serves a single DNS record and rejects updates whose If-Match
+// doesn't name its current version.
+func versionedDNSRecordServer(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	version := 1
+	record := DNSRecord{ID: "rec-1", ZoneID: "zone-1", Name: "www", Type: "A", Content: "10.0.0.1", TTL: 300}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Equal(t, "/dns/records/rec-1", r.URL.Path)
+
+		if r.Method == http.MethodPut {
+			if match := r.Header.Get("If-Match"); match != "" && match != fmt.Sprintf(`"v%d"`, version) {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "etag mismatch"}))
+				return
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&record))
+			version++
+		}
+
+		data, err := json.Marshal(record)
+		assert.NoError(t, err)
+		w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, version))
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+}
+
+func TestClientRecordsETag(t *testing.T) {
+	server := versionedDNSRecordServer(t)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	record, err := c.GetDNSRecord(context.Background(), "rec-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `"v1"`, record.ETag)
+
+	record.Content = "10.0.0.2"
+	updated, err := c.UpdateDNSRecord(context.Background(), "rec-1", *record)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `"v2"`, updated.ETag)
+	assert.Equal(t, "10.0.0.2", updated.Content)
+}
+
+func TestClientUpdateRejectsConcurrentModification(t *testing.T) {
+	server := versionedDNSRecordServer(t)
+	defer server.Close()
+
+	ours := NewClient(server.URL, testKey)
+	theirs := NewClient(server.URL, testKey)
+
+	record, err := ours.GetDNSRecord(context.Background(), "rec-1")
+	assert.NoError(t, err)
+
+	// Someone else changes the record after we read it
+	other, err := theirs.GetDNSRecord(context.Background(), "rec-1")
+	assert.NoError(t, err)
+	other.Content = "10.0.0.3"
+	_, err = theirs.UpdateDNSRecord(context.Background(), "rec-1", *other)
+	assert.NoError(t, err)
+
+	record.Content = "10.0.0.2"
+	_, err = ours.UpdateDNSRecord(context.Background(), "rec-1", *record)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusPreconditionFailed, apiErr.Status)
+
+	// Without a recorded version the update is unconditional
+	record.ETag = ""
+	_, err = ours.UpdateDNSRecord(context.Background(), "rec-1", *record)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WithFailoverEndpoints adds standby endpoints that are tried, in order, when the
+// current endpoint can't be reached.
+func WithFailoverEndpoints(standbys ...string) Option {
+	return func(c *Client) {
+		for _, endpoint := range standbys {
+			if normalized, err := NormalizeEndpoint(endpoint); err == nil {
+				endpoint = normalized
+			}
+			c.standbys = append(c.standbys, endpoint)
+		}
+	}
+}
+
+// endpoints returns the primary endpoint followed by the standbys.
+func (c *Client) endpoints() []string {
+	return append([]string{c.Endpoint}, c.standbys...)
+}
+
+// currentEndpoint is the endpoint that last answered a request.
+func (c *Client) currentEndpoint() string {
+	endpoints := c.endpoints()
+	return endpoints[int(c.activeEndpoint.Load())%len(endpoints)]
+}
+
+// failoverTransport sends a request to the next endpoint when the current one fails
+// at the connection level. HTTP error responses are returned as they are, since any
+// endpoint would give the same answer. It sits below the retry layer, so a retry is
+// only made once every endpoint has been tried.
+type failoverTransport struct {
+	next   http.RoundTripper
+	client *Client
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoints := t.client.endpoints()
+	reqURL := req.URL.String()
+
+	// Find the endpoint the request was built against so the rest of the URL can be
+	// moved onto another endpoint.
+	builtFrom, current := "", 0
+	for i, endpoint := range endpoints {
+		if strings.HasPrefix(reqURL, endpoint) && len(endpoint) > len(builtFrom) {
+			builtFrom, current = endpoint, i
+		}
+	}
+	if builtFrom == "" {
+		return t.next.RoundTrip(req)
+	}
+	rest := strings.TrimPrefix(reqURL, builtFrom)
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close() // nolint:errcheck
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	var lastErr error
+	for n := 0; n < len(endpoints); n++ {
+		i := (current + n) % len(endpoints)
+
+		u, err := url.Parse(endpoints[i] + rest)
+		if err != nil {
+			return nil, err
+		}
+
+		attempt := req.Clone(req.Context())
+		attempt.URL = u
+		attempt.Host = ""
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.next.RoundTrip(attempt)
+		if err == nil {
+			if i != current {
+				tflog.Warn(req.Context(), "failed over to standby API endpoint", map[string]interface{}{"endpoint": endpoints[i]})
+			}
+			t.client.activeEndpoint.Store(int32(i))
+			return resp, nil
+		}
+
+		if !isConnectionError(err) {
+			return nil, err
+		}
+
+		tflog.Debug(req.Context(), "API endpoint unreachable", map[string]interface{}{"endpoint": endpoints[i], "error": err.Error()})
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// isConnectionError reports whether err means the endpoint could not be reached at all,
+// as opposed to a failure after the request was accepted.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var recordErr tls.RecordHeaderError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	return errors.As(err, &certErr) || errors.As(err, &recordErr) || errors.As(err, &authorityErr) || errors.As(err, &hostnameErr)
+}
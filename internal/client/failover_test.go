@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// deadEndpoint returns the URL of a server that has been shut down, so connecting
+// to it is refused.
+func deadEndpoint(t *testing.T) string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func TestClientFailsOverToLiveEndpoint(t *testing.T) {
+	var bodies []string
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		data, err := json.Marshal(VPC{ID: testVpcID, Name: testVpcName})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer live.Close()
+
+	c := NewClient(deadEndpoint(t), testKey, WithFailoverEndpoints(live.URL), WithRetryMax(0))
+
+	vpc, err := c.CreateVPC(context.Background(), testVpcName, "10.0.0.0/16")
+	assert.NoError(t, err)
+	assert.Equal(t, testVpcID, vpc.ID)
+	assert.Len(t, bodies, 1)
+	assert.Contains(t, bodies[0], testVpcName)
+
+	// The live endpoint is remembered for the following requests
+	assert.Equal(t, live.URL, c.currentEndpoint())
+	assert.Equal(t, live.URL+"/vpcs", c.BuildURL("/vpcs"))
+
+	_, err = c.GetVPC(context.Background(), testVpcID)
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 2)
+}
+
+func TestClientDoesNotFailOverOnHTTPErrors(t *testing.T) {
+	primaryCalls, standbyCalls := 0, 0
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		standbyCalls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer standby.Close()
+
+	c := NewClient(primary.URL, testKey, WithFailoverEndpoints(standby.URL), WithRetryMax(1), WithRetryWait(time.Millisecond, time.Millisecond))
+
+	_, err := c.GetVPC(context.Background(), testVpcID)
+	assert.Error(t, err)
+	assert.Equal(t, 2, primaryCalls)
+	assert.Equal(t, 0, standbyCalls)
+	assert.Equal(t, primary.URL, c.currentEndpoint())
+}
+
+func TestClientRetriesOncePerEndpointCycle(t *testing.T) {
+	dials := 0
+	c := NewClient(deadEndpoint(t), testKey, WithFailoverEndpoints(deadEndpoint(t)), WithRetryMax(2), WithRetryWait(time.Millisecond, time.Millisecond))
+
+	// Count the attempts reaching the network underneath the failover layer
+	retrying, ok := c.HTTPClient.Transport.(*retryablehttp.RoundTripper)
+	assert.True(t, ok)
+	failover, ok := retrying.Client.HTTPClient.Transport.(*failoverTransport)
+	assert.True(t, ok)
+	failover.next = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		dials++
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	})
+
+	_, err := c.GetVPC(context.Background(), testVpcID)
+	assert.Error(t, err)
+
+	// Three attempts from the retry layer, each trying both endpoints once
+	assert.Equal(t, 6, dials)
+}
+
+func TestIsConnectionError(t *testing.T) {
+	assert.True(t, isConnectionError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, isConnectionError(&net.DNSError{Err: "no such host", Name: "api.invalid"}))
+	assert.False(t, isConnectionError(&net.OpError{Op: "read", Err: errors.New("connection reset")}))
+	assert.False(t, isConnectionError(context.DeadlineExceeded))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
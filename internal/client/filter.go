@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ListFilter narrows a List call on the server. Empty fields are not sent, so the
+// zero value lists the whole collection.
+type ListFilter struct {
+	Name    string
+	VpcID   string
+	Status  string
+	OS      string
+	Version string
+}
+
+// filterFields are the values of a listed item that a ListFilter is matched against.
+type filterFields struct {
+	name    string
+	vpcID   string
+	status  string
+	os      string
+	version string
+
+	// Items without a VPC or status can't be matched client-side, so filtering by them is
+	// left to the API
+	noVpcID  bool
+	noStatus bool
+}
+
+func (f ListFilter) isZero() bool {
+	return f == ListFilter{}
+}
+
+func (f ListFilter) query() string {
+	v := url.Values{}
+	if f.Name != "" {
+		v.Set("name", f.Name)
+	}
+	if f.VpcID != "" {
+		v.Set("vpc_id", f.VpcID)
+	}
+	if f.Status != "" {
+		v.Set("status", f.Status)
+	}
+	if f.OS != "" {
+		v.Set("os", f.OS)
+	}
+	if f.Version != "" {
+		v.Set("version", f.Version)
+	}
+	if len(v) == 0 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+func (f ListFilter) matches(item filterFields) bool {
+	return (f.Name == "" || item.name == f.Name) &&
+		(f.VpcID == "" || item.noVpcID || item.vpcID == f.VpcID) &&
+		(f.Status == "" || item.noStatus || strings.EqualFold(item.status, f.Status)) &&
+		(f.OS == "" || strings.EqualFold(item.os, f.OS)) &&
+		(f.Version == "" || item.version == f.Version)
+}
+
+// listFiltered lists path with the filter sent as query parameters. The filter is
+// applied again to the response because endpoints that don't support a parameter
+// ignore it and return the full collection.
+func listFiltered[T any](ctx context.Context, c *Client, path string, filters []ListFilter, fields func(T) filterFields) ([]T, error) {
+	var f ListFilter
+	if len(filters) > 0 {
+		f = filters[0]
+	}
+
+	start := time.Now()
+
+	items, _, err := listAll[T](ctx, c, path+f.query())
+	if err != nil {
+		return nil, err
+	}
+
+	if f.isZero() {
+		return items, nil
+	}
+
+	var matched []T
+	for _, item := range items {
+		if f.matches(fields(item)) {
+			matched = append(matched, item)
+		}
+	}
+
+	logFields := map[string]interface{}{
+		"path":       path,
+		"filter":     f.query(),
+		"returned":   len(items),
+		"matched":    len(matched),
+		"elapsed_ms": time.Since(start).Milliseconds(),
+	}
+	if len(matched) != len(items) {
+		tflog.Debug(ctx, "API ignored list filter, filtered client-side", logFields)
+	} else {
+		tflog.Debug(ctx, "listed with server-side filter", logFields)
+	}
+
+	return matched, nil
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newInstancesServer serves count instances, honoring the name filter only when
+// supportsFilter is set.
+func newInstancesServer(t testing.TB, count int, supportsFilter bool, queries *[]string) *httptest.Server {
+	instances := make([]Instance, 0, count)
+	for i := 0; i < count; i++ {
+		instances = append(instances, Instance{ID: fmt.Sprintf("inst-%d", i), Name: fmt.Sprintf("web-%d", i), VpcID: testVpcID, Status: "RUNNING"})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if queries != nil {
+			*queries = append(*queries, r.URL.RawQuery)
+		}
+
+		result := instances
+		if name := r.URL.Query().Get("name"); supportsFilter && name != "" {
+			result = nil
+			for _, inst := range instances {
+				if inst.Name == name {
+					result = append(result, inst)
+				}
+			}
+		}
+
+		data, err := json.Marshal(result)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+}
+
+func TestListFilterQuery(t *testing.T) {
+	assert.Equal(t, "", ListFilter{}.query())
+	assert.Equal(t, "?name=web+1", ListFilter{Name: "web 1"}.query())
+	assert.Equal(t, "?name=web&status=running&vpc_id=vpc-1", ListFilter{Name: "web", VpcID: "vpc-1", Status: "running"}.query())
+	assert.Equal(t, "?os=ubuntu&version=22.04", ListFilter{OS: "ubuntu", Version: "22.04"}.query())
+}
+
+func TestClientListWithoutFilter(t *testing.T) {
+	var queries []string
+	server := newInstancesServer(t, 3, true, &queries)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	instances, err := c.ListInstances(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, instances, 3)
+	assert.Equal(t, []string{""}, queries)
+}
+
+func TestClientListServerSideFilter(t *testing.T) {
+	var queries []string
+	server := newInstancesServer(t, 3, true, &queries)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	instances, err := c.ListInstances(context.Background(), ListFilter{Name: "web-1", Status: "running"})
+
+	assert.NoError(t, err)
+	assert.Len(t, instances, 1)
+	assert.Equal(t, "inst-1", instances[0].ID)
+	assert.Equal(t, []string{"name=web-1&status=running"}, queries)
+}
+
+func TestClientListFilterIgnoredByAPI(t *testing.T) {
+	server := newInstancesServer(t, 3, false, nil)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	instances, err := c.ListInstances(context.Background(), ListFilter{Name: "web-2"})
+
+	assert.NoError(t, err)
+	assert.Len(t, instances, 1)
+	assert.Equal(t, "inst-2", instances[0].ID)
+
+	instances, err = c.ListInstances(context.Background(), ListFilter{Name: "missing"})
+	assert.NoError(t, err)
+	assert.Empty(t, instances)
+}
+
+func TestClientListImagesByOSAndVersion(t *testing.T) {
+	images := []Image{
+		{ID: "img-1", Name: "ubuntu-2204", OS: "ubuntu", Version: "22.04"},
+		{ID: "img-2", Name: "ubuntu-2404", OS: "Ubuntu", Version: "24.04"},
+		{ID: "img-3", Name: "debian-12", OS: "debian", Version: "12"},
+	}
+
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/images", r.URL.Path)
+		query = r.URL.RawQuery
+
+		// The API ignores the filter and returns every image
+		data, err := json.Marshal(images)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	found, err := c.ListImages(context.Background(), ListFilter{OS: "ubuntu", Version: "24.04"})
+	assert.NoError(t, err)
+	assert.Equal(t, "os=ubuntu&version=24.04", query)
+	assert.Len(t, found, 1)
+	assert.Equal(t, "img-2", found[0].ID)
+
+	found, err = c.ListImages(context.Background(), ListFilter{OS: "ubuntu"})
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+}
+
+func TestClientListSecurityGroupsByVPCAndName(t *testing.T) {
+	groups := []SecurityGroup{
+		{ID: "sg-1", VPCID: "vpc-1", Name: "web"},
+		{ID: "sg-2", VPCID: "vpc-2", Name: "web"},
+		{ID: "sg-3", VPCID: "vpc-1", Name: "db"},
+	}
+
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/security-groups", r.URL.Path)
+		query = r.URL.RawQuery
+
+		// The API ignores the filter and returns every group
+		data, err := json.Marshal(groups)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+
+	found, err := c.ListSecurityGroups(context.Background(), ListFilter{Name: "web", VpcID: "vpc-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "name=web&vpc_id=vpc-1", query)
+	assert.Len(t, found, 1)
+	assert.Equal(t, "sg-1", found[0].ID)
+}
+
+func TestClientListFilterFieldsOfItemsWithoutThem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The API ignores the filter and returns everything
+		var items interface{}
+		switch r.URL.Path {
+		case "/vpcs":
+			items = []VPC{{ID: "vpc-1", Name: "main", Status: "available"}, {ID: "vpc-2", Name: "main", Status: "deleting"}}
+		case "/volumes":
+			items = []Volume{{ID: "vol-1", Name: "data", Status: "available"}, {ID: "vol-2", Name: "data", Status: "in-use"}}
+		case "/gateway/routes":
+			items = []GatewayRoute{{ID: "route-1", Name: "api"}}
+		}
+		data, err := json.Marshal(items)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	ctx := context.Background()
+
+	vpcs, err := c.ListVPCs(ctx, ListFilter{VpcID: "vpc-2", Status: "DELETING"})
+	assert.NoError(t, err)
+	assert.Len(t, vpcs, 1)
+	assert.Equal(t, "vpc-2", vpcs[0].ID)
+
+	// Volumes have no VPC, so only their status is matched
+	volumes, err := c.ListVolumes(ctx, ListFilter{VpcID: "vpc-1", Status: "available"})
+	assert.NoError(t, err)
+	assert.Len(t, volumes, 1)
+	assert.Equal(t, "vol-1", volumes[0].ID)
+
+	routes, err := c.ListGatewayRoutes(ctx, ListFilter{Name: "api", VpcID: "vpc-1", Status: "active"})
+	assert.NoError(t, err)
+	assert.Len(t, routes, 1)
+}
+
+func benchmarkListInstancesByName(b *testing.B, supportsFilter bool) {
+	server := newInstancesServer(b, 5000, supportsFilter, nil)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		instances, err := c.ListInstances(ctx, ListFilter{Name: "web-4999"})
+		if err != nil || len(instances) != 1 {
+			b.Fatalf("unexpected result: %v, %d instances", err, len(instances))
+		}
+	}
+}
+
+func BenchmarkListInstancesServerSideFilter(b *testing.B) {
+	benchmarkListInstancesByName(b, true)
+}
+
+func BenchmarkListInstancesClientSideFilter(b *testing.B) {
+	benchmarkListInstancesByName(b, false)
+}
@@ -0,0 +1,433 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// update rewrites the golden files under testdata/golden from the decoded fixtures:
+//
+//	go test ./internal/client -run TestClientGetGolden -update
+var update = flag.Bool("update", false, "rewrite golden files")
+
+// getCase is a resource family's Get method and the API path it reads.
+type getCase struct {
+	name string
+	path string
+	get  func(c *Client, ctx context.Context) (interface{}, error)
+}
+
+// getCases lists one Get per resource family. Gets that are implemented on top of a
+// List call are covered by the pagination tests instead.
+var getCases = []getCase{
+	{"vpc", "/vpcs/vpc-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetVPC(ctx, "vpc-123") }},
+	{"subnet", "/subnets/subnet-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetSubnet(ctx, "subnet-123") }},
+	{"instance", "/instances/inst-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetInstance(ctx, "inst-123") }},
+	{"volume", "/volumes/vol-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetVolume(ctx, "vol-123") }},
+	{"load_balancer", "/lb/lb-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetLoadBalancer(ctx, "lb-123") }},
+	{"database", "/databases/db-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetDatabase(ctx, "db-123") }},
+	{"cache", "/caches/cache-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetCache(ctx, "cache-123") }},
+	{"queue", "/queues/q-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetQueue(ctx, "q-123") }},
+	{"function", "/functions/fn-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetFunction(ctx, "fn-123") }},
+	{"cluster", "/clusters/cls-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetCluster(ctx, "cls-123") }},
+	{"global_lb", "/global-lb/glb-123", func(c *Client, ctx context.Context) (interface{}, error) { return c.GetGlobalLB(ctx, "glb-123") }},
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return b
+}
+
+// fixtureServer answers tc.path with body and status. Databases and caches also
+// fetch their connection string, which is answered separately.
+func fixtureServer(t *testing.T, tc getCase, status int, body []byte) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		if r.URL.Path == tc.path+"/connection" {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": {"connection_string": "conn://` + tc.name + `"}}`)) // nolint:errcheck
+			return
+		}
+
+		assert.Equal(t, tc.path, r.URL.Path)
+		w.WriteHeader(status)
+		w.Write(body) // nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL, testKey, WithRetryMax(0))
+}
+
+func TestClientGetGolden(t *testing.T) {
+	for _, tc := range getCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("success", func(t *testing.T) {
+				c := fixtureServer(t, tc, http.StatusOK, readFixture(t, filepath.Join("responses", tc.name+".json")))
+
+				got, err := tc.get(c, context.Background())
+				require.NoError(t, err)
+
+				decoded, err := json.MarshalIndent(got, "", "  ")
+				require.NoError(t, err)
+				decoded = append(decoded, '\n')
+
+				golden := filepath.Join("testdata", "golden", tc.name+".json")
+				if *update {
+					require.NoError(t, os.WriteFile(golden, decoded, 0o644))
+				}
+
+				want, err := os.ReadFile(golden)
+				require.NoError(t, err, "run with -update to create the golden file")
+				assert.Equal(t, string(want), string(decoded))
+			})
+
+			t.Run("error as string", func(t *testing.T) {
+				c := fixtureServer(t, tc, http.StatusForbidden, readFixture(t, "errors/string.json"))
+
+				got, err := tc.get(c, context.Background())
+				assert.Nil(t, got)
+
+				var apiErr *APIError
+				require.True(t, errors.As(err, &apiErr), "got %v", err)
+				assert.Equal(t, http.StatusForbidden, apiErr.Status)
+				assert.Equal(t, "forbidden", apiErr.Message)
+			})
+
+			t.Run("error as object", func(t *testing.T) {
+				c := fixtureServer(t, tc, http.StatusUnprocessableEntity, readFixture(t, "errors/object.json"))
+
+				got, err := tc.get(c, context.Background())
+				assert.Nil(t, got)
+
+				var apiErr *APIError
+				require.True(t, errors.As(err, &apiErr), "got %v", err)
+				assert.Equal(t, http.StatusUnprocessableEntity, apiErr.Status)
+				assert.Equal(t, "validation_error", apiErr.Type)
+				assert.Equal(t, "cidr overlaps vpc-456", apiErr.Message)
+				assert.Equal(t, "CIDR_OVERLAP", apiErr.Code)
+			})
+
+			t.Run("not found", func(t *testing.T) {
+				c := fixtureServer(t, tc, http.StatusNotFound, readFixture(t, "errors/not_found.json"))
+
+				got, err := tc.get(c, context.Background())
+				assert.NoError(t, err)
+				assert.Nil(t, got)
+			})
+
+			t.Run("malformed json", func(t *testing.T) {
+				c := fixtureServer(t, tc, http.StatusOK, readFixture(t, "errors/malformed.json"))
+
+				got, err := tc.get(c, context.Background())
+				assert.Nil(t, got)
+				assert.ErrorContains(t, err, "failed to decode response")
+			})
+		})
+	}
+}
+
+func TestClientDoContextCanceled(t *testing.T) {
+	received := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetVPC(ctx, "vpc-123")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not aborted when its context was canceled")
+	}
+}
+
+// TestClientMethodsReturnAPIErrors checks that every exported method that calls the
+// API surfaces an error response instead of swallowing it.
+func TestClientMethodsReturnAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error": {"message": "boom", "code": "INTERNAL"}}`)) // nolint:errcheck
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+	ctx := context.Background()
+
+	// Each call discards its result; only the error matters here.
+	calls := map[string]func() error{
+		"CreateVPC":                   func() error { _, err := c.CreateVPC(ctx, "n", testCIDR); return err },
+		"GetVPC":                      func() error { _, err := c.GetVPC(ctx, "id"); return err },
+		"DeleteVPC":                   func() error { return c.DeleteVPC(ctx, "id") },
+		"ListVPCs":                    func() error { _, err := c.ListVPCs(ctx); return err },
+		"CreateVPCPeering":            func() error { _, err := c.CreateVPCPeering(ctx, "a", "b"); return err },
+		"GetVPCPeering":               func() error { _, err := c.GetVPCPeering(ctx, "id"); return err },
+		"AcceptVPCPeering":            func() error { _, err := c.AcceptVPCPeering(ctx, "id"); return err },
+		"DeleteVPCPeering":            func() error { return c.DeleteVPCPeering(ctx, "id") },
+		"CreateInstance":              func() error { _, err := c.CreateInstance(ctx, LaunchInstanceRequest{}); return err },
+		"GetInstance":                 func() error { _, err := c.GetInstance(ctx, "id"); return err },
+		"ListInstances":               func() error { _, err := c.ListInstances(ctx); return err },
+		"SetInstanceLabels":           func() error { _, err := c.SetInstanceLabels(ctx, "id", nil); return err },
+		"DeleteInstance":              func() error { return c.DeleteInstance(ctx, "id") },
+		"StopInstance":                func() error { return c.StopInstance(ctx, "id") },
+		"StartInstance":               func() error { return c.StartInstance(ctx, "id") },
+		"AttachInstanceSecurityGroup": func() error { return c.AttachInstanceSecurityGroup(ctx, "id", "sg") },
+		"DetachInstanceSecurityGroup": func() error { return c.DetachInstanceSecurityGroup(ctx, "id", "sg") },
+		"GetInstanceConsoleOutput":    func() error { _, err := c.GetInstanceConsoleOutput(ctx, "id", 200); return err },
+		"CreateVolume":                func() error { _, err := c.CreateVolume(ctx, "n", 1, ""); return err },
+		"GetVolume":                   func() error { _, err := c.GetVolume(ctx, "id"); return err },
+		"ListVolumes":                 func() error { _, err := c.ListVolumes(ctx); return err },
+		"DeleteVolume":                func() error { return c.DeleteVolume(ctx, "id") },
+		"CreateSecurityGroup":         func() error { _, err := c.CreateSecurityGroup(ctx, "vpc", "n", ""); return err },
+		"GetSecurityGroup":            func() error { _, err := c.GetSecurityGroup(ctx, "id"); return err },
+		"GetDefaultSecurityGroup":     func() error { _, err := c.GetDefaultSecurityGroup(ctx, "vpc"); return err },
+		"ListSecurityGroups":          func() error { _, err := c.ListSecurityGroups(ctx); return err },
+		"DeleteSecurityGroup":         func() error { return c.DeleteSecurityGroup(ctx, "id") },
+		"AddSecurityRule":             func() error { _, err := c.AddSecurityRule(ctx, "sg", SecurityRule{}); return err },
+		"RemoveSecurityRule":          func() error { return c.RemoveSecurityRule(ctx, "id") },
+		"CreateLoadBalancer":          func() error { _, err := c.CreateLoadBalancer(ctx, "n", "vpc", 80, "", nil); return err },
+		"GetLoadBalancer":             func() error { _, err := c.GetLoadBalancer(ctx, "id"); return err },
+		"GetLoadBalancerWithTargets":  func() error { _, err := c.GetLoadBalancerWithTargets(ctx, "id"); return err },
+		"UpdateLoadBalancerTLS":       func() error { _, err := c.UpdateLoadBalancerTLS(ctx, "id", nil); return err },
+		"DeleteLoadBalancer":          func() error { return c.DeleteLoadBalancer(ctx, "id") },
+		"AddLBTarget":                 func() error { return c.AddLBTarget(ctx, "lb", LBTarget{}) },
+		"RemoveLBTarget":              func() error { return c.RemoveLBTarget(ctx, "lb", "inst") },
+		"ListLBTargets":               func() error { _, err := c.ListLBTargets(ctx, "lb"); return err },
+		"CreateCertificate":           func() error { _, err := c.CreateCertificate(ctx, "n", "cert", "key"); return err },
+		"GetCertificate":              func() error { _, err := c.GetCertificate(ctx, "id"); return err },
+		"DeleteCertificate":           func() error { return c.DeleteCertificate(ctx, "id") },
+		"CreateSecret":                func() error { _, err := c.CreateSecret(ctx, "n", "v", ""); return err },
+		"GenerateSecret":              func() error { _, err := c.GenerateSecret(ctx, GenerateSecretRequest{}); return err },
+		"GetSecret":                   func() error { _, err := c.GetSecret(ctx, "id"); return err },
+		"ListSecrets":                 func() error { _, err := c.ListSecrets(ctx); return err },
+		"GetSecretValue":              func() error { _, err := c.GetSecretValue(ctx, "id"); return err },
+		"UpdateSecret":                func() error { v := "v"; _, err := c.UpdateSecret(ctx, "id", &v, ""); return err },
+		"DeleteSecret":                func() error { return c.DeleteSecret(ctx, "id") },
+		"CreateSSHKey":                func() error { _, err := c.CreateSSHKey(ctx, "n", "key"); return err },
+		"GetSSHKey":                   func() error { _, err := c.GetSSHKey(ctx, "id"); return err },
+		"ListSSHKeys":                 func() error { _, err := c.ListSSHKeys(ctx); return err },
+		"DeleteSSHKey":                func() error { return c.DeleteSSHKey(ctx, "id") },
+		"CreateAPIKey":                func() error { _, err := c.CreateAPIKey(ctx, "n"); return err },
+		"GetAPIKey":                   func() error { _, err := c.GetAPIKey(ctx, "id"); return err },
+		"ListAPIKeys":                 func() error { _, err := c.ListAPIKeys(ctx); return err },
+		"RevokeAPIKey":                func() error { return c.RevokeAPIKey(ctx, "id") },
+		"CreateScalingGroup":          func() error { _, err := c.CreateScalingGroup(ctx, nil); return err },
+		"GetScalingGroup":             func() error { _, err := c.GetScalingGroup(ctx, "id"); return err },
+		"ListScalingGroups":           func() error { _, err := c.ListScalingGroups(ctx); return err },
+		"DeleteScalingGroup":          func() error { return c.DeleteScalingGroup(ctx, "id") },
+		"ListScalingGroupInstances":   func() error { _, err := c.ListScalingGroupInstances(ctx, "id"); return err },
+		"CreateSubnet":                func() error { _, err := c.CreateSubnet(ctx, "vpc", "n", testCIDR, ""); return err },
+		"GetSubnet":                   func() error { _, err := c.GetSubnet(ctx, "id"); return err },
+		"ListSubnets":                 func() error { _, err := c.ListSubnets(ctx, "vpc"); return err },
+		"DeleteSubnet":                func() error { return c.DeleteSubnet(ctx, "id") },
+		"CreateSnapshot":              func() error { _, err := c.CreateSnapshot(ctx, "vol", ""); return err },
+		"GetSnapshot":                 func() error { _, err := c.GetSnapshot(ctx, "id"); return err },
+		"ListSnapshots":               func() error { _, err := c.ListSnapshots(ctx); return err },
+		"RestoreSnapshot":             func() error { _, err := c.RestoreSnapshot(ctx, "snap", "n", 1); return err },
+		"DeleteSnapshot":              func() error { return c.DeleteSnapshot(ctx, "id") },
+		"CreateSnapshotPolicy":        func() error { _, err := c.CreateSnapshotPolicy(ctx, SnapshotPolicy{}); return err },
+		"GetSnapshotPolicy":           func() error { _, err := c.GetSnapshotPolicy(ctx, "id"); return err },
+		"UpdateSnapshotPolicy": func() error {
+			_, err := c.UpdateSnapshotPolicy(ctx, "id", UpdateSnapshotPolicyRequest{})
+			return err
+		},
+		"DeleteSnapshotPolicy":         func() error { return c.DeleteSnapshotPolicy(ctx, "id") },
+		"CreateDatabase":               func() error { _, err := c.CreateDatabase(ctx, CreateDatabaseRequest{}); return err },
+		"GetDatabase":                  func() error { _, err := c.GetDatabase(ctx, "id"); return err },
+		"ListDatabases":                func() error { _, err := c.ListDatabases(ctx); return err },
+		"SetDatabaseNetworkACL":        func() error { return c.SetDatabaseNetworkACL(ctx, "id", nil) },
+		"ResizeDatabase":               func() error { return c.ResizeDatabase(ctx, "id", "db.small", 20) },
+		"ResetDatabasePassword":        func() error { return c.ResetDatabasePassword(ctx, "id", "p") },
+		"SetDatabaseMaintenanceWindow": func() error { return c.SetDatabaseMaintenanceWindow(ctx, "id", "sun:03:00-sun:04:00") },
+		"DeleteDatabase":               func() error { return c.DeleteDatabase(ctx, "id") },
+		"CreateDatabaseBackup":         func() error { _, err := c.CreateDatabaseBackup(ctx, "db", ""); return err },
+		"GetDatabaseBackup":            func() error { _, err := c.GetDatabaseBackup(ctx, "db", "id"); return err },
+		"DeleteDatabaseBackup":         func() error { return c.DeleteDatabaseBackup(ctx, "db", "id") },
+		"RestoreDatabaseBackup": func() error {
+			_, err := c.RestoreDatabaseBackup(ctx, "db", "id", CreateDatabaseRequest{})
+			return err
+		},
+		"AllocateElasticIP":     func() error { _, err := c.AllocateElasticIP(ctx); return err },
+		"GetElasticIP":          func() error { _, err := c.GetElasticIP(ctx, "id"); return err },
+		"ListElasticIPs":        func() error { _, err := c.ListElasticIPs(ctx); return err },
+		"ReleaseElasticIP":      func() error { return c.ReleaseElasticIP(ctx, "id") },
+		"AssociateElasticIP":    func() error { _, err := c.AssociateElasticIP(ctx, "id", "inst"); return err },
+		"DisassociateElasticIP": func() error { _, err := c.DisassociateElasticIP(ctx, "id"); return err },
+		"CreateNATGateway":      func() error { _, err := c.CreateNATGateway(ctx, "subnet", "eip"); return err },
+		"GetNATGateway":         func() error { _, err := c.GetNATGateway(ctx, "id"); return err },
+		"DeleteNATGateway":      func() error { return c.DeleteNATGateway(ctx, "id") },
+		"CreateDNSZone":         func() error { _, err := c.CreateDNSZone(ctx, "example.com", "", ""); return err },
+		"GetDNSZone":            func() error { _, err := c.GetDNSZone(ctx, "id"); return err },
+		"ListDNSZones":          func() error { _, err := c.ListDNSZones(ctx); return err },
+		"UpdateDNSZone":         func() error { _, err := c.UpdateDNSZone(ctx, "id", ""); return err },
+		"DeleteDNSZone":         func() error { return c.DeleteDNSZone(ctx, "id") },
+		"CreateDNSRecord":       func() error { _, err := c.CreateDNSRecord(ctx, "zone", DNSRecord{}); return err },
+		"GetDNSRecord":          func() error { _, err := c.GetDNSRecord(ctx, "id"); return err },
+		"ListDNSRecords":        func() error { _, err := c.ListDNSRecords(ctx, "zone"); return err },
+		"UpdateDNSRecord":       func() error { _, err := c.UpdateDNSRecord(ctx, "id", DNSRecord{}); return err },
+		"DeleteDNSRecord":       func() error { return c.DeleteDNSRecord(ctx, "id") },
+		"CreateCluster":         func() error { _, err := c.CreateCluster(ctx, CreateClusterRequest{}); return err },
+		"GetCluster":            func() error { _, err := c.GetCluster(ctx, "id"); return err },
+		"ListClusters":          func() error { _, err := c.ListClusters(ctx); return err },
+		"DeleteCluster":         func() error { return c.DeleteCluster(ctx, "id") },
+		"ScaleCluster":          func() error { return c.ScaleCluster(ctx, "id", 3) },
+		"UpgradeCluster":        func() error { return c.UpgradeCluster(ctx, "id", "v1.29.0") },
+		"UpdateClusterAutoscaling": func() error {
+			return c.UpdateClusterAutoscaling(ctx, "id", ClusterAutoscaling{})
+		},
+		"CreateNodePool": func() error { _, err := c.CreateNodePool(ctx, "cls", NodePool{}); return err },
+		"GetNodePool":    func() error { _, err := c.GetNodePool(ctx, "cls", "id"); return err },
+		"ListNodePools":  func() error { _, err := c.ListNodePools(ctx, "cls"); return err },
+		"UpdateNodePool": func() error {
+			_, err := c.UpdateNodePool(ctx, "cls", "id", UpdateNodePoolRequest{})
+			return err
+		},
+		"DeleteNodePool":            func() error { return c.DeleteNodePool(ctx, "cls", "id") },
+		"CreateGlobalLB":            func() error { _, err := c.CreateGlobalLB(ctx, CreateGlobalLBRequest{}); return err },
+		"GetGlobalLB":               func() error { _, err := c.GetGlobalLB(ctx, "id"); return err },
+		"ListGlobalLBs":             func() error { _, err := c.ListGlobalLBs(ctx); return err },
+		"UpdateGlobalLB":            func() error { _, err := c.UpdateGlobalLB(ctx, "id", UpdateGlobalLBRequest{}); return err },
+		"DeleteGlobalLB":            func() error { return c.DeleteGlobalLB(ctx, "id") },
+		"AllocateGlobalIP":          func() error { _, err := c.AllocateGlobalIP(ctx, ""); return err },
+		"GetGlobalIP":               func() error { _, err := c.GetGlobalIP(ctx, "id"); return err },
+		"UpdateGlobalIPDescription": func() error { _, err := c.UpdateGlobalIPDescription(ctx, "id", ""); return err },
+		"ReleaseGlobalIP":           func() error { return c.ReleaseGlobalIP(ctx, "id") },
+		"AddGlobalEndpoint": func() error {
+			_, err := c.AddGlobalEndpoint(ctx, "glb", AddGlobalEndpointRequest{})
+			return err
+		},
+		"UpdateGlobalEndpoint": func() error {
+			_, err := c.UpdateGlobalEndpoint(ctx, "glb", "ep", UpdateGlobalEndpointRequest{})
+			return err
+		},
+		"RemoveGlobalEndpoint":  func() error { return c.RemoveGlobalEndpoint(ctx, "glb", "ep") },
+		"CreateGatewayRoute":    func() error { _, err := c.CreateGatewayRoute(ctx, CreateRouteRequest{}); return err },
+		"GetGatewayRoute":       func() error { _, err := c.GetGatewayRoute(ctx, "id"); return err },
+		"ListGatewayRoutes":     func() error { _, err := c.ListGatewayRoutes(ctx); return err },
+		"UpdateGatewayRoute":    func() error { _, err := c.UpdateGatewayRoute(ctx, "id", GatewayRoute{}); return err },
+		"DeleteGatewayRoute":    func() error { return c.DeleteGatewayRoute(ctx, "id") },
+		"CreateFunction":        func() error { _, err := c.CreateFunction(ctx, "n", "python3.9", "h", []byte("code")); return err },
+		"UpdateFunctionCode":    func() error { _, err := c.UpdateFunctionCode(ctx, "id", []byte("code")); return err },
+		"UpdateFunction":        func() error { _, err := c.UpdateFunction(ctx, "id", "python3.9", "h"); return err },
+		"GetFunction":           func() error { _, err := c.GetFunction(ctx, "id"); return err },
+		"ListFunctions":         func() error { _, err := c.ListFunctions(ctx); return err },
+		"DeleteFunction":        func() error { return c.DeleteFunction(ctx, "id") },
+		"InvokeFunction":        func() error { _, err := c.InvokeFunction(ctx, "id", "{}"); return err },
+		"CreateFunctionTrigger": func() error { _, err := c.CreateFunctionTrigger(ctx, "fn", FunctionTrigger{}); return err },
+		"GetFunctionTrigger":    func() error { _, err := c.GetFunctionTrigger(ctx, "fn", "id"); return err },
+		"DeleteFunctionTrigger": func() error { return c.DeleteFunctionTrigger(ctx, "fn", "id") },
+		"CreateFunctionAlias":   func() error { _, err := c.CreateFunctionAlias(ctx, "fn", "live", nil); return err },
+		"GetFunctionAlias":      func() error { _, err := c.GetFunctionAlias(ctx, "fn", "live"); return err },
+		"DeleteFunctionAlias":   func() error { return c.DeleteFunctionAlias(ctx, "fn", "live") },
+		"UpdateFunctionAliasRouting": func() error {
+			_, err := c.UpdateFunctionAliasRouting(ctx, "fn", "live", nil)
+			return err
+		},
+		"CreateCache": func() error {
+			_, err := c.CreateCache(ctx, "n", "7.0", 512, "", CreateCacheOptions{})
+			return err
+		},
+		"GetCache":                  func() error { _, err := c.GetCache(ctx, "id"); return err },
+		"ListCaches":                func() error { _, err := c.ListCaches(ctx); return err },
+		"DeleteCache":               func() error { return c.DeleteCache(ctx, "id") },
+		"SetCacheReplicaCount":      func() error { return c.SetCacheReplicaCount(ctx, "id", 1) },
+		"SetCacheMaintenanceWindow": func() error { return c.SetCacheMaintenanceWindow(ctx, "id", "sun:03:00-sun:04:00") },
+		"ResizeCache":               func() error { return c.ResizeCache(ctx, "id", 1024) },
+		"FlushCache":                func() error { return c.FlushCache(ctx, "id") },
+		"CreateQueue":               func() error { _, err := c.CreateQueue(ctx, "n", CreateQueueOptions{}); return err },
+		"GetQueue":                  func() error { _, err := c.GetQueue(ctx, "id"); return err },
+		"ListQueues":                func() error { _, err := c.ListQueues(ctx); return err },
+		"UpdateQueue":               func() error { _, err := c.UpdateQueue(ctx, "id", UpdateQueueOptions{}); return err },
+		"DeleteQueue":               func() error { return c.DeleteQueue(ctx, "id") },
+		"PurgeQueue":                func() error { return c.PurgeQueue(ctx, "id") },
+		"StartQueueRedrive":         func() error { _, err := c.StartQueueRedrive(ctx, "id", "dlq"); return err },
+		"GetQueueRedriveTask":       func() error { _, err := c.GetQueueRedriveTask(ctx, "id", "task"); return err },
+		"CreateTenant":              func() error { _, err := c.CreateTenant(ctx, "n", "slug"); return err },
+		"GetTenant":                 func() error { _, err := c.GetTenant(ctx, "id"); return err },
+		"UpdateTenant":              func() error { _, err := c.UpdateTenant(ctx, "id", UpdateTenantRequest{}); return err },
+		"DeleteTenant":              func() error { return c.DeleteTenant(ctx, "id") },
+		"ListTenants":               func() error { _, err := c.ListTenants(ctx); return err },
+		"AddTenantMember":           func() error { _, err := c.AddTenantMember(ctx, "t", "a@example.com", "admin"); return err },
+		"ListTenantMembers":         func() error { _, err := c.ListTenantMembers(ctx, "t"); return err },
+		"UpdateTenantMember":        func() error { _, err := c.UpdateTenantMember(ctx, "t", "m", "admin"); return err },
+		"RemoveTenantMember":        func() error { return c.RemoveTenantMember(ctx, "t", "m") },
+		"ListAuditEvents":           func() error { _, err := c.ListAuditEvents(ctx, AuditEventFilter{}); return err },
+		"CreateDeployment":          func() error { _, err := c.CreateDeployment(ctx, CreateDeploymentRequest{}); return err },
+		"GetDeployment":             func() error { _, err := c.GetDeployment(ctx, "id"); return err },
+		"ListDeployments":           func() error { _, err := c.ListDeployments(ctx); return err },
+		"DeleteDeployment":          func() error { return c.DeleteDeployment(ctx, "id") },
+		"ScaleDeployment":           func() error { return c.ScaleDeployment(ctx, "id", 2) },
+		"RolloutDeployment": func() error {
+			_, err := c.RolloutDeployment(ctx, "id", RolloutDeploymentRequest{})
+			return err
+		},
+		"GetDeploymentLogs": func() error {
+			_, err := c.GetDeploymentLogs(ctx, "id", DeploymentLogsOptions{Tail: 20})
+			return err
+		},
+		"RegisterImage":         func() error { _, err := c.RegisterImage(ctx, RegisterImageRequest{}); return err },
+		"UploadImage":           func() error { return c.UploadImage(ctx, "id", strings.NewReader("image"), 5, nil) },
+		"GetImage":              func() error { _, err := c.GetImage(ctx, "id"); return err },
+		"ListImages":            func() error { _, err := c.ListImages(ctx); return err },
+		"DeleteImage":           func() error { return c.DeleteImage(ctx, "id") },
+		"FindImage":             func() error { _, err := c.FindImage(ctx, "ubuntu-22.04"); return err },
+		"GetInstanceSize":       func() error { _, err := c.GetInstanceSize(ctx, "medium"); return err },
+		"ListGPUTypes":          func() error { _, err := c.ListGPUTypes(ctx); return err },
+		"CreateBucket":          func() error { _, err := c.CreateBucket(ctx, "b", false); return err },
+		"GetBucket":             func() error { _, err := c.GetBucket(ctx, "b"); return err },
+		"ListBuckets":           func() error { _, err := c.ListBuckets(ctx); return err },
+		"DeleteBucket":          func() error { return c.DeleteBucket(ctx, "b") },
+		"SetBucketVersioning":   func() error { return c.SetBucketVersioning(ctx, "b", true) },
+		"SetBucketAccess":       func() error { return c.SetBucketAccess(ctx, "b", true) },
+		"GetBucketCORS":         func() error { _, err := c.GetBucketCORS(ctx, "b"); return err },
+		"PutBucketCORS":         func() error { return c.PutBucketCORS(ctx, "b", nil) },
+		"GetBucketLifecycle":    func() error { _, err := c.GetBucketLifecycle(ctx, "b"); return err },
+		"PutBucketLifecycle":    func() error { return c.PutBucketLifecycle(ctx, "b", nil) },
+		"GetBucketWebsite":      func() error { _, err := c.GetBucketWebsite(ctx, "b"); return err },
+		"PutBucketWebsite":      func() error { _, err := c.PutBucketWebsite(ctx, "b", BucketWebsite{}); return err },
+		"DeleteBucketWebsite":   func() error { return c.DeleteBucketWebsite(ctx, "b") },
+		"ListAvailabilityZones": func() error { _, err := c.ListAvailabilityZones(ctx); return err },
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call()
+
+			var apiErr *APIError
+			if assert.True(t, errors.As(err, &apiErr), "got %v", err) {
+				assert.Equal(t, http.StatusInternalServerError, apiErr.Status)
+				assert.Equal(t, "boom", apiErr.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// userAgentProduct names the provider in the User-Agent header.
+const userAgentProduct = "terraform-provider-thecloud"
+
+// UserAgent returns the User-Agent sent by the provider, e.g.
+// "terraform-provider-thecloud/1.2.0 (Terraform/1.9.5)". The Terraform version is left out
+// when it is not known.
+func UserAgent(providerVersion, terraformVersion string) string {
+	ua := fmt.Sprintf("%s/%s", userAgentProduct, providerVersion)
+	if terraformVersion != "" {
+		ua += fmt.Sprintf(" (Terraform/%s)", terraformVersion)
+	}
+	return ua
+}
+
+// WithUserAgent sets the User-Agent sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithExtraHeaders adds headers to every request, e.g. for a proxy in front of the API.
+// Headers the client sets itself, such as credentials and Content-Type, take precedence.
+func WithExtraHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.extraHeaders = headers
+	}
+}
+
+// tenantHeader selects the tenant a request operates on. Without it the API uses the
+// default tenant of the credentials.
+const tenantHeader = "X-Tenant-ID"
+
+// WithTenantID sends every request to the given tenant instead of the credentials'
+// default tenant.
+func WithTenantID(id string) Option {
+	return func(c *Client) {
+		c.tenantID = id
+	}
+}
+
+// setHeaders adds the User-Agent, tenant and extra headers to a request. Every request the
+// client builds goes through it, including multipart and binary uploads.
+func (c *Client) setHeaders(req *http.Request) {
+	req.Header.Set("User-Agent", c.userAgent)
+	if c.tenantID != "" {
+		req.Header.Set(tenantHeader, c.tenantID)
+	}
+	for name, value := range c.extraHeaders {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAgent(t *testing.T) {
+	assert.Equal(t, "terraform-provider-thecloud/1.2.0 (Terraform/1.9.5)", UserAgent("1.2.0", "1.9.5"))
+	assert.Equal(t, "terraform-provider-thecloud/dev", UserAgent("dev", ""))
+}
+
+func TestClientSendsHeaders(t *testing.T) {
+	var seen []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Clone())
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey,
+		WithRetryMax(0),
+		WithUserAgent(UserAgent("1.2.0", "1.9.5")),
+		WithExtraHeaders(map[string]string{"X-Proxy-Auth": "secret", "X-API-Key": "ignored", "X-Tenant-ID": "ignored"}),
+		WithTenantID("tenant-1"),
+	)
+	ctx := context.Background()
+
+	// JSON, multipart and streaming upload requests are built separately
+	assert.NoError(t, c.DeleteVPC(ctx, testVpcID))
+	_, err := c.UpdateFunctionCode(ctx, "fn-1", []byte("code"))
+	assert.NoError(t, err)
+	assert.NoError(t, c.UploadImage(ctx, "img-1", strings.NewReader("data"), 4, nil))
+
+	assert.Len(t, seen, 4)
+	for _, h := range seen {
+		assert.Equal(t, "terraform-provider-thecloud/1.2.0 (Terraform/1.9.5)", h.Get("User-Agent"))
+		assert.Equal(t, "secret", h.Get("X-Proxy-Auth"))
+		assert.Equal(t, testKey, h.Get("X-API-Key"))
+		assert.Equal(t, "tenant-1", h.Get("X-Tenant-ID"))
+	}
+}
+
+func TestClientDefaultUserAgent(t *testing.T) {
+	var userAgent, tenantID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		tenantID = r.Header.Get("X-Tenant-ID")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+	assert.NoError(t, c.DeleteVPC(context.Background(), testVpcID))
+	assert.Equal(t, "terraform-provider-thecloud", userAgent)
+	assert.Empty(t, tenantID)
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "REDACTED"
+
+// redactedHeaders carry credentials and are never logged.
+var redactedHeaders = []string{"X-API-Key", "Authorization"}
+
+// redactedFields are JSON body fields whose values are never logged, at any depth.
+var redactedFields = map[string]bool{
+	"value":             true,
+	"key":               true,
+	"connection_string": true,
+	"kubeconfig":        true,
+	"password":          true,
+	"private_key_pem":   true,
+
+	// Deployment env mixes plain and sensitive variables, so all of it is redacted
+	"env": true,
+	// Headers injected by gateway routes usually authenticate with the target
+	"request_headers": true,
+}
+
+// WithRequestLogging logs every API request at TRACE level, optionally including the
+// request and response bodies. Credentials and secret values are redacted.
+func WithRequestLogging(logBodies bool) Option {
+	return func(c *Client) {
+		c.logRequests = true
+		c.logBodies = logBodies
+	}
+}
+
+// loggingTransport logs each attempt made by the retrying client.
+type loggingTransport struct {
+	next      http.RoundTripper
+	logBodies bool
+
+	// sensitiveHeaders are redacted in addition to redactedHeaders
+	sensitiveHeaders []string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header, t.sensitiveHeaders),
+	}
+
+	if t.logBodies && req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close() // nolint:errcheck
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(ctx)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fields["request_body"] = redactBody(req.Header.Get("Content-Type"), body)
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Trace(ctx, "API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	if t.logBodies && resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close() // nolint:errcheck
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		fields["response_body"] = redactBody(resp.Header.Get("Content-Type"), body)
+	}
+
+	tflog.Trace(ctx, "API request", fields)
+
+	return resp, nil
+}
+
+func redactHeaders(header http.Header, sensitive []string) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		out[name] = strings.Join(values, ", ")
+	}
+	for _, name := range slices.Concat(redactedHeaders, sensitive) {
+		if header.Get(name) != "" {
+			out[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return out
+}
+
+// redactBody returns a loggable form of a body. JSON has sensitive fields replaced;
+// anything else (e.g. multipart uploads) is summarized rather than logged.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range v {
+			if redactedFields[strings.ToLower(k)] {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(inner)
+		}
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+	}
+	return v
+}
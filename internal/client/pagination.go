@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// listPage is one page of a list response. The items are decoded from the data field and
+// the cursor from the envelope.
+type listPage[T any] struct {
+	items    []T
+	nextPage string
+}
+
+func (p *listPage[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &p.items)
+}
+
+func (p *listPage[T]) setNextPage(cursor string) {
+	p.nextPage = cursor
+}
+
+// pageSetter is implemented by response objects that record the next_page cursor.
+type pageSetter interface {
+	setNextPage(cursor string)
+}
+
+// listAll lists path, following the next_page cursor until the API reports no more pages.
+// The status code of the first page is returned, so callers can tell a missing collection
+// (404) from an empty one.
+func listAll[T any](ctx context.Context, c *Client, path string) ([]T, int, error) {
+	return listUpTo[T](ctx, c, path, 0)
+}
+
+// listUpTo is listAll, but stops requesting pages once limit items have been read and
+// returns at most limit items. A limit of 0 lists everything.
+func listUpTo[T any](ctx context.Context, c *Client, path string, limit int) ([]T, int, error) {
+	var items []T
+	cursor := ""
+	seen := map[string]bool{}
+
+	for page := 0; ; page++ {
+		pagePath := path
+		if cursor != "" {
+			pagePath = withQueryParam(path, "cursor", cursor)
+		}
+
+		var p listPage[T]
+		status, err := c.do(ctx, "GET", pagePath, nil, &p)
+		if err != nil {
+			return nil, status, err
+		}
+		if page == 0 {
+			if status == http.StatusNotFound {
+				return nil, status, nil
+			}
+			items = p.items
+		} else {
+			items = append(items, p.items...)
+		}
+
+		if limit > 0 && len(items) >= limit {
+			return items[:limit], status, nil
+		}
+		if p.nextPage == "" {
+			return items, status, nil
+		}
+		// A cursor pointing back at a page already read would never end
+		if seen[p.nextPage] {
+			return nil, status, fmt.Errorf("listing %s: API returned next_page cursor %q twice", path, p.nextPage)
+		}
+		seen[p.nextPage] = true
+		cursor = p.nextPage
+	}
+}
+
+// withQueryParam adds a query parameter to path, which may already have a query.
+func withQueryParam(path, key, value string) string {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + url.Values{key: {value}}.Encode()
+}
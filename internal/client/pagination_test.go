@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedServer serves pages of instances keyed by the cursor they are requested with.
+func pagedServer(t *testing.T, pages map[string][]Instance, next map[string]string, queries *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		cursor := r.URL.Query().Get("cursor")
+
+		data, err := json.Marshal(pages[cursor])
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data, NextPage: next[cursor]}))
+	}))
+}
+
+func TestClientListFollowsPages(t *testing.T) {
+	var queries []string
+	server := pagedServer(t,
+		map[string][]Instance{
+			"":   {{ID: "i-1", Name: "web"}, {ID: "i-2", Name: "db"}},
+			"p2": {{ID: "i-3", Name: "web"}},
+			"p3": {{ID: "i-4", Name: "web"}},
+		},
+		map[string]string{"": "p2", "p2": "p3"},
+		&queries,
+	)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	instances, err := c.ListInstances(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, instances, 4)
+	assert.Equal(t, "i-4", instances[3].ID)
+	assert.Equal(t, []string{"", "cursor=p2", "cursor=p3"}, queries)
+
+	// The filter is sent with every page
+	queries = nil
+	instances, err = c.ListInstances(context.Background(), ListFilter{Name: "web"})
+	assert.NoError(t, err)
+	assert.Len(t, instances, 3)
+	assert.Equal(t, []string{"name=web", "name=web&cursor=p2", "name=web&cursor=p3"}, queries)
+}
+
+func TestClientListSinglePage(t *testing.T) {
+	var queries []string
+	server := pagedServer(t, map[string][]Instance{"": {}}, nil, &queries)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	instances, err := c.ListInstances(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, instances)
+	assert.Empty(t, instances)
+	assert.Len(t, queries, 1)
+}
+
+func TestClientListRepeatedCursor(t *testing.T) {
+	var queries []string
+	server := pagedServer(t,
+		map[string][]Instance{"": {{ID: "i-1"}}, "p2": {{ID: "i-2"}}},
+		map[string]string{"": "p2", "p2": "p2"},
+		&queries,
+	)
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0))
+
+	_, err := c.ListInstances(context.Background())
+	assert.ErrorContains(t, err, `cursor "p2" twice`)
+	assert.Len(t, queries, 2)
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// maxIdleConnsPerHost keeps enough idle connections to the API for Terraform's parallel
+// resource operations to reuse them instead of dialling a new one per request.
+const maxIdleConnsPerHost = 32
+
+// WithRateLimit caps the rate of requests sent to the API. The limit is shared by every
+// resource using the client and applies to each attempt, so retries are throttled too.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+	}
+}
+
+// rateLimitTransport waits for the shared limiter before sending each attempt.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
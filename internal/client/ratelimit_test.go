@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRateLimitsConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	var arrivals []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		arrivals = append(arrivals, time.Now())
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	const requests = 6
+	c := NewClient(server.URL, testKey, WithRetryMax(0), WithRateLimit(20, 1))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.do(context.Background(), http.MethodDelete, "/vpcs/"+testVpcID, nil, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// One request goes out at once, the others wait 50ms each for the limiter
+	assert.Len(t, arrivals, requests)
+	assert.GreaterOrEqual(t, time.Since(start), 225*time.Millisecond)
+}
+
+func TestClientRateLimitRespectsContext(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(0), WithRateLimit(0.1, 1))
+
+	_, err := c.do(context.Background(), http.MethodDelete, "/vpcs/"+testVpcID, nil, nil)
+	assert.NoError(t, err)
+
+	// The next token is ten seconds away, past the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.do(ctx, http.MethodDelete, "/vpcs/"+testVpcID, nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientReusesConnections(t *testing.T) {
+	c := NewClient("http://localhost:8080", testKey)
+
+	retrying, ok := c.HTTPClient.Transport.(*retryablehttp.RoundTripper)
+	assert.True(t, ok)
+	transport, ok := retrying.Client.HTTPClient.Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Equal(t, maxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+}
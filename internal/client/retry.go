@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type retryStartKey struct{}
+
+type noRetryKey struct{}
+
+// retryableConflictPaths lists the non-idempotent requests that are safe to retry on
+// 409 Conflict. The API answers these with 409 while the parent resource is settling.
+var retryableConflictPaths = []*regexp.Regexp{
+	regexp.MustCompile(`/vpcs/[^/]+/subnets$`),
+	regexp.MustCompile(`/security-groups/[^/]+/rules$`),
+	regexp.MustCompile(`/lb/[^/]+/targets$`),
+	regexp.MustCompile(`/elastic-ips/[^/]+/associate$`),
+}
+
+// withRetryStart records when the first attempt of a request was made so that
+// checkRetry can cap the total time spent retrying.
+func withRetryStart(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryStartKey{}, time.Now())
+}
+
+// withoutRetry marks requests that must not be sent twice, such as a function invocation.
+// A timeout or 5xx may come after the API acted on the request, so only a 429, which the
+// API answers before doing anything, is retried.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+func (c *Client) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	shouldRetry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if checkErr != nil {
+		return false, checkErr
+	}
+
+	if noRetry, _ := ctx.Value(noRetryKey{}).(bool); noRetry {
+		shouldRetry = shouldRetry && resp != nil && resp.StatusCode == http.StatusTooManyRequests
+	}
+
+	if !shouldRetry && resp != nil && resp.StatusCode == http.StatusConflict {
+		shouldRetry = isRetryableConflict(resp.Request)
+	}
+
+	if shouldRetry && c.retryMaxElapsed > 0 {
+		if start, ok := ctx.Value(retryStartKey{}).(time.Time); ok && time.Since(start) >= c.retryMaxElapsed {
+			return false, nil
+		}
+	}
+
+	return shouldRetry, nil
+}
+
+func isRetryableConflict(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, re := range retryableConflictPaths {
+			if re.MatchString(req.URL.Path) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// backoff honors Retry-After on throttled responses, bounded by the configured
+// maximum wait, and otherwise falls back to exponential backoff.
+func backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	sleep := retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	if sleep > max {
+		sleep = max
+	}
+	return sleep
+}
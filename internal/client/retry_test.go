@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRetriesTooManyRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		data, err := json.Marshal(VPC{ID: testVpcID, Name: testVpcName})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey)
+	vpc, err := c.GetVPC(context.Background(), testVpcID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testVpcID, vpc.ID)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestClientRetryAfterCappedByWaitMax(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	assert.Equal(t, 30*time.Second, backoff(time.Second, 30*time.Second, 1, resp))
+}
+
+func TestClientRetriesConflictOnIdempotentRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryWait(time.Millisecond, 10*time.Millisecond))
+	err := c.DeleteVPC(context.Background(), testVpcID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClientRetriesConflictOnWhitelistedPost(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		data, err := json.Marshal(Subnet{ID: "subnet-1", VPCID: testVpcID})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(APIResponse{Data: data}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryWait(time.Millisecond, 10*time.Millisecond))
+	subnet, err := c.CreateSubnet(context.Background(), testVpcID, "subnet", "10.0.1.0/24", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "subnet-1", subnet.ID)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClientDoesNotRetryConflictOnOtherPosts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusConflict)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"error": "vpc already exists"}))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryWait(time.Millisecond, 10*time.Millisecond))
+	_, err := c.CreateVPC(context.Background(), testVpcName, testCIDR)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "vpc already exists")
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClientDoesNotRetryInvokeAfterServerError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryWait(time.Millisecond, 10*time.Millisecond))
+
+	// The throttled attempt is retried, but the invocation that may have run is not
+	_, err := c.InvokeFunction(context.Background(), "fn-1", "{}")
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+
+	attempts = 1
+	_, err = c.StartQueueRedrive(context.Background(), "queue-dlq", "queue-main")
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestClientRetryMax(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, testKey, WithRetryMax(2), WithRetryWait(time.Millisecond, 10*time.Millisecond))
+	_, err := c.GetVPC(context.Background(), testVpcID)
+
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	defaultWaitTimeout     = 10 * time.Minute
+	defaultWaitMinInterval = 2 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+)
+
+// Clock abstracts time so that waiters can be tested without sleeping
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// WaitOpts configures WaitForState. Zero values fall back to sensible defaults.
+type WaitOpts struct {
+	Timeout     time.Duration
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	Clock       Clock
+}
+
+func (o WaitOpts) withDefaults() WaitOpts {
+	if o.Timeout <= 0 {
+		o.Timeout = defaultWaitTimeout
+	}
+	if o.MinInterval <= 0 {
+		o.MinInterval = defaultWaitMinInterval
+	}
+	if o.MaxInterval < o.MinInterval {
+		o.MaxInterval = defaultWaitMaxInterval
+		if o.MaxInterval < o.MinInterval {
+			o.MaxInterval = o.MinInterval
+		}
+	}
+	if o.Clock == nil {
+		o.Clock = realClock{}
+	}
+	return o
+}
+
+// WaitTimeoutError is returned when the target state is not reached in time
+type WaitTimeoutError struct {
+	LastStatus string
+	Target     []string
+	Timeout    time.Duration
+}
+
+func (e *WaitTimeoutError) Error() string {
+	if len(e.Target) == 0 {
+		return fmt.Sprintf("timed out after %s waiting for resource to be deleted (last status: %q)", e.Timeout, e.LastStatus)
+	}
+	return fmt.Sprintf("timed out after %s waiting for status %s (last status: %q)", e.Timeout, strings.Join(e.Target, "/"), e.LastStatus)
+}
+
+// UnexpectedStateError is returned when the resource enters a status that is neither pending nor target
+type UnexpectedStateError struct {
+	Status string
+	Target []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	if len(e.Target) == 0 {
+		return fmt.Sprintf("unexpected status %q while waiting for resource to be deleted", e.Status)
+	}
+	return fmt.Sprintf("unexpected status %q while waiting for status %s", e.Status, strings.Join(e.Target, "/"))
+}
+
+// WaitForState polls fetch with exponential backoff until the resource reaches one of the
+// target statuses. When target is empty the wait succeeds once fetch reports the resource
+// as gone. When pending is non-empty, any status outside target and pending aborts the
+// wait with an UnexpectedStateError. Status comparisons are case-insensitive.
+func WaitForState(ctx context.Context, fetch func() (status string, gone bool, err error), target []string, pending []string, opts WaitOpts) (string, error) {
+	opts = opts.withDefaults()
+
+	deadline := opts.Clock.Now().Add(opts.Timeout)
+	interval := opts.MinInterval
+	lastStatus := ""
+
+	for {
+		status, gone, err := fetch()
+		if err != nil {
+			return lastStatus, err
+		}
+
+		if gone {
+			if len(target) == 0 {
+				return "", nil
+			}
+			return lastStatus, fmt.Errorf("resource disappeared while waiting for status %s", strings.Join(target, "/"))
+		}
+
+		lastStatus = status
+		if ContainsStatus(target, status) {
+			return status, nil
+		}
+		if len(pending) > 0 && !ContainsStatus(pending, status) {
+			return status, &UnexpectedStateError{Status: status, Target: target}
+		}
+
+		remaining := deadline.Sub(opts.Clock.Now())
+		if remaining <= 0 {
+			return status, &WaitTimeoutError{LastStatus: status, Target: target, Timeout: opts.Timeout}
+		}
+
+		wait := interval
+		if wait > remaining {
+			wait = remaining
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-opts.Clock.After(wait):
+		}
+
+		interval *= 2
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// ContainsStatus reports whether statuses contains status. Statuses are compared
+// case-insensitively, like WaitForState does.
+func ContainsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	return false
+}
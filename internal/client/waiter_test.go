@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock advances time instantly whenever a waiter sleeps
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.sleeps = append(f.sleeps, d)
+	f.now = f.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+	return ch
+}
+
+func statusSequence(statuses ...string) func() (string, bool, error) {
+	i := 0
+	return func() (string, bool, error) {
+		if i >= len(statuses) {
+			return "", true, nil
+		}
+		s := statuses[i]
+		i++
+		return s, false, nil
+	}
+}
+
+func TestWaitForStateReachesTarget(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	opts := WaitOpts{MinInterval: time.Second, MaxInterval: 5 * time.Second, Clock: clock}
+
+	status, err := WaitForState(context.Background(), statusSequence("creating", "creating", "creating", "creating", "AVAILABLE"), []string{"available"}, []string{"creating"}, opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", status)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, clock.sleeps)
+}
+
+func TestWaitForStateGone(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	status, err := WaitForState(context.Background(), statusSequence("deleting", "deleting"), nil, nil, WaitOpts{Clock: clock})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", status)
+	assert.Len(t, clock.sleeps, 2)
+}
+
+func TestWaitForStateDisappeared(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	_, err := WaitForState(context.Background(), statusSequence("creating"), []string{"available"}, nil, WaitOpts{Clock: clock})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "disappeared")
+}
+
+func TestWaitForStateUnexpectedStatus(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+
+	status, err := WaitForState(context.Background(), statusSequence("creating", "failed"), []string{"available"}, []string{"creating"}, WaitOpts{Clock: clock})
+
+	var unexpected *UnexpectedStateError
+	assert.True(t, errors.As(err, &unexpected))
+	assert.Equal(t, "failed", status)
+	assert.Equal(t, "failed", unexpected.Status)
+}
+
+func TestWaitForStateTimeout(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	opts := WaitOpts{Timeout: 10 * time.Second, MinInterval: 3 * time.Second, MaxInterval: 3 * time.Second, Clock: clock}
+
+	fetch := func() (string, bool, error) { return "deleting", false, nil }
+	_, err := WaitForState(context.Background(), fetch, nil, nil, opts)
+
+	var timeout *WaitTimeoutError
+	assert.True(t, errors.As(err, &timeout))
+	assert.Equal(t, "deleting", timeout.LastStatus)
+	assert.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second, 3 * time.Second, time.Second}, clock.sleeps)
+}
+
+func TestWaitForStateFetchError(t *testing.T) {
+	fetch := func() (string, bool, error) { return "", false, errors.New("boom") }
+
+	_, err := WaitForState(context.Background(), fetch, []string{"available"}, nil, WaitOpts{Clock: &fakeClock{}})
+
+	assert.EqualError(t, err, "boom")
+}
+
+func TestWaitForStateContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fetch := func() (string, bool, error) { return "creating", false, nil }
+	_, err := WaitForState(ctx, fetch, []string{"available"}, nil, WaitOpts{MinInterval: time.Hour})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"regexp"
+)
+
+// AvailabilityZonePattern is the format of availability zone names, e.g. us-east-1a.
+// The API maps unknown zones to a default, so names are checked before they're sent.
+var AvailabilityZonePattern = regexp.MustCompile(`^[a-z]{2}-[a-z]+-[0-9]+[a-z]$`)
+
+// AvailabilityZone represents the API response for an Availability Zone
+type AvailabilityZone struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// WithDefaultAvailabilityZone sets the zone used for resources created without one
+func WithDefaultAvailabilityZone(zone string) Option {
+	return func(c *Client) {
+		c.defaultAvailabilityZone = zone
+	}
+}
+
+// AvailabilityZoneOrDefault returns zone, or the provider's default zone when it is empty.
+func (c *Client) AvailabilityZoneOrDefault(zone string) string {
+	if zone == "" {
+		return c.defaultAvailabilityZone
+	}
+	return zone
+}
+
+func (c *Client) ListAvailabilityZones(ctx context.Context) ([]AvailabilityZone, error) {
+	zones, _, err := listAll[AvailabilityZone](ctx, c, "/zones")
+	return zones, err
+}
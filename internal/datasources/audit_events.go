@@ -0,0 +1,201 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// defaultAuditEventsLimit is the number of events returned when limit is not set.
+const defaultAuditEventsLimit = 100
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &AuditEventsDataSource{}
+
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &AuditEventsDataSource{}
+}
+
+// AuditEventsDataSource defines the data source implementation.
+type AuditEventsDataSource struct {
+	client *client.Client
+}
+
+// AuditEventsDataSourceModel describes the data source data model.
+type AuditEventsDataSourceModel struct {
+	Since        types.String      `tfsdk:"since"`
+	ResourceType types.String      `tfsdk:"resource_type"`
+	Action       types.String      `tfsdk:"action"`
+	Limit        types.Int64       `tfsdk:"limit"`
+	Events       []AuditEventModel `tfsdk:"events"`
+}
+
+// AuditEventModel describes a single audit log event.
+type AuditEventModel struct {
+	ID           types.String `tfsdk:"id"`
+	Timestamp    types.String `tfsdk:"timestamp"`
+	Actor        types.String `tfsdk:"actor"`
+	Action       types.String `tfsdk:"action"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	Details      types.String `tfsdk:"details"`
+}
+
+func (d *AuditEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+func (d *AuditEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Audit Events data source allows you to list who changed what from the audit log, optionally filtered by time, resource type and action.",
+
+		Attributes: map[string]schema.Attribute{
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return events at or after this time, as an RFC3339 timestamp (e.g., `2024-01-02T15:04:05Z`).",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return events for resources of this type (e.g., instance, vpc).",
+			},
+			"action": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return events for this action (e.g., create, delete).",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of events to return. Defaults to `%d`.", defaultAuditEventsLimit),
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of audit events.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the event.",
+						},
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the event happened.",
+						},
+						"actor": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user or API key that performed the action.",
+						},
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The action that was performed.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the resource the action was performed on.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the resource the action was performed on.",
+						},
+						"details": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Action-specific details of the event, as a JSON string. Use `jsondecode` to read them.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditEventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Limit.IsNull() {
+		data.Limit = types.Int64Value(defaultAuditEventsLimit)
+	}
+
+	filter := client.AuditEventFilter{
+		ResourceType: data.ResourceType.ValueString(),
+		Action:       data.Action.ValueString(),
+		Limit:        int(data.Limit.ValueInt64()),
+	}
+	if !data.Since.IsNull() {
+		// since is validated at plan time, this only fails for values unknown until apply
+		since, err := time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				fmt.Sprintf("Expected an RFC3339 timestamp, got %q: %s", data.Since.ValueString(), err),
+			)
+			return
+		}
+		filter.Since = since
+	}
+
+	events, err := d.client.ListAuditEvents(ctx, filter)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list audit events", err)
+		return
+	}
+
+	data.Events = []AuditEventModel{}
+	for _, e := range events {
+		details := "{}"
+		if len(e.Details) > 0 && string(e.Details) != "null" {
+			details = string(e.Details)
+		}
+
+		data.Events = append(data.Events, AuditEventModel{
+			ID:           types.StringValue(e.ID),
+			Timestamp:    types.StringValue(e.Timestamp),
+			Actor:        types.StringValue(e.Actor),
+			Action:       types.StringValue(e.Action),
+			ResourceType: types.StringValue(e.ResourceType),
+			ResourceID:   types.StringValue(e.ResourceID),
+			Details:      types.StringValue(details),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,112 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &AvailabilityZonesDataSource{}
+
+func NewAvailabilityZonesDataSource() datasource.DataSource {
+	return &AvailabilityZonesDataSource{}
+}
+
+// AvailabilityZonesDataSource defines the data source implementation.
+type AvailabilityZonesDataSource struct {
+	client *client.Client
+}
+
+// AvailabilityZonesDataSourceModel describes the data source data model.
+type AvailabilityZonesDataSourceModel struct {
+	Names []types.String          `tfsdk:"names"`
+	Zones []AvailabilityZoneModel `tfsdk:"zones"`
+}
+
+// AvailabilityZoneModel describes a single availability zone.
+type AvailabilityZoneModel struct {
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (d *AvailabilityZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_availability_zones"
+}
+
+func (d *AvailabilityZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Availability zones data source allows you to list the availability zones of the platform.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Names of all availability zones.",
+			},
+			"zones": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of availability zones.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the availability zone.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the availability zone.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AvailabilityZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AvailabilityZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := AvailabilityZonesDataSourceModel{
+		Names: []types.String{},
+		Zones: []AvailabilityZoneModel{},
+	}
+
+	zones, err := d.client.ListAvailabilityZones(ctx)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list availability zones", err)
+		return
+	}
+
+	for _, z := range zones {
+		data.Names = append(data.Names, types.StringValue(z.Name))
+		data.Zones = append(data.Zones, AvailabilityZoneModel{
+			Name:   types.StringValue(z.Name),
+			Status: types.StringValue(z.Status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
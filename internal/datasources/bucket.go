@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -30,6 +31,7 @@ type BucketDataSourceModel struct {
 	VersioningEnabled types.Bool   `tfsdk:"versioning_enabled"`
 	EncryptionEnabled types.Bool   `tfsdk:"encryption_enabled"`
 	CreatedAt         types.String `tfsdk:"created_at"`
+	Endpoint          types.String `tfsdk:"endpoint"`
 }
 
 func (d *BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,6 +67,10 @@ func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the bucket was created.",
 			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname objects in the bucket are served from.",
+			},
 		},
 	}
 }
@@ -99,7 +105,7 @@ func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	bucket, err := d.client.GetBucket(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bucket, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read bucket", err)
 		return
 	}
 
@@ -114,6 +120,7 @@ func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.VersioningEnabled = types.BoolValue(bucket.VersioningEnabled)
 	data.EncryptionEnabled = types.BoolValue(bucket.EncryptionEnabled)
 	data.CreatedAt = types.StringValue(bucket.CreatedAt)
+	data.Endpoint = types.StringValue(bucket.Endpoint)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
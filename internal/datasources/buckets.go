@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -65,6 +66,10 @@ func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							Computed:            true,
 							MarkdownDescription: "The timestamp when the bucket was created.",
 						},
+						"endpoint": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname objects in the bucket are served from.",
+						},
 					},
 				},
 			},
@@ -96,7 +101,7 @@ func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	buckets, err := d.client.ListBuckets(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list buckets, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list buckets", err)
 		return
 	}
 
@@ -108,6 +113,7 @@ func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			VersioningEnabled: types.BoolValue(b.VersioningEnabled),
 			EncryptionEnabled: types.BoolValue(b.EncryptionEnabled),
 			CreatedAt:         types.StringValue(b.CreatedAt),
+			Endpoint:          types.StringValue(b.Endpoint),
 		})
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -137,7 +138,7 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read cluster", err)
 		return
 	}
 
@@ -162,7 +163,7 @@ func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 }
 
 func (d *ClusterDataSource) lookupClusterByName(ctx context.Context, name string) (*client.Cluster, error) {
-	clusters, err := d.client.ListClusters(ctx)
+	clusters, err := d.client.ListClusters(ctx, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
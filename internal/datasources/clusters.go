@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -24,6 +25,9 @@ type ClustersDataSource struct {
 
 // ClustersDataSourceModel describes the data source data model.
 type ClustersDataSourceModel struct {
+	Name     types.String             `tfsdk:"name"`
+	VpcID    types.String             `tfsdk:"vpc_id"`
+	Status   types.String             `tfsdk:"status"`
 	Clusters []ClusterDataSourceModel `tfsdk:"clusters"`
 }
 
@@ -33,9 +37,21 @@ func (d *ClustersDataSource) Metadata(ctx context.Context, req datasource.Metada
 
 func (d *ClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Clusters data source allows you to list all available managed Kubernetes clusters.",
+		MarkdownDescription: "Clusters data source allows you to list managed Kubernetes clusters, optionally filtered by name, VPC or status.",
 
 		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clusters with this exact name.",
+			},
+			"vpc_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clusters in this VPC.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return clusters with this status.",
+			},
 			"clusters": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of clusters.",
@@ -114,9 +130,19 @@ func (d *ClustersDataSource) Configure(ctx context.Context, req datasource.Confi
 func (d *ClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ClustersDataSourceModel
 
-	clusters, err := d.client.ListClusters(ctx)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, err := d.client.ListClusters(ctx, client.ListFilter{
+		Name:   data.Name.ValueString(),
+		VpcID:  data.VpcID.ValueString(),
+		Status: data.Status.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list clusters, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list clusters", err)
 		return
 	}
 
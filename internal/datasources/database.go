@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -30,7 +31,9 @@ type DatabaseDataSourceModel struct {
 	Version          types.String `tfsdk:"version"`
 	VpcID            types.String `tfsdk:"vpc_id"`
 	Status           types.String `tfsdk:"status"`
+	Host             types.String `tfsdk:"host"`
 	Port             types.Int64  `tfsdk:"port"`
+	Address          types.String `tfsdk:"address"`
 	Username         types.String `tfsdk:"username"`
 	ConnectionString types.String `tfsdk:"connection_string"`
 }
@@ -70,10 +73,18 @@ func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:            true,
 				MarkdownDescription: "The status of the database.",
 			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname clients connect to.",
+			},
 			"port": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The port the database is listening on.",
 			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `host:port` clients connect to. Unlike `connection_string`, it holds no credentials.",
+			},
 			"username": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The master username for the database.",
@@ -128,7 +139,7 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read database", err)
 		return
 	}
 
@@ -143,7 +154,9 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.Version = types.StringValue(found.Version)
 	data.VpcID = types.StringValue(found.VpcID)
 	data.Status = types.StringValue(found.Status)
+	data.Host = types.StringValue(found.ResolvedHost())
 	data.Port = types.Int64Value(int64(found.Port))
+	data.Address = types.StringValue(found.Address())
 	data.Username = types.StringValue(found.Username)
 	data.ConnectionString = types.StringValue(found.ConnectionString)
 
@@ -151,7 +164,7 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func (d *DatabaseDataSource) lookupDatabaseByName(ctx context.Context, name string) (*client.Database, error) {
-	dbs, err := d.client.ListDatabases(ctx)
+	dbs, err := d.client.ListDatabases(ctx, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
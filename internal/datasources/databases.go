@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -65,10 +66,18 @@ func (d *DatabasesDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Computed:            true,
 							MarkdownDescription: "The status of the database.",
 						},
+						"host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname clients connect to.",
+						},
 						"port": schema.Int64Attribute{
 							Computed:            true,
 							MarkdownDescription: "The port the database is listening on.",
 						},
+						"address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The `host:port` clients connect to. Unlike `connection_string`, it holds no credentials.",
+						},
 						"username": schema.StringAttribute{
 							Computed:            true,
 							MarkdownDescription: "The master username for the database.",
@@ -109,7 +118,7 @@ func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	databases, err := d.client.ListDatabases(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list databases, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list databases", err)
 		return
 	}
 
@@ -121,7 +130,9 @@ func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 			Version:          types.StringValue(db.Version),
 			VpcID:            types.StringValue(db.VpcID),
 			Status:           types.StringValue(db.Status),
+			Host:             types.StringValue(db.ResolvedHost()),
 			Port:             types.Int64Value(int64(db.Port)),
+			Address:          types.StringValue(db.Address()),
 			Username:         types.StringValue(db.Username),
 			ConnectionString: types.StringValue(db.ConnectionString),
 		})
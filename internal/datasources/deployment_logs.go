@@ -0,0 +1,163 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// defaultDeploymentLogsTail is the number of log lines returned when tail is not set.
+const defaultDeploymentLogsTail = 100
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &DeploymentLogsDataSource{}
+
+func NewDeploymentLogsDataSource() datasource.DataSource {
+	return &DeploymentLogsDataSource{}
+}
+
+// DeploymentLogsDataSource defines the data source implementation.
+type DeploymentLogsDataSource struct {
+	client *client.Client
+}
+
+// DeploymentLogsDataSourceModel describes the data source data model.
+type DeploymentLogsDataSourceModel struct {
+	DeploymentID types.String              `tfsdk:"deployment_id"`
+	Tail         types.Int64               `tfsdk:"tail"`
+	Since        types.String              `tfsdk:"since"`
+	Entries      []DeploymentLogEntryModel `tfsdk:"entries"`
+}
+
+// DeploymentLogEntryModel describes a log line of a deployment.
+type DeploymentLogEntryModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Replica   types.String `tfsdk:"replica"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func (d *DeploymentLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_logs"
+}
+
+func (d *DeploymentLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Deployment Logs data source allows you to read the recent logs of a deployment's containers, e.g. to find out why a rollout failed.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the deployment.",
+			},
+			"tail": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The number of most recent log lines to return. Defaults to `%d`.", defaultDeploymentLogsTail),
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return lines logged within this long of now, as a Go duration (e.g. `15m`).",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The log lines, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the line was logged, as an RFC3339 timestamp. Null for lines continuing the previous one.",
+						},
+						"replica": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The replica that logged the line. Null for lines continuing the previous one.",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The logged message.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Tail.IsNull() {
+		data.Tail = types.Int64Value(defaultDeploymentLogsTail)
+	}
+
+	opts := client.DeploymentLogsOptions{Tail: int(data.Tail.ValueInt64())}
+	if !data.Since.IsNull() {
+		// Validated by durationValidator
+		opts.Since, _ = time.ParseDuration(data.Since.ValueString())
+	}
+
+	entries, err := d.client.GetDeploymentLogs(ctx, data.DeploymentID.ValueString(), opts)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read deployment logs", err)
+		return
+	}
+
+	if entries == nil {
+		resp.Diagnostics.AddError("Deployment Not Found", fmt.Sprintf("No deployment with ID %s was found.", data.DeploymentID.ValueString()))
+		return
+	}
+
+	data.Entries = []DeploymentLogEntryModel{}
+	for _, e := range entries {
+		m := DeploymentLogEntryModel{
+			Timestamp: types.StringNull(),
+			Replica:   types.StringNull(),
+			Message:   types.StringValue(e.Message),
+		}
+		if !e.Timestamp.IsZero() {
+			m.Timestamp = types.StringValue(e.Timestamp.Format(time.RFC3339Nano))
+			m.Replica = types.StringValue(e.Replica)
+		}
+		data.Entries = append(data.Entries, m)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,160 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &DNSRecordsDataSource{}
+
+func NewDNSRecordsDataSource() datasource.DataSource {
+	return &DNSRecordsDataSource{}
+}
+
+// DNSRecordsDataSource defines the data source implementation.
+type DNSRecordsDataSource struct {
+	client *client.Client
+}
+
+// DNSRecordsDataSourceModel describes the data source data model.
+type DNSRecordsDataSourceModel struct {
+	ZoneID  types.String     `tfsdk:"zone_id"`
+	Type    types.String     `tfsdk:"type"`
+	Name    types.String     `tfsdk:"name"`
+	Records []DNSRecordModel `tfsdk:"records"`
+}
+
+// DNSRecordModel describes a single DNS record.
+type DNSRecordModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
+
+func (d *DNSRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (d *DNSRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Records data source allows you to list the records of a DNS zone, optionally filtered by type and name.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the DNS Zone whose records are listed.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return records of this type (e.g., A, CNAME, MX).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return records with this name. Matching ignores case and a trailing dot.",
+			},
+			"records": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of DNS records.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the record.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the record.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the record.",
+						},
+						"content": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The content of the record.",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The time to live of the record, in seconds.",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The priority of the record. Only set for record types that have one, such as MX.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.client.ListDNSRecords(ctx, data.ZoneID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list DNS records", err)
+		return
+	}
+
+	data.Records = []DNSRecordModel{}
+	for _, r := range records {
+		if !data.Type.IsNull() && !strings.EqualFold(r.Type, data.Type.ValueString()) {
+			continue
+		}
+		if !data.Name.IsNull() && !dnsNamesEqual(r.Name, data.Name.ValueString()) {
+			continue
+		}
+
+		record := DNSRecordModel{
+			ID:       types.StringValue(r.ID),
+			Name:     types.StringValue(r.Name),
+			Type:     types.StringValue(r.Type),
+			Content:  types.StringValue(r.Content),
+			TTL:      types.Int64Value(int64(r.TTL)),
+			Priority: types.Int64Null(),
+		}
+		if r.Priority != nil {
+			record.Priority = types.Int64Value(int64(*r.Priority))
+		}
+		data.Records = append(data.Records, record)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
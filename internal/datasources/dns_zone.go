@@ -0,0 +1,149 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &DNSZoneDataSource{}
+
+func NewDNSZoneDataSource() datasource.DataSource {
+	return &DNSZoneDataSource{}
+}
+
+// DNSZoneDataSource defines the data source implementation.
+type DNSZoneDataSource struct {
+	client *client.Client
+}
+
+// DNSZoneDataSourceModel describes the data source data model.
+type DNSZoneDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	VpcID       types.String `tfsdk:"vpc_id"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (d *DNSZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *DNSZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Zone data source allows you to look up a private DNS zone by ID or Name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the DNS Zone to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the DNS Zone to look up (e.g., example.internal). Matching ignores case and a trailing dot.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the DNS Zone.",
+			},
+			"vpc_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the VPC the DNS Zone belongs to.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the DNS Zone.",
+			},
+		},
+	}
+}
+
+func (d *DNSZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSZoneDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.DNSZone
+	var err error
+
+	if !data.ID.IsNull() {
+		found, err = d.client.GetDNSZone(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		found, err = d.lookupDNSZoneByName(ctx, data.Name.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read DNS zone", err)
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("DNS Zone Not Found", "No DNS zone matching the criteria was found.")
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.Description = types.StringValue(found.Description)
+	data.VpcID = types.StringValue(found.VpcID)
+	data.Status = types.StringValue(found.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *DNSZoneDataSource) lookupDNSZoneByName(ctx context.Context, name string) (*client.DNSZone, error) {
+	zones, err := d.client.ListDNSZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, z := range zones {
+		if dnsNamesEqual(z.Name, name) {
+			return &z, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// dnsNamesEqual compares DNS names the way resolvers do: ignoring case and the
+// trailing dot of a fully qualified name.
+func dnsNamesEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
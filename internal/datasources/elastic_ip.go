@@ -0,0 +1,150 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &ElasticIPDataSource{}
+
+func NewElasticIPDataSource() datasource.DataSource {
+	return &ElasticIPDataSource{}
+}
+
+// ElasticIPDataSource defines the data source implementation.
+type ElasticIPDataSource struct {
+	client *client.Client
+}
+
+// ElasticIPDataSourceModel describes the data source data model.
+type ElasticIPDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	PublicIP   types.String `tfsdk:"public_ip"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (d *ElasticIPDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_elastic_ip"
+}
+
+func (d *ElasticIPDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Elastic IP data source allows you to look up an Elastic IP by ID or public IP address.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the Elastic IP to look up.",
+			},
+			"public_ip": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The public IP address of the Elastic IP to look up.",
+			},
+			"instance_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the instance the Elastic IP is associated with. Null when it isn't associated.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the Elastic IP.",
+			},
+		},
+	}
+}
+
+func (d *ElasticIPDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ElasticIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ElasticIPDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.ElasticIP
+
+	if !data.ID.IsNull() {
+		eip, err := d.client.GetElasticIP(ctx, data.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read Elastic IP", err)
+			return
+		}
+		found = eip
+	} else if !data.PublicIP.IsNull() {
+		ip := net.ParseIP(data.PublicIP.ValueString())
+		if ip == nil {
+			resp.Diagnostics.AddError("Invalid Public IP", fmt.Sprintf("Expected an IP address such as \"203.0.113.10\", got %q.", data.PublicIP.ValueString()))
+			return
+		}
+
+		eips, err := d.client.ListElasticIPs(ctx)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to list Elastic IPs", err)
+			return
+		}
+
+		// Compare parsed addresses so differently written forms of the same IPv6 address match
+		for i := range eips {
+			if ip.Equal(net.ParseIP(eips[i].PublicIP)) {
+				found = &eips[i]
+				break
+			}
+		}
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or public_ip must be specified.")
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("Elastic IP Not Found", "No Elastic IP matching the criteria was found.")
+		return
+	}
+
+	data = flattenElasticIP(*found)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenElasticIP(eip client.ElasticIP) ElasticIPDataSourceModel {
+	instanceID := types.StringNull()
+	if eip.InstanceID != "" {
+		instanceID = types.StringValue(eip.InstanceID)
+	}
+
+	return ElasticIPDataSourceModel{
+		ID:         types.StringValue(eip.ID),
+		PublicIP:   types.StringValue(eip.PublicIP),
+		InstanceID: instanceID,
+		Status:     types.StringValue(eip.Status),
+	}
+}
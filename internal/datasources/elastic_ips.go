@@ -0,0 +1,116 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &ElasticIPsDataSource{}
+
+func NewElasticIPsDataSource() datasource.DataSource {
+	return &ElasticIPsDataSource{}
+}
+
+// ElasticIPsDataSource defines the data source implementation.
+type ElasticIPsDataSource struct {
+	client *client.Client
+}
+
+// ElasticIPsDataSourceModel describes the data source data model.
+type ElasticIPsDataSourceModel struct {
+	UnassociatedOnly types.Bool                 `tfsdk:"unassociated_only"`
+	ElasticIPs       []ElasticIPDataSourceModel `tfsdk:"elastic_ips"`
+}
+
+func (d *ElasticIPsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_elastic_ips"
+}
+
+func (d *ElasticIPsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Elastic IPs data source allows you to list Elastic IPs, for example to pick an unassociated one from a pool.",
+
+		Attributes: map[string]schema.Attribute{
+			"unassociated_only": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list Elastic IPs that aren't associated with an instance. Defaults to `false`.",
+			},
+			"elastic_ips": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of Elastic IPs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the Elastic IP.",
+						},
+						"public_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The public IP address.",
+						},
+						"instance_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the instance the Elastic IP is associated with. Null when it isn't associated.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the Elastic IP.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ElasticIPsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ElasticIPsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ElasticIPsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eips, err := d.client.ListElasticIPs(ctx)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list Elastic IPs", err)
+		return
+	}
+
+	data.ElasticIPs = []ElasticIPDataSourceModel{}
+	for _, eip := range eips {
+		if data.UnassociatedOnly.ValueBool() && eip.InstanceID != "" {
+			continue
+		}
+		data.ElasticIPs = append(data.ElasticIPs, flattenElasticIP(eip))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
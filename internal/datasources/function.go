@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -31,6 +32,7 @@ type FunctionDataSourceModel struct {
 	CodePath  types.String `tfsdk:"code_path"`
 	Status    types.String `tfsdk:"status"`
 	CreatedAt types.String `tfsdk:"created_at"`
+	Version   types.Int64  `tfsdk:"version"`
 }
 
 func (d *FunctionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,6 +74,10 @@ func (d *FunctionDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the function was created.",
 			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the deployed code.",
+			},
 		},
 	}
 }
@@ -117,7 +123,7 @@ func (d *FunctionDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read function, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read function", err)
 		return
 	}
 
@@ -133,12 +139,13 @@ func (d *FunctionDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.CodePath = types.StringValue(found.CodePath)
 	data.Status = types.StringValue(found.Status)
 	data.CreatedAt = types.StringValue(found.CreatedAt.String())
+	data.Version = types.Int64Value(int64(found.Version))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (d *FunctionDataSource) lookupFunctionByName(ctx context.Context, name string) (*client.Function, error) {
-	functions, err := d.client.ListFunctions(ctx)
+	functions, err := d.client.ListFunctions(ctx, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,131 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &FunctionInvocationDataSource{}
+
+func NewFunctionInvocationDataSource() datasource.DataSource {
+	return &FunctionInvocationDataSource{}
+}
+
+// FunctionInvocationDataSource defines the data source implementation.
+type FunctionInvocationDataSource struct {
+	client *client.Client
+}
+
+// FunctionInvocationDataSourceModel describes the data source data model.
+type FunctionInvocationDataSourceModel struct {
+	FunctionID types.String `tfsdk:"function_id"`
+	Payload    types.String `tfsdk:"payload"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Body       types.String `tfsdk:"body"`
+	DurationMS types.Int64  `tfsdk:"duration_ms"`
+}
+
+func (d *FunctionInvocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_invocation"
+}
+
+func (d *FunctionInvocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Function invocation data source invokes a function synchronously on every plan and apply, " +
+			"so a deployed function can be checked before traffic is sent to it. A failing function is reported as an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the function to invoke.",
+			},
+			"payload": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The payload passed to the function, such as a JSON document.",
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether to invoke the function. When `false`, nothing is invoked and the results are null.",
+			},
+			"status_code": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The status code returned by the function.",
+			},
+			"body": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The response returned by the function, as text. JSON responses are returned unparsed.",
+			},
+			"duration_ms": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "How long the invocation took, in milliseconds.",
+			},
+		},
+	}
+}
+
+func (d *FunctionInvocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FunctionInvocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FunctionInvocationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.StatusCode = types.Int64Null()
+	data.Body = types.StringNull()
+	data.DurationMS = types.Int64Null()
+
+	if !data.Enabled.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	invocation, err := d.client.InvokeFunction(ctx, data.FunctionID.ValueString(), data.Payload.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to invoke function", err)
+		return
+	}
+
+	if invocation.Error != "" {
+		resp.Diagnostics.AddError(
+			"Function Invocation Failed",
+			fmt.Sprintf("Function %s failed after %d ms with status %d: %s",
+				data.FunctionID.ValueString(), invocation.DurationMS, invocation.StatusCode, invocation.Error),
+		)
+		return
+	}
+
+	data.StatusCode = types.Int64Value(int64(invocation.StatusCode))
+	data.Body = types.StringValue(invocation.BodyString())
+	data.DurationMS = types.Int64Value(invocation.DurationMS)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
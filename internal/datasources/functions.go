@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -69,6 +70,10 @@ func (d *FunctionsDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Computed:            true,
 							MarkdownDescription: "The timestamp when the function was created.",
 						},
+						"version": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the deployed code.",
+						},
 					},
 				},
 			},
@@ -100,7 +105,7 @@ func (d *FunctionsDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	functions, err := d.client.ListFunctions(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list functions, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list functions", err)
 		return
 	}
 
@@ -113,6 +118,7 @@ func (d *FunctionsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			CodePath:  types.StringValue(f.CodePath),
 			Status:    types.StringValue(f.Status),
 			CreatedAt: types.StringValue(f.CreatedAt.String()),
+			Version:   types.Int64Value(int64(f.Version)),
 		})
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -32,6 +33,7 @@ type GatewayRouteDataSourceModel struct {
 	StripPrefix types.Bool   `tfsdk:"strip_prefix"`
 	RateLimit   types.Int64  `tfsdk:"rate_limit"`
 	Priority    types.Int64  `tfsdk:"priority"`
+	Position    types.Int64  `tfsdk:"position"`
 }
 
 func (d *GatewayRouteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -78,6 +80,10 @@ func (d *GatewayRouteDataSource) Schema(ctx context.Context, req datasource.Sche
 				Computed:            true,
 				MarkdownDescription: "Priority for route matching.",
 			},
+			"position": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The route's place in the order the gateway evaluates routes, starting at 1.",
+			},
 		},
 	}
 }
@@ -123,7 +129,7 @@ func (d *GatewayRouteDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read gateway route, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read gateway route", err)
 		return
 	}
 
@@ -139,6 +145,7 @@ func (d *GatewayRouteDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.StripPrefix = types.BoolValue(found.StripPrefix)
 	data.RateLimit = types.Int64Value(int64(found.RateLimit))
 	data.Priority = types.Int64Value(int64(found.Priority))
+	data.Position = types.Int64Value(int64(found.Position))
 
 	methods, diags := types.ListValueFrom(ctx, types.StringType, found.Methods)
 	resp.Diagnostics.Append(diags...)
@@ -148,7 +155,7 @@ func (d *GatewayRouteDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func (d *GatewayRouteDataSource) lookupRouteByName(ctx context.Context, name string) (*client.GatewayRoute, error) {
-	routes, err := d.client.ListGatewayRoutes(ctx)
+	routes, err := d.client.ListGatewayRoutes(ctx, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
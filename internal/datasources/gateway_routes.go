@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -74,6 +75,10 @@ func (d *GatewayRoutesDataSource) Schema(ctx context.Context, req datasource.Sch
 							Computed:            true,
 							MarkdownDescription: "Priority for route matching.",
 						},
+						"position": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The route's place in the order the gateway evaluates routes, starting at 1.",
+						},
 					},
 				},
 			},
@@ -105,7 +110,7 @@ func (d *GatewayRoutesDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	routes, err := d.client.ListGatewayRoutes(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list gateway routes, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list gateway routes", err)
 		return
 	}
 
@@ -121,6 +126,7 @@ func (d *GatewayRoutesDataSource) Read(ctx context.Context, req datasource.ReadR
 			StripPrefix: types.BoolValue(r.StripPrefix),
 			RateLimit:   types.Int64Value(int64(r.RateLimit)),
 			Priority:    types.Int64Value(int64(r.Priority)),
+			Position:    types.Int64Value(int64(r.Position)),
 			Methods:     methods,
 		})
 	}
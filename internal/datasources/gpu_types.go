@@ -0,0 +1,141 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &GPUTypesDataSource{}
+
+func NewGPUTypesDataSource() datasource.DataSource {
+	return &GPUTypesDataSource{}
+}
+
+// GPUTypesDataSource defines the data source implementation.
+type GPUTypesDataSource struct {
+	client *client.Client
+}
+
+// GPUTypesDataSourceModel describes the data source data model.
+type GPUTypesDataSourceModel struct {
+	GPUTypes []GPUTypeModel `tfsdk:"gpu_types"`
+}
+
+// GPUTypeModel describes a single GPU type.
+type GPUTypeModel struct {
+	Name         types.String               `tfsdk:"name"`
+	MemoryGB     types.Int64                `tfsdk:"memory_gb"`
+	Availability []GPUTypeAvailabilityModel `tfsdk:"availability"`
+}
+
+// GPUTypeAvailabilityModel describes the capacity of a GPU type in one zone.
+type GPUTypeAvailabilityModel struct {
+	Region           types.String `tfsdk:"region"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Available        types.Int64  `tfsdk:"available"`
+}
+
+func (d *GPUTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gpu_types"
+}
+
+func (d *GPUTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "GPU types data source allows you to list the GPU accelerator types and their available capacity per zone.",
+
+		Attributes: map[string]schema.Attribute{
+			"gpu_types": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of GPU types.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the GPU type, as used in `gpu_type`.",
+						},
+						"memory_gb": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The memory of a single GPU in GB.",
+						},
+						"availability": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Available capacity of the GPU type per zone.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"region": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The region of the zone.",
+									},
+									"availability_zone": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The availability zone.",
+									},
+									"available": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "The number of GPUs of this type that can currently be allocated in the zone.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GPUTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GPUTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := GPUTypesDataSourceModel{
+		GPUTypes: []GPUTypeModel{},
+	}
+
+	gpuTypes, err := d.client.ListGPUTypes(ctx)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list GPU types", err)
+		return
+	}
+
+	for _, t := range gpuTypes {
+		gpuType := GPUTypeModel{
+			Name:         types.StringValue(t.Name),
+			MemoryGB:     types.Int64Value(int64(t.MemoryGB)),
+			Availability: []GPUTypeAvailabilityModel{},
+		}
+		for _, a := range t.Availability {
+			gpuType.Availability = append(gpuType.Availability, GPUTypeAvailabilityModel{
+				Region:           types.StringValue(a.Region),
+				AvailabilityZone: types.StringValue(a.AvailabilityZone),
+				Available:        types.Int64Value(int64(a.Available)),
+			})
+		}
+		data.GPUTypes = append(data.GPUTypes, gpuType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
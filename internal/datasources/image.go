@@ -0,0 +1,174 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &ImageDataSource{}
+
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+// ImageDataSource defines the data source implementation.
+type ImageDataSource struct {
+	client *client.Client
+}
+
+// ImageDataSourceModel describes the data source data model.
+type ImageDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	OS           types.String `tfsdk:"os"`
+	Version      types.String `tfsdk:"version"`
+	Architecture types.String `tfsdk:"architecture"`
+	IsPublic     types.Bool   `tfsdk:"is_public"`
+	Status       types.String `tfsdk:"status"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Image data source allows you to look up a machine image by ID or Name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the image to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the image to look up.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the image.",
+			},
+			"os": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The operating system of the image.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the operating system.",
+			},
+			"architecture": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CPU architecture of the image.",
+			},
+			"is_public": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the image is public.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the image.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the image was created.",
+			},
+		},
+	}
+}
+
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.Image
+	var err error
+
+	if !data.ID.IsNull() {
+		found, err = d.client.GetImage(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		found, err = d.lookupImageByName(ctx, data.Name.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read image", err)
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("Image Not Found", "No image matching the criteria was found.")
+		return
+	}
+
+	data = flattenImage(found)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lookupImageByName returns the newest image with the given name.
+func (d *ImageDataSource) lookupImageByName(ctx context.Context, name string) (*client.Image, error) {
+	images, err := d.client.ListImages(ctx, client.ListFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	sortImagesNewestFirst(images)
+	for _, img := range images {
+		if img.Name == name {
+			return &img, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func flattenImage(img *client.Image) ImageDataSourceModel {
+	return ImageDataSourceModel{
+		ID:           types.StringValue(img.ID),
+		Name:         types.StringValue(img.Name),
+		Description:  types.StringValue(img.Description),
+		OS:           types.StringValue(img.OS),
+		Version:      types.StringValue(img.Version),
+		Architecture: types.StringValue(img.Architecture),
+		IsPublic:     types.BoolValue(img.IsPublic),
+		Status:       types.StringValue(img.Status),
+		CreatedAt:    types.StringValue(img.CreatedAt),
+	}
+}
@@ -0,0 +1,184 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &ImagesDataSource{}
+
+func NewImagesDataSource() datasource.DataSource {
+	return &ImagesDataSource{}
+}
+
+// ImagesDataSource defines the data source implementation.
+type ImagesDataSource struct {
+	client *client.Client
+}
+
+// ImagesDataSourceModel describes the data source data model.
+type ImagesDataSourceModel struct {
+	OS         types.String           `tfsdk:"os"`
+	Version    types.String           `tfsdk:"version"`
+	MostRecent types.Bool             `tfsdk:"most_recent"`
+	ID         types.String           `tfsdk:"id"`
+	Images     []ImageDataSourceModel `tfsdk:"images"`
+}
+
+func (d *ImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+func (d *ImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Images data source allows you to list machine images, optionally filtered by operating system and version.",
+
+		Attributes: map[string]schema.Attribute{
+			"os": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list images of this operating system (e.g. ubuntu).",
+			},
+			"version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list images of this operating system version (e.g. 22.04).",
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set `id` to the newest matching image. It is an error when no image matches. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the newest matching image. Only set when `most_recent` is `true`.",
+			},
+			"images": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of matching images, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the image.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the image.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the image.",
+						},
+						"os": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The operating system of the image.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the operating system.",
+						},
+						"architecture": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The CPU architecture of the image.",
+						},
+						"is_public": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the image is public.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the image.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The timestamp when the image was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	images, err := d.client.ListImages(ctx, client.ListFilter{
+		OS:      data.OS.ValueString(),
+		Version: data.Version.ValueString(),
+	})
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list images", err)
+		return
+	}
+
+	sortImagesNewestFirst(images)
+
+	data.ID = types.StringNull()
+	data.Images = []ImageDataSourceModel{}
+	for _, img := range images {
+		data.Images = append(data.Images, flattenImage(&img))
+	}
+
+	if data.MostRecent.ValueBool() {
+		if len(images) == 0 {
+			resp.Diagnostics.AddError("Image Not Found", "No image matching the criteria was found.")
+			return
+		}
+		data.ID = types.StringValue(images[0].ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sortImagesNewestFirst orders images by creation time, newest first. Images whose
+// creation time can't be parsed sort last, and ties are broken by name.
+func sortImagesNewestFirst(images []client.Image) {
+	created := func(img client.Image) time.Time {
+		t, err := time.Parse(time.RFC3339, img.CreatedAt)
+		if err != nil {
+			return time.Time{}
+		}
+		return t
+	}
+
+	sort.SliceStable(images, func(i, j int) bool {
+		ti, tj := created(images[i]), created(images[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return images[i].Name < images[j].Name
+	})
+}
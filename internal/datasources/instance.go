@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -117,7 +118,7 @@ func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read instance", err)
 		return
 	}
 
@@ -138,7 +139,7 @@ func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func (d *InstanceDataSource) lookupInstanceByName(ctx context.Context, name string) (*client.Instance, error) {
-	instances, err := d.client.ListInstances(ctx)
+	instances, err := d.client.ListInstances(ctx, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,135 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// defaultConsoleOutputLines is the number of console lines returned when lines is not set.
+const defaultConsoleOutputLines = 200
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &InstanceConsoleOutputDataSource{}
+
+func NewInstanceConsoleOutputDataSource() datasource.DataSource {
+	return &InstanceConsoleOutputDataSource{}
+}
+
+// InstanceConsoleOutputDataSource defines the data source implementation.
+type InstanceConsoleOutputDataSource struct {
+	client *client.Client
+}
+
+// InstanceConsoleOutputDataSourceModel describes the data source data model.
+type InstanceConsoleOutputDataSourceModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	Lines      types.Int64  `tfsdk:"lines"`
+	Output     types.String `tfsdk:"output"`
+	Timestamp  types.String `tfsdk:"timestamp"`
+}
+
+func (d *InstanceConsoleOutputDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_console_output"
+}
+
+func (d *InstanceConsoleOutputDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Instance Console Output data source allows you to read the tail of an instance's serial console, e.g. to find out why it failed to boot.",
+
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the instance.",
+			},
+			"lines": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The number of most recent console lines to return. Defaults to `%d`.", defaultConsoleOutputLines),
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+			},
+			"output": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The console output. Empty when the instance is stopped.",
+			},
+			"timestamp": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the output was captured, as an RFC3339 timestamp. Null when the instance is stopped.",
+			},
+		},
+	}
+}
+
+func (d *InstanceConsoleOutputDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceConsoleOutputDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceConsoleOutputDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Lines.IsNull() {
+		data.Lines = types.Int64Value(defaultConsoleOutputLines)
+	}
+
+	console, err := d.client.GetInstanceConsoleOutput(ctx, data.InstanceID.ValueString(), int(data.Lines.ValueInt64()))
+
+	// A stopped instance has no console to read, which shouldn't fail the whole plan
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusConflict {
+		resp.Diagnostics.AddWarning(
+			"Instance Console Unavailable",
+			fmt.Sprintf("The console of instance %s can't be read while it isn't running: %s. The output is empty.", data.InstanceID.ValueString(), err),
+		)
+		data.Output = types.StringValue("")
+		data.Timestamp = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read instance console output", err)
+		return
+	}
+
+	if console == nil {
+		resp.Diagnostics.AddError("Instance Not Found", fmt.Sprintf("No instance with ID %s was found.", data.InstanceID.ValueString()))
+		return
+	}
+
+	data.Output = types.StringValue(console.Output)
+	data.Timestamp = types.StringValue(console.CapturedAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,10 +3,13 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -24,6 +27,10 @@ type InstancesDataSource struct {
 
 // InstancesDataSourceModel describes the data source data model.
 type InstancesDataSourceModel struct {
+	Name      types.String              `tfsdk:"name"`
+	NameRegex types.String              `tfsdk:"name_regex"`
+	VpcID     types.String              `tfsdk:"vpc_id"`
+	Status    types.String              `tfsdk:"status"`
 	Instances []InstanceDataSourceModel `tfsdk:"instances"`
 }
 
@@ -33,9 +40,28 @@ func (d *InstancesDataSource) Metadata(ctx context.Context, req datasource.Metad
 
 func (d *InstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Instances data source allows you to list all available compute instances.",
+		MarkdownDescription: "Instances data source allows you to list compute instances, optionally filtered by name, name pattern, VPC or status.",
 
 		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return instances with this exact name.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return instances whose name matches this regular expression.",
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return instances in this VPC.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return instances with this status.",
+			},
 			"instances": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of instances.",
@@ -98,13 +124,32 @@ func (d *InstancesDataSource) Configure(ctx context.Context, req datasource.Conf
 func (d *InstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data InstancesDataSourceModel
 
-	instances, err := d.client.ListInstances(ctx)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instances, err := d.client.ListInstances(ctx, client.ListFilter{
+		Name:   data.Name.ValueString(),
+		VpcID:  data.VpcID.ValueString(),
+		Status: data.Status.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list instances, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list instances", err)
 		return
 	}
 
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+	}
+
 	for _, inst := range instances {
+		if nameRegex != nil && !nameRegex.MatchString(inst.Name) {
+			continue
+		}
+
 		data.Instances = append(data.Instances, InstanceDataSourceModel{
 			ID:        types.StringValue(inst.ID),
 			Name:      types.StringValue(inst.Name),
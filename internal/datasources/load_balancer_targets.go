@@ -0,0 +1,132 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &LoadBalancerTargetsDataSource{}
+
+func NewLoadBalancerTargetsDataSource() datasource.DataSource {
+	return &LoadBalancerTargetsDataSource{}
+}
+
+// LoadBalancerTargetsDataSource defines the data source implementation.
+type LoadBalancerTargetsDataSource struct {
+	client *client.Client
+}
+
+// LoadBalancerTargetDataSourceModel describes a single target of a load balancer.
+type LoadBalancerTargetDataSourceModel struct {
+	InstanceID   types.String `tfsdk:"instance_id"`
+	Port         types.Int64  `tfsdk:"port"`
+	Weight       types.Int64  `tfsdk:"weight"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+	HealthStatus types.String `tfsdk:"health_status"`
+}
+
+// LoadBalancerTargetsDataSourceModel describes the data source data model.
+type LoadBalancerTargetsDataSourceModel struct {
+	LoadBalancerID types.String                        `tfsdk:"load_balancer_id"`
+	Targets        []LoadBalancerTargetDataSourceModel `tfsdk:"targets"`
+}
+
+func (d *LoadBalancerTargetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer_targets"
+}
+
+func (d *LoadBalancerTargetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Load Balancer Targets data source allows you to list all targets registered to a load balancer along with their health.",
+
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the load balancer to list targets for.",
+			},
+			"targets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of load balancer targets.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the registered instance.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The port on the instance traffic is sent to.",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The weight of the target.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the target is currently passing health checks.",
+						},
+						"health_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The health check status reported for the target.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LoadBalancerTargetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LoadBalancerTargetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LoadBalancerTargetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, err := d.client.ListLBTargets(ctx, data.LoadBalancerID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list load balancer targets", err)
+		return
+	}
+
+	data.Targets = []LoadBalancerTargetDataSourceModel{}
+	for _, t := range targets {
+		data.Targets = append(data.Targets, LoadBalancerTargetDataSourceModel{
+			InstanceID:   types.StringValue(t.InstanceID),
+			Port:         types.Int64Value(int64(t.Port)),
+			Weight:       types.Int64Value(int64(t.Weight)),
+			Healthy:      types.BoolValue(t.Healthy),
+			HealthStatus: types.StringValue(t.Status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
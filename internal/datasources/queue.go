@@ -0,0 +1,174 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+// QueueDataSource defines the data source implementation.
+type QueueDataSource struct {
+	client *client.Client
+}
+
+// QueueDataSourceModel describes the data source data model.
+type QueueDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ARN               types.String `tfsdk:"arn"`
+	VisibilityTimeout types.Int64  `tfsdk:"visibility_timeout"`
+	RetentionDays     types.Int64  `tfsdk:"retention_days"`
+	MaxMessageSize    types.Int64  `tfsdk:"max_message_size"`
+	Status            types.String `tfsdk:"status"`
+	DeadLetterQueueID types.String `tfsdk:"dead_letter_queue_id"`
+	MaxReceiveCount   types.Int64  `tfsdk:"max_receive_count"`
+}
+
+func (d *QueueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue"
+}
+
+func (d *QueueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queue data source allows you to look up a queue by ID or Name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the queue to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the queue to look up. It must match exactly one queue.",
+			},
+			"arn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Amazon Resource Name (ARN) of the queue.",
+			},
+			"visibility_timeout": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Seconds a message remains hidden after retrieval.",
+			},
+			"retention_days": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Days before non-deleted messages are purged.",
+			},
+			"max_message_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum payload size in bytes.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the queue.",
+			},
+			"dead_letter_queue_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the queue's dead-letter queue, if it has one.",
+			},
+			"max_receive_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "How many times a message is received before it's moved to the dead-letter queue.",
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueueDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.Queue
+
+	if !data.ID.IsNull() {
+		q, err := d.client.GetQueue(ctx, data.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read queue", err)
+			return
+		}
+		found = q
+	} else if !data.Name.IsNull() {
+		queues, err := d.client.ListQueues(ctx, client.ListFilter{Name: data.Name.ValueString()})
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to list queues", err)
+			return
+		}
+
+		if len(queues) > 1 {
+			ids := make([]string, 0, len(queues))
+			for _, q := range queues {
+				ids = append(ids, q.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Queues Found",
+				fmt.Sprintf("%d queues are named %q (%s). Look the queue up by id instead.", len(queues), data.Name.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+		if len(queues) == 1 {
+			found = &queues[0]
+		}
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("Queue Not Found", "No queue matching the criteria was found.")
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.ARN = types.StringValue(found.ARN)
+	data.VisibilityTimeout = types.Int64Value(int64(found.VisibilityTimeout))
+	data.RetentionDays = types.Int64Value(int64(found.RetentionDays))
+	data.MaxMessageSize = types.Int64Value(int64(found.MaxMessageSize))
+	data.Status = types.StringValue(found.Status)
+	data.DeadLetterQueueID = types.StringNull()
+	data.MaxReceiveCount = types.Int64Null()
+	if found.RedrivePolicy != nil {
+		data.DeadLetterQueueID = types.StringValue(found.RedrivePolicy.DeadLetterQueueID)
+		data.MaxReceiveCount = types.Int64Value(int64(found.RedrivePolicy.MaxReceiveCount))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,223 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &ScalingGroupDataSource{}
+
+func NewScalingGroupDataSource() datasource.DataSource {
+	return &ScalingGroupDataSource{}
+}
+
+// ScalingGroupDataSource defines the data source implementation.
+type ScalingGroupDataSource struct {
+	client *client.Client
+}
+
+// ScalingGroupDataSourceModel describes the data source data model.
+type ScalingGroupDataSourceModel struct {
+	ID             types.String                          `tfsdk:"id"`
+	Name           types.String                          `tfsdk:"name"`
+	VpcID          types.String                          `tfsdk:"vpc_id"`
+	LoadBalancerID types.String                          `tfsdk:"load_balancer_id"`
+	Image          types.String                          `tfsdk:"image"`
+	InstanceSize   types.String                          `tfsdk:"instance_size"`
+	MinInstances   types.Int64                           `tfsdk:"min_instances"`
+	MaxInstances   types.Int64                           `tfsdk:"max_instances"`
+	DesiredCount   types.Int64                           `tfsdk:"desired_count"`
+	Status         types.String                          `tfsdk:"status"`
+	InstanceIDs    []types.String                        `tfsdk:"instance_ids"`
+	Instances      []ScalingGroupInstanceDataSourceModel `tfsdk:"instances"`
+}
+
+// ScalingGroupInstanceDataSourceModel describes an instance currently in a scaling group.
+type ScalingGroupInstanceDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func (d *ScalingGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scaling_group"
+}
+
+func (d *ScalingGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scaling Group data source allows you to look up an auto-scaling group by ID or Name, including the instances currently in it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the scaling group to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the scaling group to look up.",
+			},
+			"vpc_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the VPC the scaling group belongs to.",
+			},
+			"load_balancer_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the load balancer associated with the group.",
+			},
+			"image": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The image instances in the group are launched from.",
+			},
+			"instance_size": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instance size of instances in the group.",
+			},
+			"min_instances": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The minimum number of instances in the group.",
+			},
+			"max_instances": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The maximum number of instances in the group.",
+			},
+			"desired_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The desired number of instances in the group.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the scaling group.",
+			},
+			"instance_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the instances currently in the group.",
+			},
+			"instances": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instances currently in the group.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the instance.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of the instance.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ScalingGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ScalingGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScalingGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.ScalingGroup
+	var err error
+
+	if !data.ID.IsNull() {
+		found, err = d.client.GetScalingGroup(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		found, err = d.lookupScalingGroupByName(ctx, data.Name.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read scaling group", err)
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("Scaling Group Not Found", "No scaling group matching the criteria was found.")
+		return
+	}
+
+	instances, err := d.client.ListScalingGroupInstances(ctx, found.ID)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list scaling group instances", err)
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.VpcID = types.StringValue(found.VpcID)
+	data.LoadBalancerID = types.StringValue(found.LoadBalancerID)
+	data.Image = types.StringValue(found.Image)
+	data.InstanceSize = types.StringValue(found.InstanceSize)
+	data.MinInstances = types.Int64Value(int64(found.MinInstances))
+	data.MaxInstances = types.Int64Value(int64(found.MaxInstances))
+	data.DesiredCount = types.Int64Value(int64(found.DesiredCount))
+	data.Status = types.StringValue(found.Status)
+
+	data.InstanceIDs = []types.String{}
+	data.Instances = []ScalingGroupInstanceDataSourceModel{}
+	for _, inst := range instances {
+		data.InstanceIDs = append(data.InstanceIDs, types.StringValue(inst.ID))
+		data.Instances = append(data.Instances, ScalingGroupInstanceDataSourceModel{
+			ID:        types.StringValue(inst.ID),
+			IPAddress: types.StringValue(inst.IPAddress),
+			Status:    types.StringValue(inst.Status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ScalingGroupDataSource) lookupScalingGroupByName(ctx context.Context, name string) (*client.ScalingGroup, error) {
+	groups, err := d.client.ListScalingGroups(ctx, client.ListFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		if g.Name == name {
+			return &g, nil
+		}
+	}
+
+	return nil, nil // nolint:nilnil
+}
@@ -0,0 +1,172 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &SecretDataSource{}
+
+func NewSecretDataSource() datasource.DataSource {
+	return &SecretDataSource{}
+}
+
+// SecretDataSource defines the data source implementation.
+type SecretDataSource struct {
+	client *client.Client
+}
+
+// SecretDataSourceModel describes the data source data model.
+type SecretDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Version     types.Int64  `tfsdk:"version"`
+	FetchValue  types.Bool   `tfsdk:"fetch_value"`
+	Value       types.String `tfsdk:"value"`
+}
+
+func (d *SecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Secret data source allows you to look up a secret by ID or Name, and optionally read its value.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the secret to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the secret to look up.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the secret.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The current version of the secret.",
+			},
+			"fetch_value": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to read the secret's value. The value is stored in state, so it is only fetched when this is `true`. Defaults to `false`.",
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The value of the secret. Only set when `fetch_value` is `true`.",
+			},
+		},
+	}
+}
+
+func (d *SecretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.Secret
+	var err error
+
+	if !data.ID.IsNull() {
+		found, err = d.client.GetSecret(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		found, err = d.lookupSecretByName(ctx, data.Name.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read secret", err)
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("Secret Not Found", "No secret matching the criteria was found.")
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.Description = types.StringValue(found.Description)
+	data.Version = types.Int64Value(found.Version)
+	data.Value = types.StringNull()
+
+	if data.FetchValue.ValueBool() {
+		value, err := d.client.GetSecretValue(ctx, found.ID)
+
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && (apiErr.Status == http.StatusForbidden || apiErr.Status == http.StatusUnauthorized) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fetch_value"),
+				"Secret Value Access Denied",
+				fmt.Sprintf("The credentials used by the provider can read secret %s but not its value: %s. "+
+					"Grant permission to read secret values, or remove fetch_value to read only the metadata.", found.ID, err),
+			)
+			return
+		}
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read secret value", err)
+			return
+		}
+
+		data.Value = types.StringValue(value)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SecretDataSource) lookupSecretByName(ctx context.Context, name string) (*client.Secret, error) {
+	secrets, err := d.client.ListSecrets(ctx, client.ListFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range secrets {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+
+	return nil, nil // nolint:nilnil
+}
@@ -0,0 +1,229 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &SecurityGroupDataSource{}
+
+func NewSecurityGroupDataSource() datasource.DataSource {
+	return &SecurityGroupDataSource{}
+}
+
+// SecurityGroupDataSource defines the data source implementation.
+type SecurityGroupDataSource struct {
+	client *client.Client
+}
+
+// SecurityGroupDataSourceModel describes the data source data model.
+type SecurityGroupDataSourceModel struct {
+	ID          types.String             `tfsdk:"id"`
+	VpcID       types.String             `tfsdk:"vpc_id"`
+	Name        types.String             `tfsdk:"name"`
+	Description types.String             `tfsdk:"description"`
+	Rules       []SecurityGroupRuleModel `tfsdk:"rules"`
+}
+
+// SecurityGroupRuleModel describes a rule of a security group.
+type SecurityGroupRuleModel struct {
+	ID        types.String `tfsdk:"id"`
+	Direction types.String `tfsdk:"direction"`
+	Protocol  types.String `tfsdk:"protocol"`
+	PortMin   types.Int64  `tfsdk:"port_min"`
+	PortMax   types.Int64  `tfsdk:"port_max"`
+	CIDR      types.String `tfsdk:"cidr"`
+	Priority  types.Int64  `tfsdk:"priority"`
+}
+
+func (d *SecurityGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group"
+}
+
+func (d *SecurityGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Security Group data source allows you to look up a security group and its rules by ID or by name within a VPC.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the security group to look up.",
+			},
+			"vpc_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the VPC the security group belongs to. Required when looking up by name.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the security group to look up.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the security group.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The rules of the security group, ordered by priority and then by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the rule.",
+						},
+						"direction": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The direction of the traffic the rule applies to (ingress or egress).",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The protocol the rule applies to.",
+						},
+						"port_min": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The start of the port range. Null when the rule covers every port.",
+						},
+						"port_max": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The end of the port range. Null when the rule covers every port.",
+						},
+						"cidr": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The CIDR block the rule allows.",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The priority of the rule.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SecurityGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SecurityGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecurityGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	if data.ID.IsNull() {
+		if data.Name.IsNull() || data.VpcID.IsNull() {
+			resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name (with vpc_id) must be specified.")
+			return
+		}
+
+		groups, err := d.client.ListSecurityGroups(ctx, client.ListFilter{Name: data.Name.ValueString(), VpcID: data.VpcID.ValueString()})
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to list security groups", err)
+			return
+		}
+
+		if len(groups) > 1 {
+			ids := make([]string, 0, len(groups))
+			for _, sg := range groups {
+				ids = append(ids, sg.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Security Groups Found",
+				fmt.Sprintf("%d security groups named %q exist in VPC %s (%s). Look the security group up by id instead.",
+					len(groups), data.Name.ValueString(), data.VpcID.ValueString(), strings.Join(ids, ", ")),
+			)
+			return
+		}
+		if len(groups) == 0 {
+			resp.Diagnostics.AddError("Security Group Not Found", "No security group matching the criteria was found.")
+			return
+		}
+
+		id = groups[0].ID
+	}
+
+	// Listed groups may come without their rules, so the group is always read by ID
+	sg, err := d.client.GetSecurityGroup(ctx, id)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read security group", err)
+		return
+	}
+
+	if sg == nil {
+		resp.Diagnostics.AddError("Security Group Not Found", "No security group matching the criteria was found.")
+		return
+	}
+
+	data.ID = types.StringValue(sg.ID)
+	data.VpcID = types.StringValue(sg.VPCID)
+	data.Name = types.StringValue(sg.Name)
+	data.Description = types.StringValue(sg.Description)
+	data.Rules = flattenSecurityRules(sg.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenSecurityRules orders rules by priority and then by ID, so the list doesn't
+// churn when the API returns them in a different order.
+func flattenSecurityRules(rules []client.SecurityRule) []SecurityGroupRuleModel {
+	sorted := make([]client.SecurityRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	out := []SecurityGroupRuleModel{}
+	for _, rule := range sorted {
+		m := SecurityGroupRuleModel{
+			ID:        types.StringValue(rule.ID),
+			Direction: types.StringValue(rule.Direction),
+			Protocol:  types.StringValue(rule.Protocol),
+			PortMin:   types.Int64Null(),
+			PortMax:   types.Int64Null(),
+			CIDR:      types.StringValue(rule.CIDR),
+			Priority:  types.Int64Value(int64(rule.Priority)),
+		}
+		if rule.PortMin != 0 || rule.PortMax != 0 {
+			m.PortMin = types.Int64Value(int64(rule.PortMin))
+			m.PortMax = types.Int64Value(int64(rule.PortMax))
+		}
+		out = append(out, m)
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ datasource.DataSource = &SSHKeyDataSource{}
+
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &SSHKeyDataSource{}
+}
+
+// SSHKeyDataSource defines the data source implementation.
+type SSHKeyDataSource struct {
+	client *client.Client
+}
+
+// SSHKeyDataSourceModel describes the data source data model.
+type SSHKeyDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (d *SSHKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (d *SSHKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SSH Key data source allows you to look up a registered SSH key by ID or Name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the SSH key to look up.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the SSH key to look up.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public key in OpenSSH authorized_keys format.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fingerprint of the public key.",
+			},
+		},
+	}
+}
+
+func (d *SSHKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SSHKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSHKeyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var found *client.SSHKey
+	var err error
+
+	if !data.ID.IsNull() {
+		found, err = d.client.GetSSHKey(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		found, err = d.lookupSSHKeyByName(ctx, data.Name.ValueString())
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either id or name must be specified.")
+		return
+	}
+
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read SSH key", err)
+		return
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError("SSH Key Not Found", "No SSH key matching the criteria was found.")
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.PublicKey = types.StringValue(found.PublicKey)
+	data.Fingerprint = types.StringValue(found.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SSHKeyDataSource) lookupSSHKeyByName(ctx context.Context, name string) (*client.SSHKey, error) {
+	keys, err := d.client.ListSSHKeys(ctx, client.ListFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range keys {
+		if k.Name == name {
+			return &k, nil
+		}
+	}
+
+	return nil, nil // nolint:nilnil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -101,7 +102,7 @@ func (d *SubnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if !data.ID.IsNull() {
 		subnet, err = d.client.GetSubnet(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subnet, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read subnet", err)
 			return
 		}
 	} else if !data.Name.IsNull() {
@@ -111,7 +112,7 @@ func (d *SubnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		}
 		subnet, err = d.lookupSubnetByName(ctx, data.VpcID.ValueString(), data.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to lookup subnet, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to lookup subnet", err)
 			return
 		}
 	} else {
@@ -138,7 +139,7 @@ func (d *SubnetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func (d *SubnetDataSource) lookupSubnetByName(ctx context.Context, vpcID, name string) (*client.Subnet, error) {
-	subnets, err := d.client.ListSubnets(ctx, vpcID)
+	subnets, err := d.client.ListSubnets(ctx, vpcID, client.ListFilter{Name: name})
 	if err != nil {
 		return nil, err
 	}
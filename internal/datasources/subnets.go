@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -103,7 +104,7 @@ func (d *SubnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	subnets, err := d.client.ListSubnets(ctx, data.VpcID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list subnets, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list subnets", err)
 		return
 	}
 
@@ -0,0 +1,64 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexValidator{}
+
+// regexValidator checks that a string compiles as a regular expression.
+type regexValidator struct{}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Expected a valid regular expression, got %q: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator checks that a string is an RFC3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected an RFC3339 timestamp such as 2024-01-02T15:04:05Z, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
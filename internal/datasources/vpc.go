@@ -3,10 +3,12 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -28,6 +30,9 @@ type VpcDataSourceModel struct {
 	Name      types.String `tfsdk:"name"`
 	CIDRBlock types.String `tfsdk:"cidr_block"`
 	Status    types.String `tfsdk:"status"`
+
+	DefaultSecurityGroupID types.String `tfsdk:"default_security_group_id"`
+	DefaultSubnetID        types.String `tfsdk:"default_subnet_id"`
 }
 
 func (d *VpcDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,7 +41,7 @@ func (d *VpcDataSource) Metadata(ctx context.Context, req datasource.MetadataReq
 
 func (d *VpcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "VPC data source allows you to look up VPC details by name or ID.",
+		MarkdownDescription: "VPC data source allows you to look up VPC details by ID, name, or CIDR block.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -50,13 +55,22 @@ func (d *VpcDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "The name of the VPC to look up.",
 			},
 			"cidr_block": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The IPv4 CIDR block for the VPC.",
+				MarkdownDescription: "The IPv4 CIDR block of the VPC to look up.",
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the VPC.",
 			},
+			"default_security_group_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the security group the platform created along with the VPC.",
+			},
+			"default_subnet_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the subnet the platform created along with the VPC.",
+			},
 		},
 	}
 }
@@ -89,9 +103,38 @@ func (d *VpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	foundVpc, err := d.lookupVpc(ctx, data)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPC, got error: %s", err))
+	var foundVpc *client.VPC
+
+	if !data.ID.IsNull() {
+		v, err := d.client.GetVPC(ctx, data.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read VPC", err)
+			return
+		}
+		foundVpc = v
+	} else if !data.Name.IsNull() || !data.CIDRBlock.IsNull() {
+		vpcs, err := d.listMatchingVpcs(ctx, data)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to list VPCs", err)
+			return
+		}
+
+		if len(vpcs) > 1 {
+			ids := make([]string, 0, len(vpcs))
+			for _, v := range vpcs {
+				ids = append(ids, v.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Multiple VPCs Found",
+				fmt.Sprintf("%d VPCs match the criteria (%s). Narrow the lookup with cidr_block or look the VPC up by id instead.", len(vpcs), strings.Join(ids, ", ")),
+			)
+			return
+		}
+		if len(vpcs) == 1 {
+			foundVpc = &vpcs[0]
+		}
+	} else {
+		resp.Diagnostics.AddError("Missing Required Attribute", "One of id, name, or cidr_block must be specified.")
 		return
 	}
 
@@ -104,33 +147,34 @@ func (d *VpcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.Name = types.StringValue(foundVpc.Name)
 	data.CIDRBlock = types.StringValue(foundVpc.CIDRBlock)
 	data.Status = types.StringValue(foundVpc.Status)
+	data.DefaultSecurityGroupID = types.StringValue(foundVpc.DefaultSecurityGroupID)
+	data.DefaultSubnetID = types.StringValue(foundVpc.DefaultSubnetID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (d *VpcDataSource) lookupVpc(ctx context.Context, data VpcDataSourceModel) (*client.VPC, error) {
-	if !data.ID.IsNull() {
-		return d.client.GetVPC(ctx, data.ID.ValueString())
-	}
-
+// listMatchingVpcs returns the VPCs matching every configured name and cidr_block.
+func (d *VpcDataSource) listMatchingVpcs(ctx context.Context, data VpcDataSourceModel) ([]client.VPC, error) {
+	var filter client.ListFilter
 	if !data.Name.IsNull() {
-		return d.lookupVpcByName(ctx, data.Name.ValueString())
+		filter.Name = data.Name.ValueString()
 	}
 
-	return nil, fmt.Errorf("either 'id' or 'name' must be specified")
-}
-
-func (d *VpcDataSource) lookupVpcByName(ctx context.Context, name string) (*client.VPC, error) {
-	vpcs, err := d.client.ListVPCs(ctx)
+	vpcs, err := d.client.ListVPCs(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	if data.CIDRBlock.IsNull() {
+		return vpcs, nil
+	}
+
+	var matches []client.VPC
 	for _, v := range vpcs {
-		if v.Name == name {
-			return &v, nil
+		if v.CIDRBlock == data.CIDRBlock.ValueString() {
+			matches = append(matches, v)
 		}
 	}
 
-	return nil, nil // nolint:nilnil
+	return matches, nil
 }
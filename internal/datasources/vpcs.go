@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -57,6 +58,14 @@ func (d *VpcsDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 							Computed:            true,
 							MarkdownDescription: "The status of the VPC.",
 						},
+						"default_security_group_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the security group the platform created along with the VPC.",
+						},
+						"default_subnet_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the subnet the platform created along with the VPC.",
+						},
 					},
 				},
 			},
@@ -88,7 +97,7 @@ func (d *VpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	vpcs, err := d.client.ListVPCs(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list VPCs, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list VPCs", err)
 		return
 	}
 
@@ -98,6 +107,9 @@ func (d *VpcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			Name:      types.StringValue(v.Name),
 			CIDRBlock: types.StringValue(v.CIDRBlock),
 			Status:    types.StringValue(v.Status),
+
+			DefaultSecurityGroupID: types.StringValue(v.DefaultSecurityGroupID),
+			DefaultSubnetID:        types.StringValue(v.DefaultSubnetID),
 		})
 	}
 
@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,8 +25,26 @@ type TheCloudProvider struct {
 
 // TheCloudProviderModel describes the provider data model
 type TheCloudProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	APIKey          types.String `tfsdk:"api_key"`
+	Token           types.String `tfsdk:"token"`
+	APIKeyFile      types.String `tfsdk:"api_key_file"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin    types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax    types.String `tfsdk:"retry_wait_max"`
+	RetryMaxElapsed types.String `tfsdk:"retry_max_elapsed"`
+
+	RequestTimeout       types.String  `tfsdk:"request_timeout"`
+	EnableRequestLogging types.Bool    `tfsdk:"enable_request_logging"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxBurst             types.Int64   `tfsdk:"max_burst"`
+
+	DefaultAvailabilityZone types.String `tfsdk:"default_availability_zone"`
+	ExtraHeaders            types.Map    `tfsdk:"extra_headers"`
+	TenantID                types.String `tfsdk:"tenant_id"`
+
+	EventualConsistencyRetries types.Int64 `tfsdk:"eventual_consistency_retries"`
+	IncludeLogsInErrors        types.Bool  `tfsdk:"include_logs_in_errors"`
 }
 
 func (p *TheCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -34,14 +56,85 @@ func (p *TheCloudProvider) Schema(ctx context.Context, req provider.SchemaReques
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The base URL for The Cloud API.",
-				Optional:            true,
+				MarkdownDescription: "The base URL for The Cloud API. A comma-separated list of URLs may be given, in which case the next URL is tried " +
+					"when one can't be reached. Can also be set with the `THECLOUD_ENDPOINT` environment variable. Defaults to `http://localhost:8080`.",
+				Optional: true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "The API key for authentication.",
+				MarkdownDescription: "The API key for authentication. Can also be set with the `THECLOUD_API_KEY` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "A bearer token for authentication, sent instead of an API key. Conflicts with `api_key` and `api_key_file`. Can also be set with the `THECLOUD_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key. The file is read again when the API rejects the key, so rotated keys are picked up. Conflicts with `api_key` and `token`. Can also be set with the `THECLOUD_API_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a failed or throttled API request is retried. Defaults to `5`.",
+				Optional:            true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait between retries, as a Go duration (e.g. `1s`). Defaults to `1s`.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, as a Go duration (e.g. `30s`). Also bounds any `Retry-After` sent by the API. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"retry_max_elapsed": schema.StringAttribute{
+				MarkdownDescription: "Maximum total time spent retrying a single API request, as a Go duration (e.g. `5m`). No retry is started once it has passed, even if `max_retries` allows more. Defaults to `5m`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for a single API request attempt, as a Go duration (e.g. `30s`). Retries get a fresh timeout. Defaults to no timeout.",
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second, shared by all resources and counting retries. Defaults to no limit.",
+				Optional:            true,
+			},
+			"max_burst": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests that may be sent at once before `max_requests_per_second` applies. Requires `max_requests_per_second`. Defaults to `1`.",
+				Optional:            true,
+			},
+			"enable_request_logging": schema.BoolAttribute{
+				MarkdownDescription: "Log every API request and response, including bodies, at TRACE level. API keys and secret values are redacted. " +
+					"When unset, requests are logged without bodies if `TF_LOG` is `TRACE`.",
+				Optional: true,
+			},
+			"default_availability_zone": schema.StringAttribute{
+				MarkdownDescription: "Availability zone for subnets and volumes created without an `availability_zone`. The zone is recorded in each resource's state.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every API request, e.g. for a proxy in front of the API that requires its own headers. " +
+					"Headers set by the provider itself, such as credentials, `Content-Type` and `User-Agent`, cannot be overridden.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"eventual_consistency_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a VPC, subnet or security group that was created in the last few minutes is read again, " +
+					"over about 5 seconds, when the API reports it missing. Reads right after a create may briefly miss the new object, " +
+					"which would otherwise remove it from state. Objects that have existed for longer are not retried. `0` disables the retries. Defaults to `3`.",
+				Optional: true,
+			},
+			"include_logs_in_errors": schema.BoolAttribute{
+				MarkdownDescription: "Add the last 20 log lines of a deployment to the error reported when its rollout fails or times out. " +
+					"Logs may contain sensitive output of the application. Defaults to `false`.",
+				Optional: true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the tenant to manage resources in, for credentials with access to several tenants. " +
+					"Use a provider alias per tenant to manage more than one. Defaults to the credentials' default tenant. " +
+					"Can also be set with the `THECLOUD_TENANT_ID` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -61,36 +154,293 @@ func (p *TheCloudProvider) Configure(ctx context.Context, req provider.Configure
 	// 3. Defaults
 
 	endpoint := os.Getenv("THECLOUD_ENDPOINT")
-	apiKey := os.Getenv("THECLOUD_API_KEY")
 
 	if !data.Endpoint.IsNull() {
 		endpoint = data.Endpoint.ValueString()
 	}
-	if !data.APIKey.IsNull() {
-		apiKey = data.APIKey.ValueString()
-	}
 
 	if endpoint == "" {
 		endpoint = "http://localhost:8080"
 	}
 
-	if apiKey == "" {
+	endpoints := resolveEndpoints(endpoint, resp)
+
+	opts := []client.Option{client.WithUserAgent(client.UserAgent(p.version, req.TerraformVersion))}
+
+	if len(endpoints) > 1 {
+		opts = append(opts, client.WithFailoverEndpoints(endpoints[1:]...))
+	}
+
+	apiKey, token, apiKeyFile := resolveCredentials(data, resp)
+
+	if apiKeyFile != "" {
+		var err error
+		apiKey, err = client.ReadAPIKeyFile(apiKeyFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to Read API Key File",
+				fmt.Sprintf("Unable to read API key from %s: %s", apiKeyFile, err),
+			)
+			return
+		}
+		opts = append(opts, client.WithAPIKeyFile(apiKeyFile))
+	}
+
+	if token != "" {
+		opts = append(opts, client.WithBearerToken(token))
+	}
+
+	if apiKey == "" && token == "" && !resp.Diagnostics.HasError() {
 		resp.Diagnostics.AddError(
 			"Missing API Key",
-			"The provider requires an API key for authentication. Set it via 'api_key' in the provider block or THECLOUD_API_KEY environment variable.",
+			"The provider requires credentials for authentication. Set 'api_key', 'token' or 'api_key_file' in the provider block, "+
+				"or the THECLOUD_API_KEY, THECLOUD_TOKEN or THECLOUD_API_KEY_FILE environment variable.",
+		)
+	}
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Retry Configuration",
+				"max_retries must not be negative.",
+			)
+		}
+		opts = append(opts, client.WithRetryMax(int(data.MaxRetries.ValueInt64())))
+	}
+
+	waitMin := parseDuration(data.RetryWaitMin, path.Root("retry_wait_min"), resp)
+	waitMax := parseDuration(data.RetryWaitMax, path.Root("retry_wait_max"), resp)
+	if waitMin > 0 || waitMax > 0 {
+		if waitMin == 0 {
+			waitMin = time.Second
+		}
+		if waitMax == 0 {
+			waitMax = 30 * time.Second
+		}
+		if waitMin > waitMax {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_wait_min"),
+				"Invalid Retry Configuration",
+				fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).", waitMin, waitMax),
+			)
+		}
+		opts = append(opts, client.WithRetryWait(waitMin, waitMax))
+	}
+
+	if elapsed := parseDuration(data.RetryMaxElapsed, path.Root("retry_max_elapsed"), resp); elapsed > 0 {
+		opts = append(opts, client.WithRetryMaxElapsed(elapsed))
+	}
+
+	if timeout := parseDuration(data.RequestTimeout, path.Root("request_timeout"), resp); timeout > 0 {
+		opts = append(opts, client.WithRequestTimeout(timeout))
+	}
+
+	if !data.MaxRequestsPerSecond.IsNull() {
+		burst := int64(1)
+		if !data.MaxBurst.IsNull() {
+			burst = data.MaxBurst.ValueInt64()
+		}
+		if data.MaxRequestsPerSecond.ValueFloat64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_requests_per_second"),
+				"Invalid Rate Limit Configuration",
+				"max_requests_per_second must be greater than zero.",
+			)
+		}
+		if burst < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_burst"),
+				"Invalid Rate Limit Configuration",
+				"max_burst must be at least 1.",
+			)
+		}
+		opts = append(opts, client.WithRateLimit(data.MaxRequestsPerSecond.ValueFloat64(), int(burst)))
+	} else if !data.MaxBurst.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_burst"),
+			"Invalid Rate Limit Configuration",
+			"max_burst requires max_requests_per_second to be set.",
 		)
 	}
 
+	if !data.EnableRequestLogging.IsNull() {
+		if data.EnableRequestLogging.ValueBool() {
+			opts = append(opts, client.WithRequestLogging(true))
+		}
+	} else if strings.EqualFold(os.Getenv("TF_LOG"), "TRACE") {
+		opts = append(opts, client.WithRequestLogging(false))
+	}
+
+	if zone := data.DefaultAvailabilityZone.ValueString(); zone != "" {
+		if !client.AvailabilityZonePattern.MatchString(zone) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_availability_zone"),
+				"Invalid Availability Zone",
+				fmt.Sprintf("Expected an availability zone name such as \"us-east-1a\", got %q.", zone),
+			)
+		}
+		opts = append(opts, client.WithDefaultAvailabilityZone(zone))
+	}
+
+	if !data.ExtraHeaders.IsNull() {
+		headers := map[string]string{}
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+		opts = append(opts, client.WithExtraHeaders(headers))
+	}
+
+	if !data.EventualConsistencyRetries.IsNull() {
+		if data.EventualConsistencyRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("eventual_consistency_retries"),
+				"Invalid Retry Configuration",
+				"eventual_consistency_retries must not be negative.",
+			)
+		}
+		opts = append(opts, client.WithConsistencyRetries(int(data.EventualConsistencyRetries.ValueInt64())))
+	}
+
+	if data.IncludeLogsInErrors.ValueBool() {
+		opts = append(opts, client.WithLogsInErrors(true))
+	}
+
+	tenantID := os.Getenv("THECLOUD_TENANT_ID")
+	if !data.TenantID.IsNull() {
+		tenantID = data.TenantID.ValueString()
+	}
+	if tenantID != "" {
+		opts = append(opts, client.WithTenantID(tenantID))
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	c := client.NewClient(endpoint, apiKey)
+	c := client.NewClient(endpoints[0], apiKey, opts...)
+
+	// Fail fast instead of on the first resource when the tenant can't be used
+	if tenantID != "" {
+		checkTenantAccess(ctx, c, tenantID, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
 }
 
+// checkTenantAccess verifies that the credentials can access the configured tenant.
+func checkTenantAccess(ctx context.Context, c *client.Client, tenantID string, resp *provider.ConfigureResponse) {
+	tenant, err := c.GetTenant(ctx, tenantID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant_id"),
+			"Unable to Access Tenant",
+			fmt.Sprintf("The provider credentials cannot be used with tenant %s: %s. "+
+				"Check that tenant_id is correct and that the API key or token is a member of the tenant.", tenantID, err),
+		)
+		return
+	}
+
+	if tenant == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tenant_id"),
+			"Tenant Not Found",
+			fmt.Sprintf("Tenant %s does not exist or is not visible to the provider credentials.", tenantID),
+		)
+	}
+}
+
+// resolveEndpoints splits a comma-separated endpoint list and normalizes each entry.
+// The first endpoint is the primary, the rest are tried in order when it can't be reached.
+func resolveEndpoints(endpoint string, resp *provider.ConfigureResponse) []string {
+	var endpoints []string
+	for _, e := range strings.Split(endpoint, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+
+		normalized, err := client.NormalizeEndpoint(e)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid Endpoint",
+				fmt.Sprintf("The provider endpoint must be an absolute http or https URL: %s", err),
+			)
+			continue
+		}
+		endpoints = append(endpoints, normalized)
+	}
+
+	if len(endpoints) == 0 && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid Endpoint",
+			"The provider endpoint must contain at least one URL.",
+		)
+	}
+
+	return endpoints
+}
+
+// resolveCredentials picks the one credential to authenticate with. Credentials in the
+// provider block take precedence over the environment, and only one may be configured.
+func resolveCredentials(data TheCloudProviderModel, resp *provider.ConfigureResponse) (apiKey, token, apiKeyFile string) {
+	configured := 0
+	for _, v := range []types.String{data.APIKey, data.Token, data.APIKeyFile} {
+		if !v.IsNull() {
+			configured++
+		}
+	}
+
+	if configured > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting Credentials",
+			"Only one of 'api_key', 'token' and 'api_key_file' may be set in the provider block.",
+		)
+		return "", "", ""
+	}
+
+	switch {
+	case !data.APIKey.IsNull():
+		return data.APIKey.ValueString(), "", ""
+	case !data.Token.IsNull():
+		return "", data.Token.ValueString(), ""
+	case !data.APIKeyFile.IsNull():
+		return "", "", data.APIKeyFile.ValueString()
+	}
+
+	if v := os.Getenv("THECLOUD_API_KEY"); v != "" {
+		return v, "", ""
+	}
+	if v := os.Getenv("THECLOUD_TOKEN"); v != "" {
+		return "", v, ""
+	}
+	return "", "", os.Getenv("THECLOUD_API_KEY_FILE")
+}
+
+// parseDuration parses an optional duration attribute, returning zero when unset.
+func parseDuration(value types.String, attr path.Path, resp *provider.ConfigureResponse) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			attr,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as \"2s\", got %q.", value.ValueString()),
+		)
+		return 0
+	}
+
+	return d
+}
+
 func (p *TheCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewVpcResource,
@@ -98,29 +448,43 @@ func (p *TheCloudProvider) Resources(ctx context.Context) []func() resource.Reso
 		resources.NewVolumeResource,
 		resources.NewSecurityGroupResource,
 		resources.NewSecurityGroupRuleResource,
+		resources.NewDefaultSecurityGroupResource,
 		resources.NewLoadBalancerResource,
 		resources.NewLoadBalancerTargetResource,
 		resources.NewSecretResource,
 		resources.NewApiKeyResource,
+		resources.NewSSHKeyResource,
 		resources.NewScalingGroupResource,
 		resources.NewSubnetResource,
 		resources.NewSnapshotResource,
+		resources.NewSnapshotPolicyResource,
 		resources.NewDatabaseResource,
+		resources.NewDatabaseBackupResource,
 		resources.NewElasticIPResource,
 		resources.NewElasticIPAssociationResource,
+		resources.NewNATGatewayResource,
+		resources.NewVPCPeeringResource,
+		resources.NewVPCPeeringAccepterResource,
+		resources.NewNodePoolResource,
+		resources.NewCertificateResource,
 		resources.NewDNSZoneResource,
 		resources.NewDNSRecordResource,
 		resources.NewClusterResource,
 		resources.NewGlobalLBResource,
+		resources.NewGlobalIPResource,
 		resources.NewGlobalLBEndpointResource,
 		resources.NewBucketResource,
 		resources.NewGatewayRouteResource,
 		resources.NewFunctionResource,
+		resources.NewFunctionTriggerResource,
+		resources.NewFunctionAliasResource,
 		resources.NewCacheResource,
 		resources.NewQueueResource,
+		resources.NewQueueRedriveTaskResource,
 		resources.NewImageResource,
 		resources.NewDeploymentResource,
 		resources.NewTenantResource,
+		resources.NewTenantMemberResource,
 	}
 }
 
@@ -130,8 +494,10 @@ func (p *TheCloudProvider) DataSources(ctx context.Context) []func() datasource.
 		datasources.NewVpcsDataSource,
 		datasources.NewSubnetDataSource,
 		datasources.NewSubnetsDataSource,
+		datasources.NewSecurityGroupDataSource,
 		datasources.NewInstanceDataSource,
 		datasources.NewInstancesDataSource,
+		datasources.NewInstanceConsoleOutputDataSource,
 		datasources.NewClusterDataSource,
 		datasources.NewClustersDataSource,
 		datasources.NewBucketDataSource,
@@ -140,8 +506,24 @@ func (p *TheCloudProvider) DataSources(ctx context.Context) []func() datasource.
 		datasources.NewGatewayRoutesDataSource,
 		datasources.NewFunctionDataSource,
 		datasources.NewFunctionsDataSource,
+		datasources.NewFunctionInvocationDataSource,
+		datasources.NewImageDataSource,
+		datasources.NewImagesDataSource,
+		datasources.NewDNSZoneDataSource,
+		datasources.NewDNSRecordsDataSource,
 		datasources.NewDatabaseDataSource,
 		datasources.NewDatabasesDataSource,
+		datasources.NewLoadBalancerTargetsDataSource,
+		datasources.NewSecretDataSource,
+		datasources.NewSSHKeyDataSource,
+		datasources.NewScalingGroupDataSource,
+		datasources.NewQueueDataSource,
+		datasources.NewElasticIPDataSource,
+		datasources.NewElasticIPsDataSource,
+		datasources.NewAvailabilityZonesDataSource,
+		datasources.NewGPUTypesDataSource,
+		datasources.NewAuditEventsDataSource,
+		datasources.NewDeploymentLogsDataSource,
 	}
 }
 
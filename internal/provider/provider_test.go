@@ -0,0 +1,512 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// sensitiveAttributeNames lists attribute names that always carry credentials, whatever
+// they belong to. Any attribute with one of these names, at any nesting depth, must be
+// marked Sensitive so it is redacted from plan output.
+var sensitiveAttributeNames = map[string]bool{
+	"password":          true,
+	"token":             true,
+	"kubeconfig":        true,
+	"connection_string": true,
+	"private_key":       true,
+}
+
+// sensitiveAttributes lists, by owner and path, the attributes that carry credentials or
+// secret material under names that are also used for plain values elsewhere, like key
+// and value.
+var sensitiveAttributes = []string{
+	"provider api_key",
+	"provider token",
+	"provider extra_headers",
+	"resource thecloud_api_key key",
+	"resource thecloud_cache connection_string",
+	"resource thecloud_certificate private_key_pem",
+	"resource thecloud_database connection_string",
+	"resource thecloud_database generated_password",
+	"resource thecloud_database password",
+	"resource thecloud_deployment sensitive_env",
+	"resource thecloud_gateway_route request_headers",
+	"resource thecloud_secret generated_value",
+	"resource thecloud_secret value",
+	"data source thecloud_database connection_string",
+	"data source thecloud_databases databases.connection_string",
+	"data source thecloud_secret value",
+}
+
+func TestProviderSchemaSensitiveAttributes(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	// sensitive records whether each attribute is Sensitive, keyed like sensitiveAttributes
+	sensitive := map[string]bool{}
+
+	var providerResp fwprovider.SchemaResponse
+	p.Schema(ctx, fwprovider.SchemaRequest{}, &providerResp)
+	collectSensitiveAttributes(sensitive, "provider ", reflect.ValueOf(providerResp.Schema.GetAttributes()))
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metaResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "thecloud"}, &metaResp)
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		owner := "resource " + metaResp.TypeName + " "
+		collectSensitiveAttributes(sensitive, owner, reflect.ValueOf(schemaResp.Schema.GetAttributes()))
+		collectSensitiveBlocks(sensitive, owner, reflect.ValueOf(schemaResp.Schema.GetBlocks()))
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+
+		var metaResp datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "thecloud"}, &metaResp)
+
+		var schemaResp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+		owner := "data source " + metaResp.TypeName + " "
+		collectSensitiveAttributes(sensitive, owner, reflect.ValueOf(schemaResp.Schema.GetAttributes()))
+		collectSensitiveBlocks(sensitive, owner, reflect.ValueOf(schemaResp.Schema.GetBlocks()))
+	}
+
+	for _, attr := range sensitiveAttributes {
+		isSensitive, ok := sensitive[attr]
+		if !ok {
+			t.Errorf("%s: attribute not found in the schema", attr)
+		} else if !isSensitive {
+			t.Errorf("%s: attribute must be marked Sensitive", attr)
+		}
+	}
+
+	for attr, isSensitive := range sensitive {
+		name := attr[strings.LastIndexAny(attr, " .")+1:]
+		if sensitiveAttributeNames[name] && !isSensitive {
+			t.Errorf("%s: attribute must be marked Sensitive", attr)
+		}
+	}
+}
+
+// collectSensitiveAttributes walks a map of schema attributes, recursing into nested
+// attribute objects, and records whether each is Sensitive. Reflection is used because
+// the framework's shared attribute interfaces live in an internal package and cannot be
+// named here.
+func collectSensitiveAttributes(sensitive map[string]bool, prefix string, attrs reflect.Value) {
+	iter := attrs.MapRange()
+	for iter.Next() {
+		name := iter.Key().String()
+		attr := iter.Value()
+		if attr.Kind() == reflect.Interface {
+			attr = attr.Elem()
+		}
+
+		isSensitive := attr.MethodByName("IsSensitive")
+		sensitive[prefix+name] = isSensitive.IsValid() && isSensitive.Call(nil)[0].Bool()
+
+		if nested := attr.MethodByName("GetNestedObject"); nested.IsValid() {
+			obj := nested.Call(nil)[0]
+			collectSensitiveAttributes(sensitive, prefix+name+".", obj.MethodByName("GetAttributes").Call(nil)[0])
+		}
+	}
+}
+
+// collectSensitiveBlocks walks schema blocks and records the attributes and blocks they contain.
+func collectSensitiveBlocks(sensitive map[string]bool, prefix string, blocks reflect.Value) {
+	iter := blocks.MapRange()
+	for iter.Next() {
+		name := iter.Key().String()
+		block := iter.Value()
+		if block.Kind() == reflect.Interface {
+			block = block.Elem()
+		}
+
+		nested := block.MethodByName("GetNestedObject")
+		if !nested.IsValid() {
+			continue
+		}
+		obj := nested.Call(nil)[0]
+		collectSensitiveAttributes(sensitive, prefix+name+".", obj.MethodByName("GetAttributes").Call(nil)[0])
+		collectSensitiveBlocks(sensitive, prefix+name+".", obj.MethodByName("GetBlocks").Call(nil)[0])
+	}
+}
+
+// configureProvider runs Configure with the given provider block values. Attributes
+// not present in values are left null.
+func configureProvider(t *testing.T, values map[string]tftypes.Value) fwprovider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp fwprovider.SchemaResponse
+	p.Schema(ctx, fwprovider.SchemaRequest{}, &schemaResp)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	req := fwprovider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objType, attrs),
+		},
+	}
+
+	var resp fwprovider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+
+	return resp
+}
+
+func TestProviderConfigureNormalizesEndpoint(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, "https://api.thecloud.dev/"),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	})
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "https://api.thecloud.dev", c.Endpoint)
+}
+
+func TestProviderConfigureMalformedEndpoint(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, "api.thecloud.dev"),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+	})
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Nil(t, resp.ResourceData)
+}
+
+func TestProviderConfigureEndpointList(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer live.Close()
+
+	t.Setenv("THECLOUD_ENDPOINT", dead.URL+"/, "+live.URL)
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key":     tftypes.NewValue(tftypes.String, "test-key"),
+		"max_retries": tftypes.NewValue(tftypes.Number, 0),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, dead.URL, c.Endpoint)
+
+	// The request fails over to the second endpoint
+	assert.NoError(t, c.DeleteVPC(context.Background(), "vpc-1"))
+	assert.Equal(t, live.URL+"/vpcs", c.BuildURL("/vpcs"))
+}
+
+func TestProviderConfigureHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, server.URL),
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+		"extra_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"X-Proxy-Auth": tftypes.NewValue(tftypes.String, "proxy-secret"),
+		}),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.NoError(t, c.DeleteVPC(context.Background(), "vpc-1"))
+
+	assert.Equal(t, "terraform-provider-thecloud/test", header.Get("User-Agent"))
+	assert.Equal(t, "proxy-secret", header.Get("X-Proxy-Auth"))
+}
+
+func TestProviderConfigureTenant(t *testing.T) {
+	var tenantHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantHeaders = append(tenantHeaders, r.Header.Get("X-Tenant-ID"))
+		switch r.URL.Path {
+		case "/tenants/tenant-1":
+			_, _ = w.Write([]byte(`{"data":{"id":"tenant-1","name":"Team"}}`))
+		case "/tenants/tenant-forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"type":"forbidden","message":"not a member of this tenant"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	configure := func(tenantID string) fwprovider.ConfigureResponse {
+		return configureProvider(t, map[string]tftypes.Value{
+			"endpoint":    tftypes.NewValue(tftypes.String, server.URL),
+			"api_key":     tftypes.NewValue(tftypes.String, "test-key"),
+			"max_retries": tftypes.NewValue(tftypes.Number, 0),
+			"tenant_id":   tftypes.NewValue(tftypes.String, tenantID),
+		})
+	}
+
+	resp := configure("tenant-1")
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.ResourceData)
+	assert.Equal(t, []string{"tenant-1"}, tenantHeaders)
+
+	resp = configure("tenant-forbidden")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Unable to Access Tenant", resp.Diagnostics[0].Summary())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "not a member of this tenant")
+	assert.Nil(t, resp.ResourceData)
+
+	resp = configure("tenant-gone")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Tenant Not Found", resp.Diagnostics[0].Summary())
+	assert.Nil(t, resp.ResourceData)
+}
+
+func TestProviderConfigureInvalidEndpointList(t *testing.T) {
+	for name, endpoint := range map[string]string{
+		"malformed entry": "https://api.thecloud.dev, api2.thecloud.dev",
+		"only separators": " , ",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint": tftypes.NewValue(tftypes.String, endpoint),
+				"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+			})
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Nil(t, resp.ResourceData)
+		})
+	}
+}
+
+func TestProviderConfigureRetrySettings(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key":        tftypes.NewValue(tftypes.String, "test-key"),
+		"max_retries":    tftypes.NewValue(tftypes.Number, 10),
+		"retry_wait_min": tftypes.NewValue(tftypes.String, "500ms"),
+		"retry_wait_max": tftypes.NewValue(tftypes.String, "1m"),
+
+		"retry_max_elapsed":            tftypes.NewValue(tftypes.String, "10m"),
+		"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, 0),
+	})
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.ResourceData)
+}
+
+func TestProviderConfigureInvalidRetrySettings(t *testing.T) {
+	for name, values := range map[string]map[string]tftypes.Value{
+		"negative retries":             {"max_retries": tftypes.NewValue(tftypes.Number, -1)},
+		"negative consistency retries": {"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, -1)},
+		"bad duration":                 {"retry_wait_min": tftypes.NewValue(tftypes.String, "soon")},
+		"bad max elapsed":              {"retry_max_elapsed": tftypes.NewValue(tftypes.String, "0s")},
+		"min above max": {
+			"retry_wait_min": tftypes.NewValue(tftypes.String, "1m"),
+			"retry_wait_max": tftypes.NewValue(tftypes.String, "10s"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			values["api_key"] = tftypes.NewValue(tftypes.String, "test-key")
+			resp := configureProvider(t, values)
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Nil(t, resp.ResourceData)
+		})
+	}
+}
+
+func TestProviderConfigureRateLimit(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key":                 tftypes.NewValue(tftypes.String, "test-key"),
+		"max_requests_per_second": tftypes.NewValue(tftypes.Number, 2.5),
+		"max_burst":               tftypes.NewValue(tftypes.Number, 5),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, resp.ResourceData)
+
+	for name, values := range map[string]map[string]tftypes.Value{
+		"zero rate":   {"max_requests_per_second": tftypes.NewValue(tftypes.Number, 0)},
+		"zero burst":  {"max_requests_per_second": tftypes.NewValue(tftypes.Number, 1), "max_burst": tftypes.NewValue(tftypes.Number, 0)},
+		"burst alone": {"max_burst": tftypes.NewValue(tftypes.Number, 5)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			values["api_key"] = tftypes.NewValue(tftypes.String, "test-key")
+			resp := configureProvider(t, values)
+
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Nil(t, resp.ResourceData)
+		})
+	}
+}
+
+func TestProviderConfigurePrecedence(t *testing.T) {
+	t.Setenv("THECLOUD_ENDPOINT", "https://env.thecloud.dev")
+	t.Setenv("THECLOUD_API_KEY", "env-key")
+
+	// Environment variables are used when the attributes are unset
+	resp := configureProvider(t, map[string]tftypes.Value{})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "https://env.thecloud.dev", c.Endpoint)
+	assert.Equal(t, "env-key", c.APIKey)
+
+	// Provider configuration wins over the environment
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, "https://config.thecloud.dev"),
+		"api_key":  tftypes.NewValue(tftypes.String, "config-key"),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok = resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "https://config.thecloud.dev", c.Endpoint)
+	assert.Equal(t, "config-key", c.APIKey)
+}
+
+func TestProviderConfigureDefaultEndpoint(t *testing.T) {
+	t.Setenv("THECLOUD_ENDPOINT", "")
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key": tftypes.NewValue(tftypes.String, "test-key"),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "http://localhost:8080", c.Endpoint)
+}
+
+func TestProviderConfigureMissingAPIKey(t *testing.T) {
+	t.Setenv("THECLOUD_API_KEY", "")
+	t.Setenv("THECLOUD_TOKEN", "")
+	t.Setenv("THECLOUD_API_KEY_FILE", "")
+
+	resp := configureProvider(t, map[string]tftypes.Value{})
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Missing API Key", resp.Diagnostics.Errors()[0].Summary())
+	assert.Nil(t, resp.ResourceData)
+}
+
+func TestProviderConfigureRequestTimeout(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key":         tftypes.NewValue(tftypes.String, "test-key"),
+		"request_timeout": tftypes.NewValue(tftypes.String, "45s"),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"api_key":         tftypes.NewValue(tftypes.String, "test-key"),
+		"request_timeout": tftypes.NewValue(tftypes.String, "-1s"),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestProviderConfigureToken(t *testing.T) {
+	t.Setenv("THECLOUD_API_KEY", "env-key")
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, "ci-token"),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "ci-token", c.Token)
+	assert.Empty(t, c.APIKey)
+}
+
+func TestProviderConfigureAPIKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("file-key\n"), 0o600))
+
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key_file": tftypes.NewValue(tftypes.String, keyFile),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "file-key", c.APIKey)
+
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"api_key_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing")),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestProviderConfigureConflictingCredentials(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key": tftypes.NewValue(tftypes.String, "test-key"),
+		"token":   tftypes.NewValue(tftypes.String, "ci-token"),
+	})
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Conflicting Credentials", resp.Diagnostics.Errors()[0].Summary())
+	assert.Nil(t, resp.ResourceData)
+}
+
+func TestProviderConfigureDefaultAvailabilityZone(t *testing.T) {
+	resp := configureProvider(t, map[string]tftypes.Value{
+		"api_key":                   tftypes.NewValue(tftypes.String, "test-key"),
+		"default_availability_zone": tftypes.NewValue(tftypes.String, "us-east-1b"),
+	})
+	assert.False(t, resp.Diagnostics.HasError())
+
+	c, ok := resp.ResourceData.(*client.Client)
+	assert.True(t, ok)
+	assert.Equal(t, "us-east-1b", c.AvailabilityZoneOrDefault(""))
+	assert.Equal(t, "us-east-1a", c.AvailabilityZoneOrDefault("us-east-1a"))
+
+	resp = configureProvider(t, map[string]tftypes.Value{
+		"api_key":                   tftypes.NewValue(tftypes.String, "test-key"),
+		"default_availability_zone": tftypes.NewValue(tftypes.String, "us-east1a"),
+	})
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Nil(t, resp.ResourceData)
+}
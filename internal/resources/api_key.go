@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -101,7 +102,7 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	key, err := r.client.CreateAPIKey(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create API key, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create API key", err)
 		return
 	}
 
@@ -123,20 +124,12 @@ func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	keys, err := r.client.ListAPIKeys(ctx)
+	foundKey, err := r.client.GetAPIKey(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to list API keys, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read API key", err)
 		return
 	}
 
-	var foundKey *client.APIKey
-	for _, k := range keys {
-		if k.ID == data.ID.ValueString() {
-			foundKey = &k
-			break
-		}
-	}
-
 	if foundKey == nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -145,7 +138,7 @@ func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.ID = types.StringValue(foundKey.ID)
 	data.Name = types.StringValue(foundKey.Name)
 	data.CreatedAt = types.StringValue(foundKey.CreatedAt)
-	// Key is not returned by list for security, we keep the one from state
+	// Key is only returned on creation for security, we keep the one from state
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -165,7 +158,7 @@ func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.RevokeAPIKey(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to revoke API key, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to revoke API key", err)
 		return
 	}
 }
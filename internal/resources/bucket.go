@@ -3,20 +3,29 @@ package resources
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &BucketResource{}
 var _ resource.ResourceWithImportState = &BucketResource{}
+var _ resource.ResourceWithValidateConfig = &BucketResource{}
+var _ resource.ResourceWithModifyPlan = &BucketResource{}
 
 func NewBucketResource() resource.Resource {
 	return &BucketResource{}
@@ -29,12 +38,40 @@ type BucketResource struct {
 
 // BucketResourceModel describes the resource data model.
 type BucketResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	IsPublic          types.Bool   `tfsdk:"is_public"`
-	VersioningEnabled types.Bool   `tfsdk:"versioning_enabled"`
-	EncryptionEnabled types.Bool   `tfsdk:"encryption_enabled"`
-	CreatedAt         types.String `tfsdk:"created_at"`
+	ID                types.String               `tfsdk:"id"`
+	Name              types.String               `tfsdk:"name"`
+	IsPublic          types.Bool                 `tfsdk:"is_public"`
+	VersioningEnabled types.Bool                 `tfsdk:"versioning_enabled"`
+	EncryptionEnabled types.Bool                 `tfsdk:"encryption_enabled"`
+	CreatedAt         types.String               `tfsdk:"created_at"`
+	Endpoint          types.String               `tfsdk:"endpoint"`
+	WebsiteEndpoint   types.String               `tfsdk:"website_endpoint"`
+	LifecycleRules    []BucketLifecycleRuleModel `tfsdk:"lifecycle_rule"`
+	CORSRules         []BucketCORSRuleModel      `tfsdk:"cors_rule"`
+	Website           *BucketWebsiteModel        `tfsdk:"website"`
+}
+
+// BucketWebsiteModel describes the static website hosting of a bucket.
+type BucketWebsiteModel struct {
+	IndexDocument types.String `tfsdk:"index_document"`
+	ErrorDocument types.String `tfsdk:"error_document"`
+}
+
+// BucketCORSRuleModel describes a CORS rule of a bucket.
+type BucketCORSRuleModel struct {
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+	MaxAgeSeconds  types.Int64    `tfsdk:"max_age_seconds"`
+}
+
+// BucketLifecycleRuleModel describes a lifecycle rule of a bucket.
+type BucketLifecycleRuleModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Prefix                          types.String `tfsdk:"prefix"`
+	ExpirationDays                  types.Int64  `tfsdk:"expiration_days"`
+	NoncurrentVersionExpirationDays types.Int64  `tfsdk:"noncurrent_version_expiration_days"`
+	Enabled                         types.Bool   `tfsdk:"enabled"`
 }
 
 func (r *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,20 +100,124 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"is_public": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Whether the bucket is public.",
+				MarkdownDescription: "Whether the bucket is public. Can be changed in place.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"versioning_enabled": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Whether versioning is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"encryption_enabled": schema.BoolAttribute{
 				Computed:            true,
 				MarkdownDescription: "Whether encryption is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the bucket was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname objects in the bucket are served from, e.g. for the content of a CNAME record.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"website_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname the static website is served from. Null unless the `website` block is set.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"website": schema.SingleNestedBlock{
+				MarkdownDescription: "Serves the objects in the bucket as a static website. Removing the block disables website hosting.",
+				Attributes: map[string]schema.Attribute{
+					"index_document": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The object returned for requests to the root or a directory, e.g. `index.html`.",
+					},
+					"error_document": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The object returned when a requested object doesn't exist, e.g. `404.html`.",
+					},
+				},
+			},
+			"cors_rule": schema.ListNestedBlock{
+				MarkdownDescription: "A rule allowing cross-origin requests to the bucket. Rules are evaluated in order and the first match applies.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_origins": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "Origins allowed to make requests, such as `https://example.com` or `*`.",
+						},
+						"allowed_methods": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "HTTP methods allowed for the origins (GET, PUT, POST, DELETE, HEAD).",
+						},
+						"allowed_headers": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "Request headers allowed in preflight requests.",
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "How long browsers may cache the preflight response, in seconds.",
+							Validators: []validator.Int64{
+								validators.Int64AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"lifecycle_rule": schema.SetNestedBlock{
+				MarkdownDescription: "A rule expiring objects in the bucket. The blocks make up the complete set of rules; " +
+					"rules added outside Terraform are removed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The identifier of the rule, unique within the bucket.",
+						},
+						"prefix": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Only objects whose keys start with this prefix are expired. Defaults to all objects.",
+						},
+						"expiration_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Number of days after creation when objects are deleted.",
+							Validators: []validator.Int64{
+								validators.Int64AtLeast(1),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Number of days after becoming noncurrent when object versions are deleted. Only applies to versioned buckets.",
+							Validators: []validator.Int64{
+								validators.Int64AtLeast(1),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the rule is applied. Defaults to `true`.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -101,6 +242,33 @@ func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *BucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BucketResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range data.LifecycleRules {
+		if rule.ID.IsNull() || rule.ID.IsUnknown() {
+			continue
+		}
+
+		id := rule.ID.ValueString()
+		if seen[id] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("lifecycle_rule"),
+				"Duplicate Lifecycle Rule ID",
+				fmt.Sprintf("Lifecycle rule IDs must be unique within a bucket, but %q is used more than once.", id),
+			)
+		}
+		seen[id] = true
+	}
+}
+
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data BucketResourceModel
 
@@ -112,7 +280,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	bucket, err := r.client.CreateBucket(ctx, data.Name.ValueString(), data.IsPublic.ValueBool())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Bucket, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Bucket", err)
 		return
 	}
 
@@ -120,12 +288,14 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.IsPublic = types.BoolValue(bucket.IsPublic)
 	data.EncryptionEnabled = types.BoolValue(bucket.EncryptionEnabled)
 	data.CreatedAt = types.StringValue(bucket.CreatedAt)
+	data.Endpoint = types.StringValue(bucket.Endpoint)
+	data.WebsiteEndpoint = types.StringNull()
 
 	// Update versioning if requested (API Create doesn't seem to set it directly)
 	if !data.VersioningEnabled.IsNull() && data.VersioningEnabled.ValueBool() {
 		err = r.client.SetBucketVersioning(ctx, bucket.Name, true)
 		if err != nil {
-			resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to set Bucket versioning, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to set Bucket versioning", err)
 			return
 		}
 		data.VersioningEnabled = types.BoolValue(true)
@@ -133,6 +303,31 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.VersioningEnabled = types.BoolValue(false)
 	}
 
+	if len(data.LifecycleRules) > 0 {
+		err = r.client.PutBucketLifecycle(ctx, bucket.Name, expandBucketLifecycleRules(data.LifecycleRules))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to set Bucket lifecycle rules", err)
+			return
+		}
+	}
+
+	if len(data.CORSRules) > 0 {
+		err = r.client.PutBucketCORS(ctx, bucket.Name, expandBucketCORSRules(data.CORSRules))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to set Bucket CORS rules", err)
+			return
+		}
+	}
+
+	if data.Website != nil {
+		website, err := r.client.PutBucketWebsite(ctx, bucket.Name, *expandBucketWebsite(data.Website))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to set Bucket website", err)
+			return
+		}
+		data.WebsiteEndpoint = types.StringValue(website.Endpoint)
+	}
+
 	tflog.Trace(ctx, "created a Bucket resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -149,7 +344,7 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	bucket, err := r.client.GetBucket(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Bucket, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Bucket", err)
 		return
 	}
 
@@ -164,6 +359,32 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.VersioningEnabled = types.BoolValue(bucket.VersioningEnabled)
 	data.EncryptionEnabled = types.BoolValue(bucket.EncryptionEnabled)
 	data.CreatedAt = types.StringValue(bucket.CreatedAt)
+	data.Endpoint = types.StringValue(bucket.Endpoint)
+
+	rules, err := r.client.GetBucketLifecycle(ctx, bucket.Name)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Bucket lifecycle rules", err)
+		return
+	}
+	data.LifecycleRules = flattenBucketLifecycleRules(rules)
+
+	corsRules, err := r.client.GetBucketCORS(ctx, bucket.Name)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Bucket CORS rules", err)
+		return
+	}
+	data.CORSRules = flattenBucketCORSRules(corsRules)
+
+	website, err := r.client.GetBucketWebsite(ctx, bucket.Name)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Bucket website", err)
+		return
+	}
+	data.Website = flattenBucketWebsite(website)
+	data.WebsiteEndpoint = types.StringNull()
+	if website != nil {
+		data.WebsiteEndpoint = types.StringValue(website.Endpoint)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -178,16 +399,57 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if !plan.IsPublic.IsUnknown() && !plan.IsPublic.Equal(state.IsPublic) {
+		err := r.client.SetBucketAccess(ctx, plan.Name.ValueString(), plan.IsPublic.ValueBool())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Bucket access", err)
+			return
+		}
+	}
+
 	if !plan.VersioningEnabled.Equal(state.VersioningEnabled) {
 		err := r.client.SetBucketVersioning(ctx, plan.Name.ValueString(), plan.VersioningEnabled.ValueBool())
 		if err != nil {
-			resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to update Bucket versioning, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Bucket versioning", err)
+			return
+		}
+	}
+
+	planRules := expandBucketLifecycleRules(plan.LifecycleRules)
+	if !reflect.DeepEqual(planRules, expandBucketLifecycleRules(state.LifecycleRules)) {
+		err := r.client.PutBucketLifecycle(ctx, plan.Name.ValueString(), planRules)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Bucket lifecycle rules", err)
 			return
 		}
 	}
 
-	// is_public update not clearly supported by single PATCH, but let's assume it might be or handled via Recreate
-	// For now we only handle versioning as updateable field based on handler code.
+	planCORS := expandBucketCORSRules(plan.CORSRules)
+	if !reflect.DeepEqual(planCORS, expandBucketCORSRules(state.CORSRules)) {
+		err := r.client.PutBucketCORS(ctx, plan.Name.ValueString(), planCORS)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Bucket CORS rules", err)
+			return
+		}
+	}
+
+	plan.WebsiteEndpoint = state.WebsiteEndpoint
+	switch {
+	case plan.Website == nil && state.Website != nil:
+		err := r.client.DeleteBucketWebsite(ctx, plan.Name.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to remove Bucket website", err)
+			return
+		}
+		plan.WebsiteEndpoint = types.StringNull()
+	case plan.Website != nil && !reflect.DeepEqual(expandBucketWebsite(plan.Website), expandBucketWebsite(state.Website)):
+		website, err := r.client.PutBucketWebsite(ctx, plan.Name.ValueString(), *expandBucketWebsite(plan.Website))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Bucket website", err)
+			return
+		}
+		plan.WebsiteEndpoint = types.StringValue(website.Endpoint)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -203,11 +465,166 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteBucket(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Bucket, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Bucket", err)
 		return
 	}
 }
 
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var website *BucketWebsiteModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("website"), &website)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The website endpoint is only known once hosting is enabled, and stays the same
+	// while it remains enabled
+	endpoint := types.StringUnknown()
+	if website == nil {
+		endpoint = types.StringNull()
+	} else if !req.State.Raw.IsNull() {
+		var stateEndpoint types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("website_endpoint"), &stateEndpoint)...)
+		if !stateEndpoint.IsNull() {
+			endpoint = stateEndpoint
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("website_endpoint"), endpoint)...)
+}
+
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
+
+// expandBucketLifecycleRules converts the rules to their API form, sorted by ID so that
+// two sets of rules can be compared.
+func expandBucketLifecycleRules(rules []BucketLifecycleRuleModel) []client.BucketLifecycleRule {
+	expanded := make([]client.BucketLifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		expanded = append(expanded, client.BucketLifecycleRule{
+			ID:                              rule.ID.ValueString(),
+			Prefix:                          rule.Prefix.ValueString(),
+			ExpirationDays:                  int(rule.ExpirationDays.ValueInt64()),
+			NoncurrentVersionExpirationDays: int(rule.NoncurrentVersionExpirationDays.ValueInt64()),
+			Enabled:                         rule.Enabled.IsNull() || rule.Enabled.ValueBool(),
+		})
+	}
+	sort.Slice(expanded, func(i, j int) bool { return expanded[i].ID < expanded[j].ID })
+	return expanded
+}
+
+// flattenBucketLifecycleRules converts API rules to the model. Unset prefixes and
+// expirations are null, matching how they are omitted in configuration.
+func flattenBucketLifecycleRules(rules []client.BucketLifecycleRule) []BucketLifecycleRuleModel {
+	flat := make([]BucketLifecycleRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		m := BucketLifecycleRuleModel{
+			ID:                              types.StringValue(rule.ID),
+			Prefix:                          types.StringNull(),
+			ExpirationDays:                  types.Int64Null(),
+			NoncurrentVersionExpirationDays: types.Int64Null(),
+			Enabled:                         types.BoolValue(rule.Enabled),
+		}
+		if rule.Prefix != "" {
+			m.Prefix = types.StringValue(rule.Prefix)
+		}
+		if rule.ExpirationDays != 0 {
+			m.ExpirationDays = types.Int64Value(int64(rule.ExpirationDays))
+		}
+		if rule.NoncurrentVersionExpirationDays != 0 {
+			m.NoncurrentVersionExpirationDays = types.Int64Value(int64(rule.NoncurrentVersionExpirationDays))
+		}
+		flat = append(flat, m)
+	}
+	return flat
+}
+
+// expandBucketCORSRules converts the rules to their API form. Rule order is kept, but
+// the values within each rule are sorted so that two sets of rules can be compared.
+func expandBucketCORSRules(rules []BucketCORSRuleModel) []client.BucketCORSRule {
+	expanded := make([]client.BucketCORSRule, 0, len(rules))
+	for _, rule := range rules {
+		expanded = append(expanded, client.BucketCORSRule{
+			AllowedOrigins: expandSortedStrings(rule.AllowedOrigins),
+			AllowedMethods: expandSortedStrings(rule.AllowedMethods),
+			AllowedHeaders: expandSortedStrings(rule.AllowedHeaders),
+			MaxAgeSeconds:  int(rule.MaxAgeSeconds.ValueInt64()),
+		})
+	}
+	return expanded
+}
+
+func flattenBucketCORSRules(rules []client.BucketCORSRule) []BucketCORSRuleModel {
+	flat := make([]BucketCORSRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		m := BucketCORSRuleModel{
+			AllowedOrigins: flattenStrings(rule.AllowedOrigins),
+			AllowedMethods: flattenStrings(rule.AllowedMethods),
+			AllowedHeaders: flattenStrings(rule.AllowedHeaders),
+			MaxAgeSeconds:  types.Int64Null(),
+		}
+		if rule.MaxAgeSeconds != 0 {
+			m.MaxAgeSeconds = types.Int64Value(int64(rule.MaxAgeSeconds))
+		}
+		flat = append(flat, m)
+	}
+	return flat
+}
+
+// expandSortedStrings returns the values sorted, or nil when there are none.
+func expandSortedStrings(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	sort.Strings(out)
+	return out
+}
+
+// flattenStrings returns the values as a model slice, or nil (a null set) when there are none.
+func flattenStrings(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+// expandBucketWebsite converts the website block to its API form, or nil when the block is
+// not set.
+func expandBucketWebsite(website *BucketWebsiteModel) *client.BucketWebsite {
+	if website == nil {
+		return nil
+	}
+	return &client.BucketWebsite{
+		IndexDocument: website.IndexDocument.ValueString(),
+		ErrorDocument: website.ErrorDocument.ValueString(),
+	}
+}
+
+func flattenBucketWebsite(website *client.BucketWebsite) *BucketWebsiteModel {
+	if website == nil {
+		return nil
+	}
+	m := &BucketWebsiteModel{
+		IndexDocument: types.StringValue(website.IndexDocument),
+		ErrorDocument: types.StringNull(),
+	}
+	if website.ErrorDocument != "" {
+		m.ErrorDocument = types.StringValue(website.ErrorDocument)
+	}
+	return m
+}
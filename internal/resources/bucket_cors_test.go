@@ -0,0 +1,25 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketCORSRulesRoundTrip(t *testing.T) {
+	rules := []client.BucketCORSRule{
+		{AllowedOrigins: []string{"https://example.com"}, AllowedMethods: []string{"GET", "HEAD"}, MaxAgeSeconds: 3600},
+		{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, AllowedHeaders: []string{"Authorization"}},
+	}
+
+	flat := flattenBucketCORSRules(rules)
+	assert.Nil(t, flat[0].AllowedHeaders)
+	assert.True(t, flat[1].MaxAgeSeconds.IsNull())
+	assert.Equal(t, rules, expandBucketCORSRules(flat))
+
+	// Values within a rule are compared regardless of order, but rule order matters.
+	flat[0].AllowedMethods[0], flat[0].AllowedMethods[1] = flat[0].AllowedMethods[1], flat[0].AllowedMethods[0]
+	assert.Equal(t, rules, expandBucketCORSRules(flat))
+	assert.NotEqual(t, rules, expandBucketCORSRules([]BucketCORSRuleModel{flat[1], flat[0]}))
+}
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketLifecycleRulesRoundTrip(t *testing.T) {
+	rules := []client.BucketLifecycleRule{
+		{ID: "logs", Prefix: "logs/", ExpirationDays: 30, Enabled: true},
+		{ID: "old-versions", NoncurrentVersionExpirationDays: 7},
+	}
+
+	flat := flattenBucketLifecycleRules(rules)
+	assert.Equal(t, types.StringValue("logs/"), flat[0].Prefix)
+	assert.True(t, flat[1].Prefix.IsNull())
+	assert.True(t, flat[1].ExpirationDays.IsNull())
+	assert.Equal(t, types.BoolValue(false), flat[1].Enabled)
+
+	// Expanding sorts by ID, so the order of the set doesn't matter.
+	reversed := []BucketLifecycleRuleModel{flat[1], flat[0]}
+	assert.Equal(t, rules, expandBucketLifecycleRules(reversed))
+}
+
+func TestBucketValidateConfigDuplicateRuleIDs(t *testing.T) {
+	ctx := context.Background()
+	r := NewBucketResource().(*BucketResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	setType := objectType.AttributeTypes["lifecycle_rule"].(tftypes.Set)
+	ruleType := setType.ElementType.(tftypes.Object)
+
+	rule := func(id string, days int64) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range ruleType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, id)
+		values["expiration_days"] = tftypes.NewValue(tftypes.Number, days)
+		return tftypes.NewValue(ruleType, values)
+	}
+
+	validate := func(rules ...tftypes.Value) bool {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "logs")
+		values["lifecycle_rule"] = tftypes.NewValue(setType, rules)
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate())
+	assert.False(t, validate(rule("a", 1), rule("b", 1)))
+	assert.True(t, validate(rule("a", 1), rule("a", 30)))
+}
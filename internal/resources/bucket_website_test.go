@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketWebsiteRoundTrip(t *testing.T) {
+	assert.Nil(t, flattenBucketWebsite(nil))
+	assert.Nil(t, expandBucketWebsite(nil))
+
+	website := &client.BucketWebsite{IndexDocument: "index.html"}
+	flat := flattenBucketWebsite(&client.BucketWebsite{IndexDocument: "index.html", Endpoint: "site.example.com"})
+	assert.True(t, flat.ErrorDocument.IsNull())
+	assert.Equal(t, website, expandBucketWebsite(flat))
+}
+
+func TestBucketModifyPlanWebsiteEndpoint(t *testing.T) {
+	ctx := context.Background()
+	r := NewBucketResource().(*BucketResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	websiteType := objectType.AttributeTypes["website"].(tftypes.Object)
+
+	raw := func(website bool, endpoint interface{}) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "assets")
+		values["website_endpoint"] = tftypes.NewValue(tftypes.String, endpoint)
+		if website {
+			values["website"] = tftypes.NewValue(websiteType, map[string]tftypes.Value{
+				"index_document": tftypes.NewValue(tftypes.String, "index.html"),
+				"error_document": tftypes.NewValue(tftypes.String, nil),
+			})
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	plan := func(state tftypes.Value, website bool) types.String {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw(website, tftypes.UnknownValue)},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var endpoint types.String
+		resp.Plan.GetAttribute(ctx, path.Root("website_endpoint"), &endpoint)
+		return endpoint
+	}
+
+	noState := tftypes.NewValue(objectType, nil)
+	assert.True(t, plan(noState, false).IsNull())
+	assert.True(t, plan(noState, true).IsUnknown())
+
+	// Enabling hosting on an existing bucket yields a new endpoint
+	assert.True(t, plan(raw(false, nil), true).IsUnknown())
+	assert.Equal(t, types.StringValue("site.example.com"), plan(raw(true, "site.example.com"), true))
+	assert.True(t, plan(raw(true, "site.example.com"), false).IsNull())
+}
@@ -2,15 +2,24 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -29,17 +38,37 @@ type CacheResource struct {
 
 // CacheResourceModel describes the resource data model.
 type CacheResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Engine           types.String `tfsdk:"engine"`
-	Version          types.String `tfsdk:"version"`
-	VpcID            types.String `tfsdk:"vpc_id"`
-	MemoryMB         types.Int64  `tfsdk:"memory_mb"`
-	Status           types.String `tfsdk:"status"`
-	Port             types.Int64  `tfsdk:"port"`
-	ConnectionString types.String `tfsdk:"connection_string"`
+	ID                types.String           `tfsdk:"id"`
+	Name              types.String           `tfsdk:"name"`
+	Engine            types.String           `tfsdk:"engine"`
+	Version           types.String           `tfsdk:"version"`
+	VpcID             types.String           `tfsdk:"vpc_id"`
+	MemoryMB          types.Int64            `tfsdk:"memory_mb"`
+	Status            types.String           `tfsdk:"status"`
+	Host              types.String           `tfsdk:"host"`
+	Port              types.Int64            `tfsdk:"port"`
+	Address           types.String           `tfsdk:"address"`
+	ConnectionString  types.String           `tfsdk:"connection_string"`
+	ReplicaCount      types.Int64            `tfsdk:"replica_count"`
+	ClusterMode       types.Bool             `tfsdk:"cluster_mode"`
+	Nodes             types.List             `tfsdk:"nodes"`
+	MaintenanceWindow maintenanceWindowValue `tfsdk:"maintenance_window"`
+	Timeouts          timeouts.Value         `tfsdk:"timeouts"`
 }
 
+// CacheNodeModel describes a node of a cache.
+type CacheNodeModel struct {
+	ID       types.String `tfsdk:"id"`
+	Role     types.String `tfsdk:"role"`
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+var cacheNodeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":       types.StringType,
+	"role":     types.StringType,
+	"endpoint": types.StringType,
+}}
+
 func (r *CacheResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cache"
 }
@@ -63,6 +92,9 @@ func (r *CacheResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"engine": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The cache engine (e.g. redis).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"version": schema.StringAttribute{
 				Required:            true,
@@ -80,21 +112,90 @@ func (r *CacheResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"memory_mb": schema.Int64Attribute{
 				Required:            true,
-				MarkdownDescription: "Memory allocation in MB.",
+				MarkdownDescription: "Memory allocation in MB. Changing it resizes the cache in place.",
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the cache.",
 			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname clients connect to. With replicas, it's the primary node.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"port": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The port the cache is listening on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `host:port` clients connect to. Unlike `connection_string`, it holds no credentials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"connection_string": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The connection string for the cache.",
+				MarkdownDescription: "The connection string for the cache. With replicas, it points to the primary node.",
 				Sensitive:           true,
 			},
+			"replica_count": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The number of read replicas. Changing it adds or removes replicas in place. Defaults to `0`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_mode": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the cache runs in cluster mode, sharding keys across primaries. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"maintenance_window": schema.StringAttribute{
+				CustomType:          maintenanceWindowType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The weekly window in which the platform may upgrade the cache, formatted as `ddd:hh24:mi-ddd:hh24:mi` in UTC (e.g., `sun:03:00-sun:04:00`). Changing it updates the cache in place. Defaults to a window chosen by the platform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					maintenanceWindowValidator{},
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The nodes of the cache.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the node.",
+						},
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role of the node (primary or replica).",
+						},
+						"endpoint": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The endpoint of the node, as host:port.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Update: true,
+			}),
 		},
 	}
 }
@@ -127,23 +228,39 @@ func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	opts := client.CreateCacheOptions{
+		ClusterMode: data.ClusterMode.ValueBool(),
+	}
+	if !data.MaintenanceWindow.IsUnknown() {
+		opts.MaintenanceWindow = data.MaintenanceWindow.ValueString()
+	}
+	if !data.ReplicaCount.IsNull() && !data.ReplicaCount.IsUnknown() {
+		v := int(data.ReplicaCount.ValueInt64())
+		opts.ReplicaCount = &v
+	}
+
 	cache, err := r.client.CreateCache(
 		ctx,
 		data.Name.ValueString(),
 		data.Version.ValueString(),
 		int(data.MemoryMB.ValueInt64()),
 		data.VpcID.ValueString(),
+		opts,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Cache, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Cache", err)
 		return
 	}
 
 	data.ID = types.StringValue(cache.ID)
 	data.Engine = types.StringValue(cache.Engine)
 	data.Status = types.StringValue(cache.Status)
+	data.Host = types.StringValue(cache.ResolvedHost())
 	data.Port = types.Int64Value(int64(cache.Port))
+	data.Address = types.StringValue(cache.Address())
 	data.ConnectionString = types.StringValue(cache.ConnectionString)
+	data.MaintenanceWindow = newMaintenanceWindowValue(cache.MaintenanceWindow)
+	resp.Diagnostics.Append(data.setTopology(ctx, cache)...)
 
 	tflog.Trace(ctx, "created a Cache resource")
 
@@ -161,7 +278,7 @@ func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	cache, err := r.client.GetCache(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Cache, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Cache", err)
 		return
 	}
 
@@ -174,17 +291,93 @@ func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Name = types.StringValue(cache.Name)
 	data.Engine = types.StringValue(cache.Engine)
 	data.Version = types.StringValue(cache.Version)
-	data.VpcID = types.StringValue(cache.VpcID)
+	if !data.VpcID.IsNull() || cache.VpcID != "" {
+		data.VpcID = types.StringValue(cache.VpcID)
+	}
 	data.MemoryMB = types.Int64Value(int64(cache.MemoryMB))
 	data.Status = types.StringValue(cache.Status)
+	data.Host = types.StringValue(cache.ResolvedHost())
 	data.Port = types.Int64Value(int64(cache.Port))
+	data.Address = types.StringValue(cache.Address())
 	data.ConnectionString = types.StringValue(cache.ConnectionString)
+	data.MaintenanceWindow = newMaintenanceWindowValue(cache.MaintenanceWindow)
+	resp.Diagnostics.Append(data.setTopology(ctx, cache)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported, handled by RequiresReplace if needed
+	var data, state CacheResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MemoryMB.Equal(state.MemoryMB) {
+		err := r.client.ResizeCache(ctx, data.ID.ValueString(), int(data.MemoryMB.ValueInt64()))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to resize Cache", err)
+			return
+		}
+	}
+
+	if !data.ReplicaCount.IsUnknown() && !data.ReplicaCount.Equal(state.ReplicaCount) {
+		err := r.client.SetCacheReplicaCount(ctx, data.ID.ValueString(), int(data.ReplicaCount.ValueInt64()))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to change Cache replica count", err)
+			return
+		}
+	}
+
+	if !data.MaintenanceWindow.IsUnknown() && !data.MaintenanceWindow.IsNull() && !data.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		err := r.client.SetCacheMaintenanceWindow(ctx, data.ID.ValueString(), data.MaintenanceWindow.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Cache maintenance window", err)
+			return
+		}
+	}
+
+	var cache *client.Cache
+
+	// Wait for the changes to finish so dependents see the new size and nodes
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := r.client.GetCache(ctx, data.ID.ValueString())
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		cache = current
+		return current.Status, false, nil
+	}, []string{"available"}, []string{"resizing", "scaling", "modifying", "pending"}, client.WaitOpts{Timeout: updateTimeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Update Timeout", fmt.Sprintf("Timed out waiting for Cache %s to finish updating. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Cache %s did not become available after updating", data.ID.ValueString()), err)
+		return
+	}
+
+	data.MemoryMB = types.Int64Value(int64(cache.MemoryMB))
+	data.Engine = types.StringValue(cache.Engine)
+	data.Status = types.StringValue(cache.Status)
+	data.Host = types.StringValue(cache.ResolvedHost())
+	data.Port = types.Int64Value(int64(cache.Port))
+	data.Address = types.StringValue(cache.Address())
+	data.ConnectionString = types.StringValue(cache.ConnectionString)
+	data.MaintenanceWindow = newMaintenanceWindowValue(cache.MaintenanceWindow)
+	resp.Diagnostics.Append(data.setTopology(ctx, cache)...)
+
+	tflog.Trace(ctx, "updated a Cache resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -198,7 +391,7 @@ func (r *CacheResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteCache(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Cache, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Cache", err)
 		return
 	}
 }
@@ -206,3 +399,22 @@ func (r *CacheResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *CacheResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setTopology stores the cache's replica count, cluster mode and nodes in the model.
+func (m *CacheResourceModel) setTopology(ctx context.Context, cache *client.Cache) diag.Diagnostics {
+	m.ReplicaCount = types.Int64Value(int64(cache.ReplicaCount))
+	m.ClusterMode = types.BoolValue(cache.ClusterMode)
+
+	nodes := make([]CacheNodeModel, 0, len(cache.Nodes))
+	for _, n := range cache.Nodes {
+		nodes = append(nodes, CacheNodeModel{
+			ID:       types.StringValue(n.ID),
+			Role:     types.StringValue(n.Role),
+			Endpoint: types.StringValue(n.Endpoint),
+		})
+	}
+
+	var diags diag.Diagnostics
+	m.Nodes, diags = types.ListValueFrom(ctx, cacheNodeType, nodes)
+	return diags
+}
@@ -0,0 +1,149 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const cacheResourceName = "thecloud_cache.test"
+
+func TestAccCacheResourceResize(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	cacheName := fmt.Sprintf("test-cache-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheConfig(cacheName, 512),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "memory_mb", "512"),
+					resource.TestCheckResourceAttrSet(cacheResourceName, "id"),
+					resource.TestCheckResourceAttrSet(cacheResourceName, "host"),
+					resource.TestCheckResourceAttrSet(cacheResourceName, "address"),
+				),
+			},
+			// Changing memory only resizes in place
+			{
+				Config: testAccCacheConfig(cacheName, 1024),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cacheResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "memory_mb", "1024"),
+					resource.TestCheckResourceAttr(cacheResourceName, "status", "available"),
+				),
+			},
+			// The refreshed size matches
+			{
+				Config:   testAccCacheConfig(cacheName, 1024),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCacheResourceReplicas(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	cacheName := fmt.Sprintf("test-cache-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheReplicaConfig(cacheName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "replica_count", "1"),
+					resource.TestCheckResourceAttr(cacheResourceName, "cluster_mode", "false"),
+					resource.TestCheckResourceAttr(cacheResourceName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(cacheResourceName, "nodes.0.role", "primary"),
+				),
+			},
+			// Adding a replica happens in place
+			{
+				Config: testAccCacheReplicaConfig(cacheName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cacheResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "replica_count", "2"),
+					resource.TestCheckResourceAttr(cacheResourceName, "nodes.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCacheResourceMaintenanceWindow(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	cacheName := fmt.Sprintf("test-cache-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCacheMaintenanceWindowConfig(cacheName, "Sun:03:00-Sun:04:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "maintenance_window", "Sun:03:00-Sun:04:00"),
+				),
+			},
+			// The window normalized by the API doesn't show up as a change
+			{
+				Config:   testAccCacheMaintenanceWindowConfig(cacheName, "Sun:03:00-Sun:04:00"),
+				PlanOnly: true,
+			},
+			// Moving the window happens in place
+			{
+				Config: testAccCacheMaintenanceWindowConfig(cacheName, "sat:02:00-sat:03:00"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(cacheResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(cacheResourceName, "maintenance_window", "sat:02:00-sat:03:00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCacheConfig(name string, memoryMB int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_cache" "test" {
+  name      = "%s"
+  version   = "7.0"
+  memory_mb = %d
+}
+`, name, memoryMB)
+}
+
+func testAccCacheReplicaConfig(name string, replicas int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_cache" "test" {
+  name          = "%s"
+  version       = "7.0"
+  memory_mb     = 512
+  replica_count = %d
+}
+`, name, replicas)
+}
+
+func testAccCacheMaintenanceWindowConfig(name, window string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_cache" "test" {
+  name               = "%s"
+  version            = "7.0"
+  memory_mb          = 512
+  maintenance_window = "%s"
+}
+`, name, window)
+}
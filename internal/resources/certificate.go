@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithImportState = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client *client.Client
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	Expiry         types.String `tfsdk:"expiry"`
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Certificate resource uploads a TLS certificate and its private key for load balancers to serve. " +
+			"Certificates cannot be changed; to rotate one, create a new certificate and point the load balancer's `tls.certificate_id` at it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The PEM-encoded certificate, followed by any intermediate certificates.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				MarkdownDescription: "The PEM-encoded private key of the certificate. The API never returns it, " +
+					"so it is kept as configured and changes made outside of Terraform are not detected.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						privateKeyChanged,
+						"Changing the private key replaces the certificate.",
+						"Changing the private key replaces the certificate.",
+					),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 fingerprint of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the certificate expires, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// privateKeyChanged requires replacement when a known private key changes. An imported
+// certificate has no key in state, so setting it afterwards only records it.
+func privateKeyChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.CreateCertificate(ctx, data.Name.ValueString(), data.CertificatePEM.ValueString(), data.PrivateKeyPEM.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create certificate", err)
+		return
+	}
+
+	data.ID = types.StringValue(cert.ID)
+	data.setCertificate(cert)
+
+	tflog.Trace(ctx, "created a Certificate resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.GetCertificate(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read certificate", err)
+		return
+	}
+
+	if cert == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// private_key_pem is left as it is in state
+	data.setCertificate(cert)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only records a private key set after import: every other change forces replacement.
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCertificate(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete certificate", err)
+		return
+	}
+}
+
+func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCertificate copies the API's view of a certificate into the model. The configured
+// PEM is kept unless the certificate differs by more than surrounding whitespace.
+func (m *CertificateResourceModel) setCertificate(cert *client.Certificate) {
+	m.Name = types.StringValue(cert.Name)
+	if strings.TrimSpace(cert.CertificatePEM) != strings.TrimSpace(m.CertificatePEM.ValueString()) {
+		m.CertificatePEM = types.StringValue(cert.CertificatePEM)
+	}
+	m.Fingerprint = types.StringValue(cert.Fingerprint)
+	m.Expiry = types.StringValue(cert.Expiry)
+}
@@ -0,0 +1,89 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const certificateResourceName = "thecloud_certificate.first"
+
+func TestAccCertificateResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	firstCert, firstKey, err := acctest.RandTLSCert("thecloud-first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondCert, secondKey, err := acctest.RandTLSCert("thecloud-second")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig(rName, firstCert, firstKey, secondCert, secondKey, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(certificateResourceName, "id"),
+					resource.TestCheckResourceAttrSet(certificateResourceName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(certificateResourceName, "expiry"),
+					resource.TestCheckResourceAttrPair(lbResourceName, "tls.certificate_id", certificateResourceName, "id"),
+				),
+			},
+			// Rotating the certificate updates the load balancer in place
+			{
+				Config: testAccCertificateConfig(rName, firstCert, firstKey, secondCert, secondKey, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(lbResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPair(lbResourceName, "tls.certificate_id", "thecloud_certificate.second", "id"),
+			},
+			{
+				ResourceName:      certificateResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API never returns the private key
+				ImportStateVerifyIgnore: []string{"private_key_pem"},
+			},
+		},
+	})
+}
+
+func testAccCertificateConfig(rName, firstCert, firstKey, secondCert, secondKey, active string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_certificate" "first" {
+  name            = "cert-first-%[1]s"
+  certificate_pem = %[2]q
+  private_key_pem = %[3]q
+}
+
+resource "thecloud_certificate" "second" {
+  name            = "cert-second-%[1]s"
+  certificate_pem = %[4]q
+  private_key_pem = %[5]q
+}
+
+resource "thecloud_vpc" "lb_vpc" {
+  name       = "cert-test-vpc-%[1]s"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_load_balancer" "test" {
+  name   = "cert-test-lb-%[1]s"
+  vpc_id = thecloud_vpc.lb_vpc.id
+  port   = 443
+
+  tls {
+    certificate_id  = thecloud_certificate.%[6]s.id
+    min_tls_version = "1.2"
+  }
+}
+`, rName, firstCert, firstKey, secondCert, secondKey, active)
+}
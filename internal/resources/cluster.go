@@ -4,19 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
+var _ resource.ResourceWithUpgradeState = &ClusterResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterResource{}
 
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{}
@@ -29,17 +36,18 @@ type ClusterResource struct {
 
 // ClusterResourceModel describes the resource data model.
 type ClusterResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	VpcID              types.String `tfsdk:"vpc_id"`
-	Version            types.String `tfsdk:"version"`
-	WorkerCount        types.Int64  `tfsdk:"worker_count"`
-	Status             types.String `tfsdk:"status"`
-	PodCIDR            types.String `tfsdk:"pod_cidr"`
-	ServiceCIDR        types.String `tfsdk:"service_cidr"`
-	NetworkIsolation   types.Bool   `tfsdk:"network_isolation"`
-	HAEnabled          types.Bool   `tfsdk:"ha_enabled"`
-	APIServerLBAddress types.String `tfsdk:"api_server_lb_address"`
+	ID                 types.String             `tfsdk:"id"`
+	Name               types.String             `tfsdk:"name"`
+	VpcID              types.String             `tfsdk:"vpc_id"`
+	Version            clusterVersionValue      `tfsdk:"version"`
+	WorkerCount        types.Int64              `tfsdk:"worker_count"`
+	Status             types.String             `tfsdk:"status"`
+	PodCIDR            types.String             `tfsdk:"pod_cidr"`
+	ServiceCIDR        types.String             `tfsdk:"service_cidr"`
+	NetworkIsolation   types.Bool               `tfsdk:"network_isolation"`
+	HAEnabled          types.Bool               `tfsdk:"ha_enabled"`
+	APIServerLBAddress types.String             `tfsdk:"api_server_lb_address"`
+	Autoscaling        *ClusterAutoscalingModel `tfsdk:"autoscaling"`
 }
 
 func (r *ClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +57,7 @@ func (r *ClusterResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Cluster resource allows you to manage managed Kubernetes clusters.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -70,14 +79,19 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"version": schema.StringAttribute{
+				CustomType:          clusterVersionType{},
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The Kubernetes version of the cluster.",
+				MarkdownDescription: "The Kubernetes version of the cluster. Changing it upgrades the cluster in place; downgrades are rejected at plan time.",
 			},
 			"worker_count": schema.Int64Attribute{
-				Optional:            true,
-				Computed:            true,
-				MarkdownDescription: "The number of worker nodes in the cluster.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The number of worker nodes in the cluster. Once the cluster has `thecloud_node_pool` resources, workers are scaled through the pools: leave this unset and it reports the cluster's total, since changing it is rejected at plan time. " +
+					"While `autoscaling` is enabled the autoscaler owns the worker count: it can't be configured, and reports the autoscaler's current count.",
+				PlanModifiers: []planmodifier.Int64{
+					workerCountOwnedByAutoscaler{},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -106,6 +120,32 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The address of the API server load balancer.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"autoscaling": schema.SingleNestedBlock{
+				MarkdownDescription: "Settings for the worker autoscaler. Removing the block disables autoscaling.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required:            true,
+						MarkdownDescription: "Whether the autoscaler manages the number of workers.",
+					},
+					"min_workers": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The fewest workers the autoscaler scales down to. Required when enabled.",
+						Validators: []validator.Int64{
+							validators.Int64AtLeast(0),
+						},
+					},
+					"max_workers": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The most workers the autoscaler scales up to. Required when enabled.",
+						Validators: []validator.Int64{
+							validators.Int64AtLeast(1),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -128,6 +168,27 @@ func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *ClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ClusterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.Autoscaling.validate()...)
+
+	if data.Autoscaling.enabled() && !data.WorkerCount.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("worker_count"),
+			"Worker Count Owned By Autoscaler",
+			"While autoscaling is enabled the autoscaler owns the number of workers, so worker_count can't be configured. "+
+				"Remove worker_count to let it follow the autoscaler, or use autoscaling's min_workers and max_workers to bound it.",
+		)
+	}
+}
+
 func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ClusterResourceModel
 
@@ -145,23 +206,27 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		NetworkIsolation: data.NetworkIsolation.ValueBool(),
 		HA:               data.HAEnabled.ValueBool(),
 	}
+	// worker_count can't be configured with autoscaling, so the cluster starts at its minimum
+	if data.Autoscaling.enabled() {
+		clusterReq.Workers = int(data.Autoscaling.MinWorkers.ValueInt64())
+	}
 
 	cluster, err := r.client.CreateCluster(ctx, clusterReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Cluster, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Cluster", err)
 		return
 	}
 
-	data.ID = types.StringValue(cluster.ID)
-	data.Status = types.StringValue(cluster.Status)
-	data.PodCIDR = types.StringValue(cluster.PodCIDR)
-	data.ServiceCIDR = types.StringValue(cluster.ServiceCIDR)
-	data.APIServerLBAddress = types.StringValue(cluster.APIServerLBAddress)
-	if data.Version.IsNull() {
-		data.Version = types.StringValue(cluster.Version)
-	}
-	if data.WorkerCount.IsNull() {
-		data.WorkerCount = types.Int64Value(int64(cluster.WorkerCount))
+	data.setCreated(cluster)
+
+	if data.Autoscaling.enabled() {
+		err = r.client.UpdateClusterAutoscaling(ctx, cluster.ID, data.Autoscaling.expand())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to configure Cluster autoscaling", err)
+			// The cluster is saved so it's tainted rather than left untracked
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
 	}
 
 	tflog.Trace(ctx, "created a Cluster resource")
@@ -180,7 +245,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	cluster, err := r.client.GetCluster(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Cluster, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Cluster", err)
 		return
 	}
 
@@ -192,7 +257,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.ID = types.StringValue(cluster.ID)
 	data.Name = types.StringValue(cluster.Name)
 	data.VpcID = types.StringValue(cluster.VpcID)
-	data.Version = types.StringValue(cluster.Version)
+	data.Version = newClusterVersionValue(cluster.Version)
 	data.WorkerCount = types.Int64Value(int64(cluster.WorkerCount))
 	data.Status = types.StringValue(cluster.Status)
 	data.PodCIDR = types.StringValue(cluster.PodCIDR)
@@ -200,6 +265,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.NetworkIsolation = types.BoolValue(cluster.NetworkIsolation)
 	data.HAEnabled = types.BoolValue(cluster.HAEnabled)
 	data.APIServerLBAddress = types.StringValue(cluster.APIServerLBAddress)
+	data.setAutoscaling(cluster.Autoscaling)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -214,18 +280,51 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Autoscaling is switched first, so a worker_count change in the same apply is not
+	// undone by the autoscaler being turned off
+	if plan.Autoscaling.expand() != state.Autoscaling.expand() {
+		err := r.client.UpdateClusterAutoscaling(ctx, plan.ID.ValueString(), plan.Autoscaling.expand())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Cluster autoscaling", err)
+			return
+		}
+	}
+
+	// worker_count is unknown when it is not configured; node pools may be changing it.
+	// While autoscaling is enabled the autoscaler owns it.
+	if plan.WorkerCount.IsUnknown() || plan.Autoscaling.enabled() {
+		plan.WorkerCount = state.WorkerCount
+	}
+
 	if !plan.WorkerCount.Equal(state.WorkerCount) {
-		err := r.client.ScaleCluster(ctx, plan.ID.ValueString(), int(plan.WorkerCount.ValueInt64()))
+		pools, err := r.client.ListNodePools(ctx, plan.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to list Node Pools", err)
+			return
+		}
+
+		// Node pools created since the plan was made; keeping the old count would
+		// contradict the plan
+		if len(pools) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("worker_count"),
+				"Cluster Not Scaled",
+				fmt.Sprintf("Cluster %s has %d node pools, which manage its workers, so worker_count can't be applied. Scale the thecloud_node_pool resources instead, and remove worker_count from the cluster configuration.", plan.ID.ValueString(), len(pools)),
+			)
+			return
+		}
+
+		err = r.client.ScaleCluster(ctx, plan.ID.ValueString(), int(plan.WorkerCount.ValueInt64()))
 		if err != nil {
-			resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to scale Cluster, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to scale Cluster", err)
 			return
 		}
 	}
 
-	if !plan.Version.Equal(state.Version) {
+	if !sameVersion(plan.Version.ValueString(), state.Version.ValueString()) {
 		err := r.client.UpgradeCluster(ctx, plan.ID.ValueString(), plan.Version.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to upgrade Cluster, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to upgrade Cluster", err)
 			return
 		}
 	}
@@ -244,11 +343,106 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	err := r.client.DeleteCluster(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Cluster, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Cluster", err)
 		return
 	}
 }
 
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ClusterResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateClusterVersionChange(ctx, state.Version.StringValue, plan.Version.StringValue)...)
+
+		if plan.WorkerCount.Equal(state.WorkerCount) {
+			return
+		}
+
+		resp.Diagnostics.Append(r.validateWorkerCountChange(ctx, state.ID.ValueString(), plan.WorkerCount)...)
+	}
+
+	if !plan.WorkerCount.IsUnknown() && !plan.WorkerCount.IsNull() && plan.WorkerCount.ValueInt64() == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("worker_count"),
+			"Cluster Has No Workers",
+			"With worker_count set to 0 the cluster has no worker nodes, so workloads cannot be scheduled until it is scaled up again.",
+		)
+	}
+}
+
+func (r *ClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeStateByName(),
+	}
+}
+
 func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// validateWorkerCountChange rejects a configured worker_count change on a cluster whose
+// workers are managed by node pools. Without this the plan would show the cluster being
+// scaled while Update skips ScaleCluster; an unset worker_count just follows the pools.
+func (r *ClusterResource) validateWorkerCountChange(ctx context.Context, clusterID string, workerCount types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The provider may not be configured yet during validation
+	if workerCount.IsUnknown() || workerCount.IsNull() || r.client == nil {
+		return diags
+	}
+
+	pools, err := r.client.ListNodePools(ctx, clusterID)
+	if err != nil {
+		apierror.AddClientError(&diags, "Unable to list Node Pools", err)
+		return diags
+	}
+
+	if len(pools) > 0 {
+		diags.AddAttributeError(
+			path.Root("worker_count"),
+			"Conflicting Worker Count",
+			fmt.Sprintf("Cluster %s has %d node pools, which manage its workers. Scale the thecloud_node_pool resources instead, and remove worker_count from the cluster configuration.", clusterID, len(pools)),
+		)
+	}
+
+	return diags
+}
+
+// setCreated stores the cluster returned on creation in the model. Optional attributes
+// left unset are planned as unknown and take the value chosen by the API.
+func (m *ClusterResourceModel) setCreated(cluster *client.Cluster) {
+	m.ID = types.StringValue(cluster.ID)
+	m.Status = types.StringValue(cluster.Status)
+	m.PodCIDR = types.StringValue(cluster.PodCIDR)
+	m.ServiceCIDR = types.StringValue(cluster.ServiceCIDR)
+	m.APIServerLBAddress = types.StringValue(cluster.APIServerLBAddress)
+	if m.Version.IsUnknown() || m.Version.IsNull() {
+		m.Version = newClusterVersionValue(cluster.Version)
+	}
+	if m.WorkerCount.IsUnknown() || m.WorkerCount.IsNull() {
+		m.WorkerCount = types.Int64Value(int64(cluster.WorkerCount))
+	}
+	if m.NetworkIsolation.IsUnknown() {
+		m.NetworkIsolation = types.BoolValue(cluster.NetworkIsolation)
+	}
+	if m.HAEnabled.IsUnknown() {
+		m.HAEnabled = types.BoolValue(cluster.HAEnabled)
+	}
+}
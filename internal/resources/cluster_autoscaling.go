@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// ClusterAutoscalingModel describes the autoscaling block.
+type ClusterAutoscalingModel struct {
+	Enabled    types.Bool  `tfsdk:"enabled"`
+	MinWorkers types.Int64 `tfsdk:"min_workers"`
+	MaxWorkers types.Int64 `tfsdk:"max_workers"`
+}
+
+// enabled reports whether the block turns the autoscaler on. A missing block disables it.
+func (m *ClusterAutoscalingModel) enabled() bool {
+	return m != nil && m.Enabled.ValueBool()
+}
+
+func (m *ClusterAutoscalingModel) expand() client.ClusterAutoscaling {
+	if m == nil {
+		return client.ClusterAutoscaling{}
+	}
+	return client.ClusterAutoscaling{
+		Enabled:    m.Enabled.ValueBool(),
+		MinWorkers: int(m.MinWorkers.ValueInt64()),
+		MaxWorkers: int(m.MaxWorkers.ValueInt64()),
+	}
+}
+
+// validate checks that an enabled autoscaler has a complete and ordered worker range.
+// Unknown values are checked once they are known.
+func (m *ClusterAutoscalingModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.enabled() {
+		return diags
+	}
+
+	bounds := []struct {
+		name  string
+		value types.Int64
+	}{{"min_workers", m.MinWorkers}, {"max_workers", m.MaxWorkers}}
+	for _, bound := range bounds {
+		if bound.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root("autoscaling").AtName(bound.name),
+				"Missing Autoscaling Bound",
+				fmt.Sprintf("%s must be set when autoscaling is enabled.", bound.name),
+			)
+		}
+	}
+
+	if m.MinWorkers.IsNull() || m.MinWorkers.IsUnknown() || m.MaxWorkers.IsNull() || m.MaxWorkers.IsUnknown() {
+		return diags
+	}
+
+	if minWorkers, maxWorkers := m.MinWorkers.ValueInt64(), m.MaxWorkers.ValueInt64(); minWorkers > maxWorkers {
+		diags.AddAttributeError(
+			path.Root("autoscaling").AtName("min_workers"),
+			"Invalid Autoscaling Range",
+			fmt.Sprintf("min_workers (%d) must not be greater than max_workers (%d).", minWorkers, maxWorkers),
+		)
+	}
+
+	return diags
+}
+
+// setAutoscaling copies the cluster's autoscaler settings into the model. A disabled
+// autoscaler without a configured block stays null, as do bounds the API leaves unset.
+func (m *ClusterResourceModel) setAutoscaling(autoscaling *client.ClusterAutoscaling) {
+	if autoscaling == nil || (!autoscaling.Enabled && m.Autoscaling == nil) {
+		m.Autoscaling = nil
+		return
+	}
+
+	current := m.Autoscaling
+	if current == nil {
+		current = &ClusterAutoscalingModel{MinWorkers: types.Int64Null(), MaxWorkers: types.Int64Null()}
+	}
+
+	m.Autoscaling = &ClusterAutoscalingModel{
+		Enabled:    types.BoolValue(autoscaling.Enabled),
+		MinWorkers: autoscalingBound(current.MinWorkers, autoscaling.MinWorkers),
+		MaxWorkers: autoscalingBound(current.MaxWorkers, autoscaling.MaxWorkers),
+	}
+}
+
+// autoscalingBound keeps an unset bound null when the API reports it as zero.
+func autoscalingBound(current types.Int64, value int) types.Int64 {
+	if value == 0 && current.IsNull() {
+		return current
+	}
+	return types.Int64Value(int64(value))
+}
+
+var _ planmodifier.Int64 = workerCountOwnedByAutoscaler{}
+
+// workerCountOwnedByAutoscaler keeps an unset worker_count at its current value while
+// autoscaling is enabled. The attribute is computed, so any other change to the cluster
+// would otherwise plan it as unknown, and the autoscaler's count would show as a diff on
+// every run.
+type workerCountOwnedByAutoscaler struct{}
+
+func (m workerCountOwnedByAutoscaler) Description(ctx context.Context) string {
+	return "While autoscaling is enabled, the autoscaler owns the worker count."
+}
+
+func (m workerCountOwnedByAutoscaler) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m workerCountOwnedByAutoscaler) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// A configured worker_count must be planned as configured. ValidateConfig rejects
+	// one while autoscaling is enabled.
+	if req.StateValue.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var enabled types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("autoscaling").AtName("enabled"), &enabled)...)
+
+	if enabled.ValueBool() {
+		resp.PlanValue = req.StateValue
+	}
+}
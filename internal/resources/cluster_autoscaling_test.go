@@ -0,0 +1,206 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterAutoscalingValidate(t *testing.T) {
+	autoscaling := func(enabled bool, minWorkers, maxWorkers types.Int64) *ClusterAutoscalingModel {
+		return &ClusterAutoscalingModel{Enabled: types.BoolValue(enabled), MinWorkers: minWorkers, MaxWorkers: maxWorkers}
+	}
+
+	var missing *ClusterAutoscalingModel
+	assert.False(t, missing.validate().HasError())
+	assert.False(t, autoscaling(true, types.Int64Value(1), types.Int64Value(5)).validate().HasError())
+	assert.False(t, autoscaling(true, types.Int64Value(3), types.Int64Value(3)).validate().HasError())
+	assert.False(t, autoscaling(true, types.Int64Unknown(), types.Int64Value(3)).validate().HasError())
+	assert.False(t, autoscaling(false, types.Int64Null(), types.Int64Null()).validate().HasError())
+
+	diags := autoscaling(true, types.Int64Value(5), types.Int64Value(2)).validate()
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Invalid Autoscaling Range", diags[0].Summary())
+
+	diags = autoscaling(true, types.Int64Value(1), types.Int64Null()).validate()
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Missing Autoscaling Bound", diags[0].Summary())
+}
+
+func TestSetAutoscaling(t *testing.T) {
+	var data ClusterResourceModel
+
+	// A disabled autoscaler is not reported unless the block is configured
+	data.setAutoscaling(&client.ClusterAutoscaling{})
+	assert.Nil(t, data.Autoscaling)
+
+	data.setAutoscaling(&client.ClusterAutoscaling{Enabled: true, MinWorkers: 1, MaxWorkers: 4})
+	assert.Equal(t, &ClusterAutoscalingModel{Enabled: types.BoolValue(true), MinWorkers: types.Int64Value(1), MaxWorkers: types.Int64Value(4)}, data.Autoscaling)
+
+	data.Autoscaling = &ClusterAutoscalingModel{Enabled: types.BoolValue(false), MinWorkers: types.Int64Null(), MaxWorkers: types.Int64Null()}
+	data.setAutoscaling(&client.ClusterAutoscaling{})
+	assert.Equal(t, &ClusterAutoscalingModel{Enabled: types.BoolValue(false), MinWorkers: types.Int64Null(), MaxWorkers: types.Int64Null()}, data.Autoscaling)
+}
+
+func TestWorkerCountOwnedByAutoscaler(t *testing.T) {
+	ctx := context.Background()
+	r := NewClusterResource().(*ClusterResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	autoscalingType := objectType.AttributeTypes["autoscaling"].(tftypes.Object)
+
+	plan := func(enabled *bool) tfsdk.Plan {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		if enabled != nil {
+			values["autoscaling"] = tftypes.NewValue(autoscalingType, map[string]tftypes.Value{
+				"enabled":     tftypes.NewValue(tftypes.Bool, *enabled),
+				"min_workers": tftypes.NewValue(tftypes.Number, 1),
+				"max_workers": tftypes.NewValue(tftypes.Number, 5),
+			})
+		}
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	modify := func(p tfsdk.Plan, config, state types.Int64) types.Int64 {
+		req := planmodifier.Int64Request{
+			Path:        path.Root("worker_count"),
+			Plan:        p,
+			ConfigValue: config,
+			StateValue:  state,
+			PlanValue:   types.Int64Unknown(),
+		}
+		resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+		workerCountOwnedByAutoscaler{}.PlanModifyInt64(ctx, req, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		return resp.PlanValue
+	}
+
+	enabled, disabled := true, false
+
+	assert.Equal(t, types.Int64Value(4), modify(plan(&enabled), types.Int64Null(), types.Int64Value(4)))
+	assert.Equal(t, types.Int64Unknown(), modify(plan(&disabled), types.Int64Null(), types.Int64Value(4)))
+	assert.Equal(t, types.Int64Unknown(), modify(plan(nil), types.Int64Null(), types.Int64Value(4)))
+
+	// Configured values and new clusters are left alone
+	assert.Equal(t, types.Int64Unknown(), modify(plan(&enabled), types.Int64Value(3), types.Int64Value(4)))
+	assert.Equal(t, types.Int64Unknown(), modify(plan(&enabled), types.Int64Null(), types.Int64Null()))
+}
+
+func TestClusterValidateConfigWorkerCountWithAutoscaling(t *testing.T) {
+	ctx := context.Background()
+	r := NewClusterResource().(*ClusterResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	autoscalingType := objectType.AttributeTypes["autoscaling"].(tftypes.Object)
+
+	validate := func(enabled bool, workerCount interface{}) bool {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["worker_count"] = tftypes.NewValue(tftypes.Number, workerCount)
+		values["autoscaling"] = tftypes.NewValue(autoscalingType, map[string]tftypes.Value{
+			"enabled":     tftypes.NewValue(tftypes.Bool, enabled),
+			"min_workers": tftypes.NewValue(tftypes.Number, 1),
+			"max_workers": tftypes.NewValue(tftypes.Number, 5),
+		})
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(true, nil))
+	assert.False(t, validate(false, 3))
+
+	// The autoscaler would overwrite the configured count after apply
+	assert.True(t, validate(true, 3))
+}
+
+func TestClusterCreateWithAutoscaling(t *testing.T) {
+	ctx := context.Background()
+
+	var created client.CreateClusterRequest
+	autoscalingStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/clusters":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			raw, err := json.Marshal(client.Cluster{ID: "cluster-1", Name: created.Name, VpcID: created.VpcID, Version: "1.29.0", WorkerCount: created.Workers, Status: "provisioning"})
+			assert.NoError(t, err)
+			assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+		case r.Method == http.MethodPatch && r.URL.Path == "/clusters/cluster-1/autoscaling":
+			w.WriteHeader(autoscalingStatus)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &ClusterResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	autoscalingType := objectType.AttributeTypes["autoscaling"].(tftypes.Object)
+
+	create := func() *resource.CreateResponse {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+		}
+		values["name"] = tftypes.NewValue(tftypes.String, "autoscaled")
+		values["vpc_id"] = tftypes.NewValue(tftypes.String, "vpc-1")
+		values["autoscaling"] = tftypes.NewValue(autoscalingType, map[string]tftypes.Value{
+			"enabled":     tftypes.NewValue(tftypes.Bool, true),
+			"min_workers": tftypes.NewValue(tftypes.Number, 2),
+			"max_workers": tftypes.NewValue(tftypes.Number, 5),
+		})
+
+		req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		r.Create(ctx, req, resp)
+		return resp
+	}
+
+	resp := create()
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, 2, created.Workers)
+
+	var data ClusterResourceModel
+	assert.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "cluster-1", data.ID.ValueString())
+	assert.Equal(t, int64(2), data.WorkerCount.ValueInt64())
+	assert.Equal(t, "1.29.0", data.Version.ValueString())
+
+	// A cluster whose autoscaler couldn't be configured is still saved
+	autoscalingStatus = http.StatusBadRequest
+	resp = create()
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "cluster-1", data.ID.ValueString())
+}
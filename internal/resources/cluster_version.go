@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// validateClusterVersionChange reports an error on the version attribute when the planned
+// Kubernetes version is older than the current one. The check is skipped when either
+// version is not known or cannot be parsed.
+func validateClusterVersionChange(ctx context.Context, current, planned types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if current.IsNull() || current.IsUnknown() || planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+
+	cmp, err := compareVersions(planned.ValueString(), current.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "skipping cluster version check", map[string]interface{}{"error": err.Error()})
+		return diags
+	}
+
+	if cmp < 0 {
+		diags.AddAttributeError(
+			path.Root("version"),
+			"Version Downgrade Not Supported",
+			fmt.Sprintf("The cluster runs Kubernetes %s and cannot be downgraded to %s. Clusters can only be upgraded; "+
+				"create a new cluster to run an older version.", current.ValueString(), planned.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// sameVersion reports whether a and b name the same version, so "1.29" and "1.29.0" are
+// not treated as a change. Versions that cannot be parsed are compared as strings.
+func sameVersion(a, b string) bool {
+	cmp, err := compareVersions(a, b)
+	if err != nil {
+		return a == b
+	}
+	return cmp == 0
+}
+
+// compareVersions orders two semantic versions, returning -1, 0 or 1. A leading "v" and
+// build metadata are ignored, missing minor and patch numbers count as zero, and a
+// pre-release sorts before its release.
+func compareVersions(a, b string) (int, error) {
+	coreA, preA, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	coreB, preB, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range coreA {
+		if coreA[i] != coreB[i] {
+			if coreA[i] < coreB[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+
+	switch {
+	case preA == preB:
+		return 0, nil
+	case preA == "":
+		return 1, nil
+	case preB == "":
+		return -1, nil
+	}
+	return strings.Compare(preA, preB), nil
+}
+
+func parseVersion(v string) ([3]int, string, error) {
+	var core [3]int
+
+	s := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	s, _, _ = strings.Cut(s, "+")
+	s, pre, _ := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) > len(core) {
+		return core, "", fmt.Errorf("invalid version %q", v)
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return core, "", fmt.Errorf("invalid version %q", v)
+		}
+		core[i] = n
+	}
+
+	return core, pre, nil
+}
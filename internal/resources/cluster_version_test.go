@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.29", "1.29.0", 0},
+		{"v1.29.0", "1.29", 0},
+		{"1.29.0+build.1", "1.29.0", 0},
+		{"1.28.5", "1.29", -1},
+		{"1.29.1", "1.29", 1},
+		{"1.10", "1.9", 1},
+		{"1.30.0-rc.1", "1.30.0", -1},
+		{"1.30.0", "1.30.0-rc.1", 1},
+		{"1.30.0-alpha", "1.30.0-beta", -1},
+	}
+
+	for _, tt := range tests {
+		got, err := compareVersions(tt.a, tt.b)
+		assert.NoError(t, err, "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, tt.want, got, "%s vs %s", tt.a, tt.b)
+	}
+
+	_, err := compareVersions("latest", "1.29")
+	assert.Error(t, err)
+	_, err = compareVersions("1.29.0.1", "1.29")
+	assert.Error(t, err)
+}
+
+func TestValidateClusterVersionChange(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(current, planned types.String) bool {
+		return validateClusterVersionChange(ctx, current, planned).HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("1.29.0"), types.StringValue("1.30")))
+	assert.False(t, validate(types.StringValue("1.29.0"), types.StringValue("1.29")))
+	assert.False(t, validate(types.StringValue("1.29.0"), types.StringUnknown()))
+	assert.False(t, validate(types.StringNull(), types.StringValue("1.28")))
+	assert.False(t, validate(types.StringValue("latest"), types.StringValue("1.28")))
+	assert.True(t, validate(types.StringValue("1.29.0"), types.StringValue("1.28")))
+	assert.True(t, validate(types.StringValue("1.29.1"), types.StringValue("1.29")))
+}
+
+func TestSameVersion(t *testing.T) {
+	assert.True(t, sameVersion("1.29", "1.29.0"))
+	assert.False(t, sameVersion("1.29", "1.30"))
+	assert.True(t, sameVersion("latest", "latest"))
+	assert.False(t, sameVersion("latest", "1.29"))
+}
+
+func TestClusterVersionSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal := func(a, b string) bool {
+		ok, diags := newClusterVersionValue(a).StringSemanticEquals(ctx, newClusterVersionValue(b))
+		assert.False(t, diags.HasError())
+		return ok
+	}
+
+	assert.True(t, equal("1.29", "1.29.0"))
+	assert.True(t, equal("v1.29.0", "1.29"))
+	assert.False(t, equal("1.29", "1.30.0"))
+	assert.False(t, equal("latest", "1.29"))
+}
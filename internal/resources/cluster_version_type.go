@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = clusterVersionType{}
+
+// clusterVersionType is a string holding a Kubernetes version. Versions that differ only
+// in formatting, like "1.29" and "1.29.0", are semantically equal, so the API reporting
+// the full version doesn't show up as a change.
+type clusterVersionType struct {
+	basetypes.StringType
+}
+
+func (t clusterVersionType) Equal(o attr.Type) bool {
+	other, ok := o.(clusterVersionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t clusterVersionType) String() string {
+	return "clusterVersionType"
+}
+
+func (t clusterVersionType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return clusterVersionValue{StringValue: in}, nil
+}
+
+func (t clusterVersionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t clusterVersionType) ValueType(ctx context.Context) attr.Value {
+	return clusterVersionValue{}
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = clusterVersionValue{}
+
+// clusterVersionValue is a value of clusterVersionType.
+type clusterVersionValue struct {
+	basetypes.StringValue
+}
+
+func newClusterVersionValue(value string) clusterVersionValue {
+	return clusterVersionValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v clusterVersionValue) Equal(o attr.Value) bool {
+	other, ok := o.(clusterVersionValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v clusterVersionValue) Type(ctx context.Context) attr.Type {
+	return clusterVersionType{}
+}
+
+func (v clusterVersionValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(clusterVersionValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return sameVersion(v.ValueString(), newValue.ValueString()), diags
+}
@@ -1,5 +1,25 @@
 package resources
 
-const (
-	errClient = "Client Error"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
+
+// stringValueIgnoringCase returns the API's value unless it only differs in case from
+// current, in which case current is kept so the API's canonical casing isn't reported
+// as a diff.
+func stringValueIgnoringCase(current types.String, value string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && strings.EqualFold(current.ValueString(), value) {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+// stringValueOrNull returns value, or null when the API left it empty.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
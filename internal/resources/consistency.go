@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// createdAtPrivateKey is the private state key holding when Terraform created the object.
+const createdAtPrivateKey = "created_at"
+
+// recentlyCreatedWindow is how long after a create a missing object is read again before
+// it is removed from state. Reads are eventually consistent, but an object that has been
+// around for longer than this and reads as missing was deleted outside Terraform.
+const recentlyCreatedWindow = 5 * time.Minute
+
+// setPrivateCreatedAt records in private state that the object was just created.
+func setPrivateCreatedAt(ctx context.Context, private privateState) diag.Diagnostics {
+	raw, err := json.Marshal(time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to record creation time, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, createdAtPrivateKey, raw)
+}
+
+// recentlyCreated reports whether private state records that Terraform created the object
+// within recentlyCreatedWindow. Objects without a recorded creation time, such as imported
+// ones, were not.
+func recentlyCreated(ctx context.Context, private privateState) bool {
+	raw, diags := private.GetKey(ctx, createdAtPrivateKey)
+	if len(raw) == 0 || diags.HasError() {
+		return false
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(createdAt) < recentlyCreatedWindow
+}
+
+// readCreated reads an object with get. If the object was created recently a missing
+// object is read again a few times, so a read racing the create doesn't remove it from
+// state.
+func readCreated[T any](ctx context.Context, c *client.Client, private privateState, get func(ctx context.Context) (*T, error)) (*T, error) {
+	if !recentlyCreated(ctx, private) {
+		return get(ctx)
+	}
+	return client.GetConsistent(ctx, c, get)
+}
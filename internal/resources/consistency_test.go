@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentlyCreated(t *testing.T) {
+	ctx := context.Background()
+	private := fakePrivateState{}
+
+	// Imported objects have no recorded creation time
+	assert.False(t, recentlyCreated(ctx, private))
+
+	assert.False(t, setPrivateCreatedAt(ctx, private).HasError())
+	assert.True(t, recentlyCreated(ctx, private))
+
+	old, err := json.Marshal(time.Now().Add(-recentlyCreatedWindow - time.Minute).UTC().Format(time.RFC3339))
+	assert.NoError(t, err)
+	private[createdAtPrivateKey] = old
+	assert.False(t, recentlyCreated(ctx, private))
+
+	private[createdAtPrivateKey] = []byte(`"yesterday"`)
+	assert.False(t, recentlyCreated(ctx, private))
+}
+
+func TestReadCreatedOnlyRetriesNewObjects(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0), client.WithConsistencyRetries(1))
+	get := func(ctx context.Context) (*client.VPC, error) {
+		return c.GetVPC(ctx, "vpc-1")
+	}
+
+	// An object that has existed for a while is gone at the first miss
+	vpc, err := readCreated(ctx, c, fakePrivateState{}, get)
+	assert.NoError(t, err)
+	assert.Nil(t, vpc)
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	private := fakePrivateState{}
+	assert.False(t, setPrivateCreatedAt(ctx, private).HasError())
+
+	// A new one is read again, which the deadline cuts short
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = readCreated(waitCtx, c, private, get)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
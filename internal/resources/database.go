@@ -2,21 +2,33 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
+
+// databaseEngines are the database engines the API supports.
+var databaseEngines = []string{"postgres", "mysql"}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -29,15 +41,26 @@ type DatabaseResource struct {
 
 // DatabaseResourceModel describes the resource data model.
 type DatabaseResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Engine           types.String `tfsdk:"engine"`
-	Version          types.String `tfsdk:"version"`
-	VpcID            types.String `tfsdk:"vpc_id"`
-	Status           types.String `tfsdk:"status"`
-	Port             types.Int64  `tfsdk:"port"`
-	Username         types.String `tfsdk:"username"`
-	ConnectionString types.String `tfsdk:"connection_string"`
+	ID                types.String           `tfsdk:"id"`
+	Name              types.String           `tfsdk:"name"`
+	Engine            types.String           `tfsdk:"engine"`
+	Version           types.String           `tfsdk:"version"`
+	VpcID             types.String           `tfsdk:"vpc_id"`
+	Status            types.String           `tfsdk:"status"`
+	Host              types.String           `tfsdk:"host"`
+	Port              types.Int64            `tfsdk:"port"`
+	Address           types.String           `tfsdk:"address"`
+	Username          types.String           `tfsdk:"username"`
+	ConnectionString  types.String           `tfsdk:"connection_string"`
+	AllowedCIDRs      types.Set              `tfsdk:"allowed_cidrs"`
+	ConfirmDenyAll    types.Bool             `tfsdk:"confirm_deny_all"`
+	InstanceClass     types.String           `tfsdk:"instance_class"`
+	StorageGB         types.Int64            `tfsdk:"storage_gb"`
+	Password          types.String           `tfsdk:"password"`
+	GeneratedPassword types.String           `tfsdk:"generated_password"`
+	RestoreFromBackup types.String           `tfsdk:"restore_from_backup_id"`
+	MaintenanceWindow maintenanceWindowValue `tfsdk:"maintenance_window"`
+	Timeouts          timeouts.Value         `tfsdk:"timeouts"`
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +89,9 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: databaseEngines, ignoreCase: true},
+				},
 			},
 			"version": schema.StringAttribute{
 				Required:            true,
@@ -81,23 +107,95 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"instance_class": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The instance class of the database (e.g., db.small). Changing it resizes the database in place.",
+			},
+			"storage_gb": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The allocated storage in GB. Defaults to the instance class's default. Storage can be grown in place but not shrunk.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					storageNotShrunk{},
+				},
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the database.",
 			},
+			"host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname clients connect to.",
+			},
 			"port": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The port the database is listening on.",
 			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `host:port` clients connect to. Unlike `connection_string`, it holds no credentials.",
+			},
 			"username": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The master username for the database.",
 			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The master password. Changing it resets the password in place. When unset, the API generates one at creation.",
+			},
+			"generated_password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The master password generated by the API when `password` was not set at creation. Cleared once `password` is set, as the generated one no longer works.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					generatedPasswordUntilSet{},
+				},
+			},
 			"connection_string": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The connection string for the database.",
 				Sensitive:           true,
 			},
+			"allowed_cidrs": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Client CIDR blocks allowed to connect to the database. When unset, the whole VPC is allowed. " +
+					"An empty set denies all access except platform management and requires `confirm_deny_all`. " +
+					"Removing the attribute leaves the current list in place.",
+				Validators: []validator.Set{
+					cidrSetValidator{},
+				},
+			},
+			"confirm_deny_all": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Must be `true` to set `allowed_cidrs` to an empty set, which can lock applications out of the database.",
+			},
+			"restore_from_backup_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of a `thecloud_database_backup` (database_id:backup_id) to create the database from. Only used at creation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"maintenance_window": schema.StringAttribute{
+				CustomType:          maintenanceWindowType{},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The weekly window in which the platform may upgrade the database, formatted as `ddd:hh24:mi-ddd:hh24:mi` in UTC (e.g., `sun:03:00-sun:04:00`). Changing it updates the database in place. Defaults to a window chosen by the platform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					maintenanceWindowValidator{},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -121,32 +219,113 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RestoreFromBackup.IsNull() && !data.RestoreFromBackup.IsUnknown() {
+		if _, _, ok := parseDatabaseBackupID(data.RestoreFromBackup.ValueString()); !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("restore_from_backup_id"),
+				"Invalid Backup ID",
+				fmt.Sprintf("Expected a backup ID with format database_id:backup_id, such as the id of a thecloud_database_backup. Got: %q", data.RestoreFromBackup.ValueString()),
+			)
+		}
+	}
+
+	if data.AllowedCIDRs.IsNull() || data.AllowedCIDRs.IsUnknown() || len(data.AllowedCIDRs.Elements()) > 0 {
+		return
+	}
+
+	if data.ConfirmDenyAll.IsUnknown() || data.ConfirmDenyAll.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("allowed_cidrs"),
+		"Empty Network ACL",
+		"An empty allowed_cidrs set denies all client access to the database, including from your applications. "+
+			"Set confirm_deny_all = true to apply it.",
+	)
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DatabaseResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	db, err := r.client.CreateDatabase(
-		ctx,
-		data.Name.ValueString(),
-		data.Engine.ValueString(),
-		data.Version.ValueString(),
-		data.VpcID.ValueString(),
-	)
+	allowedCIDRs, diags := expandAllowedCIDRs(ctx, data.AllowedCIDRs)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateDatabaseRequest{
+		Name:          data.Name.ValueString(),
+		Engine:        data.Engine.ValueString(),
+		Version:       data.Version.ValueString(),
+		VpcID:         data.VpcID.ValueString(),
+		InstanceClass: data.InstanceClass.ValueString(),
+		StorageGB:     int(data.StorageGB.ValueInt64()),
+		Password:      data.Password.ValueString(),
+		AllowedCIDRs:  allowedCIDRs,
+	}
+	if !data.MaintenanceWindow.IsUnknown() {
+		createReq.MaintenanceWindow = data.MaintenanceWindow.ValueString()
+	}
+
+	var db *client.Database
+	var err error
+
+	if data.RestoreFromBackup.IsNull() {
+		db, err = r.client.CreateDatabase(ctx, createReq)
+	} else {
+		databaseID, backupID, _ := parseDatabaseBackupID(data.RestoreFromBackup.ValueString())
+		db, err = r.client.RestoreDatabaseBackup(ctx, databaseID, backupID, createReq)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Database, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Database", err)
 		return
 	}
 
-	data.ID = types.StringValue(db.ID)
-	data.Status = types.StringValue(db.Status)
-	data.Port = types.Int64Value(int64(db.Port))
-	data.Username = types.StringValue(db.Username)
-	data.ConnectionString = types.StringValue(db.ConnectionString)
+	// The generated password is only in the create response
+	data.GeneratedPassword = types.StringNull()
+	if data.Password.IsNull() && db.Password != "" {
+		data.GeneratedPassword = types.StringValue(db.Password)
+	}
+
+	data.setCreated(db)
+
+	// Wait for the database to finish provisioning before handing it to dependents
+	current, err := r.waitForAvailable(ctx, db.ID, []string{"creating", "pending", "provisioning", "restoring", "starting"}, createTimeout)
+
+	// The database is saved even when it didn't become available, so it's tainted
+	// rather than left untracked
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Create Timeout", fmt.Sprintf("Timed out waiting for Database %s to become available. Last observed status: %q.", db.ID, timeoutErr.LastStatus))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Database %s did not become available", db.ID), err)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	data.setCreated(current)
 
 	tflog.Trace(ctx, "created a Database resource")
 
@@ -164,7 +343,7 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	db, err := r.client.GetDatabase(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Database, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Database", err)
 		return
 	}
 
@@ -175,19 +354,119 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	data.ID = types.StringValue(db.ID)
 	data.Name = types.StringValue(db.Name)
-	data.Engine = types.StringValue(db.Engine)
+	data.Engine = stringValueIgnoringCase(data.Engine, db.Engine)
 	data.Version = types.StringValue(db.Version)
 	data.VpcID = types.StringValue(db.VpcID)
 	data.Status = types.StringValue(db.Status)
+	data.Host = types.StringValue(db.ResolvedHost())
 	data.Port = types.Int64Value(int64(db.Port))
+	data.Address = types.StringValue(db.Address())
 	data.Username = types.StringValue(db.Username)
 	data.ConnectionString = types.StringValue(db.ConnectionString)
+	data.MaintenanceWindow = newMaintenanceWindowValue(db.MaintenanceWindow)
+	data.setSize(db)
+
+	// The ACL is only tracked once it's managed, so unmanaged databases don't show drift
+	if !data.AllowedCIDRs.IsNull() {
+		resp.Diagnostics.Append(r.flattenAllowedCIDRs(ctx, db, &data)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// API might not support updates, handled by RequiresReplace if needed
+	var data, state DatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.InstanceClass.Equal(state.InstanceClass) || !data.StorageGB.Equal(state.StorageGB) {
+		instanceClass := ""
+		if !data.InstanceClass.Equal(state.InstanceClass) {
+			instanceClass = data.InstanceClass.ValueString()
+		}
+		storageGB := 0
+		if !data.StorageGB.IsUnknown() && !data.StorageGB.Equal(state.StorageGB) {
+			storageGB = int(data.StorageGB.ValueInt64())
+		}
+
+		if err := r.client.ResizeDatabase(ctx, data.ID.ValueString(), instanceClass, storageGB); err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to resize Database", err)
+			return
+		}
+
+		_, err := r.waitForAvailable(ctx, data.ID.ValueString(), []string{"resizing", "modifying", "pending"}, updateTimeout)
+
+		var timeoutErr *client.WaitTimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError("Update Timeout", fmt.Sprintf("Timed out waiting for Database %s to finish resizing. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+			return
+		}
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Database %s did not become available after resizing", data.ID.ValueString()), err)
+			return
+		}
+	}
+
+	// Removing the password from the configuration keeps the current one
+	if !data.Password.IsNull() && !data.Password.Equal(state.Password) {
+		if err := r.client.ResetDatabasePassword(ctx, data.ID.ValueString(), data.Password.ValueString()); err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to reset Database password", err)
+			return
+		}
+	}
+
+	if !data.AllowedCIDRs.IsNull() && !data.AllowedCIDRs.Equal(state.AllowedCIDRs) {
+		allowedCIDRs, diags := expandAllowedCIDRs(ctx, data.AllowedCIDRs)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.client.SetDatabaseNetworkACL(ctx, data.ID.ValueString(), allowedCIDRs); err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Database network ACL", err)
+			return
+		}
+	}
+
+	if !data.MaintenanceWindow.IsUnknown() && !data.MaintenanceWindow.IsNull() && !data.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		if err := r.client.SetDatabaseMaintenanceWindow(ctx, data.ID.ValueString(), data.MaintenanceWindow.ValueString()); err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Database maintenance window", err)
+			return
+		}
+	}
+
+	db, err := r.client.GetDatabase(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Database", err)
+		return
+	}
+
+	if db == nil {
+		resp.Diagnostics.AddError("Database Not Found", fmt.Sprintf("Database %s was deleted while it was being updated.", data.ID.ValueString()))
+		return
+	}
+
+	data.Status = types.StringValue(db.Status)
+	data.Host = types.StringValue(db.ResolvedHost())
+	data.Port = types.Int64Value(int64(db.Port))
+	data.Address = types.StringValue(db.Address())
+	data.Username = types.StringValue(db.Username)
+	data.ConnectionString = types.StringValue(db.ConnectionString)
+	data.MaintenanceWindow = newMaintenanceWindowValue(db.MaintenanceWindow)
+	data.setSize(db)
+
+	tflog.Trace(ctx, "updated a Database resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -201,7 +480,7 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	err := r.client.DeleteDatabase(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Database, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Database", err)
 		return
 	}
 }
@@ -209,3 +488,151 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// waitForAvailable polls the database until it is available and returns it.
+func (r *DatabaseResource) waitForAvailable(ctx context.Context, id string, pending []string, timeout time.Duration) (*client.Database, error) {
+	var db *client.Database
+
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := r.client.GetDatabase(ctx, id)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		db = current
+		return current.Status, false, nil
+	}, []string{"available"}, pending, client.WaitOpts{Timeout: timeout})
+
+	return db, err
+}
+
+// setCreated stores the attributes of a new database that are only known once it's
+// created.
+func (m *DatabaseResourceModel) setCreated(db *client.Database) {
+	m.ID = types.StringValue(db.ID)
+	m.Status = types.StringValue(db.Status)
+	m.Host = types.StringValue(db.ResolvedHost())
+	m.Port = types.Int64Value(int64(db.Port))
+	m.Address = types.StringValue(db.Address())
+	m.Username = types.StringValue(db.Username)
+	m.ConnectionString = types.StringValue(db.ConnectionString)
+	m.MaintenanceWindow = newMaintenanceWindowValue(db.MaintenanceWindow)
+	m.setSize(db)
+}
+
+// setSize stores the database's instance class and storage in the model. Values the
+// API doesn't report are left as planned.
+func (m *DatabaseResourceModel) setSize(db *client.Database) {
+	if db.InstanceClass != "" {
+		m.InstanceClass = types.StringValue(db.InstanceClass)
+	}
+	if db.StorageGB != 0 || m.StorageGB.IsUnknown() {
+		m.StorageGB = types.Int64Value(int64(db.StorageGB))
+	}
+}
+
+var _ planmodifier.Int64 = storageNotShrunk{}
+
+// storageNotShrunk rejects plans that reduce storage_gb. The API can only grow storage,
+// so a smaller value would fail part-way through an apply.
+type storageNotShrunk struct{}
+
+func (m storageNotShrunk) Description(ctx context.Context) string {
+	return "Storage can't be reduced."
+}
+
+func (m storageNotShrunk) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m storageNotShrunk) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if planned, current := req.PlanValue.ValueInt64(), req.StateValue.ValueInt64(); planned < current {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Storage Cannot Be Reduced",
+			fmt.Sprintf("Database storage can only be grown. It is currently %d GB and can't be reduced to %d GB. "+
+				"Keep storage_gb at %d or more, or replace the database to start over with less storage.", current, planned, current),
+		)
+	}
+}
+
+// flattenAllowedCIDRs stores the database's ACL in the model. The API lists the VPC CIDR
+// as an implicit entry on databases in a VPC; it is dropped unless it was configured.
+func (r *DatabaseResource) flattenAllowedCIDRs(ctx context.Context, db *client.Database, data *DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var configured []string
+	diags.Append(data.AllowedCIDRs.ElementsAs(ctx, &configured, false)...)
+
+	vpcCIDR := ""
+	if db.VpcID != "" {
+		vpc, err := r.client.GetVPC(ctx, db.VpcID)
+		if err != nil {
+			apierror.AddClientError(&diags, "Unable to read Database VPC", err)
+			return diags
+		}
+		if vpc != nil {
+			vpcCIDR = vpc.CIDRBlock
+		}
+	}
+
+	allowedCIDRs, d := types.SetValueFrom(ctx, types.StringType, withoutImplicitCIDR(db.AllowedCIDRs, vpcCIDR, configured))
+	diags.Append(d...)
+	data.AllowedCIDRs = allowedCIDRs
+
+	return diags
+}
+
+// expandAllowedCIDRs converts the allowed_cidrs set for the API. A null set returns nil
+// so the API default is kept; an empty set returns an empty, non-nil slice.
+func expandAllowedCIDRs(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	allowedCIDRs := []string{}
+	diags := set.ElementsAs(ctx, &allowedCIDRs, false)
+	return allowedCIDRs, diags
+}
+
+// withoutImplicitCIDR removes implicit from cidrs unless it is one of the configured entries.
+func withoutImplicitCIDR(cidrs []string, implicit string, configured []string) []string {
+	out := []string{}
+	for _, cidr := range cidrs {
+		if cidr == implicit && !slices.Contains(configured, cidr) {
+			continue
+		}
+		out = append(out, cidr)
+	}
+	return out
+}
+
+var _ planmodifier.String = generatedPasswordUntilSet{}
+
+// generatedPasswordUntilSet plans generated_password as null once password is configured,
+// so the generated credential, which the new password replaces, isn't kept in state.
+type generatedPasswordUntilSet struct{}
+
+func (m generatedPasswordUntilSet) Description(ctx context.Context) string {
+	return "The generated password is cleared once a password is configured."
+}
+
+func (m generatedPasswordUntilSet) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m generatedPasswordUntilSet) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !password.IsNull() {
+		resp.PlanValue = types.StringNull()
+	}
+}
@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithoutImplicitCIDR(t *testing.T) {
+	api := []string{"10.0.0.0/16", "10.0.1.0/24"}
+
+	// The implicit VPC entry is hidden when it wasn't configured
+	assert.Equal(t, []string{"10.0.1.0/24"}, withoutImplicitCIDR(api, "10.0.0.0/16", []string{"10.0.1.0/24"}))
+
+	// ...and kept when it was
+	assert.Equal(t, api, withoutImplicitCIDR(api, "10.0.0.0/16", api))
+
+	// Databases outside a VPC have no implicit entry
+	assert.Equal(t, api, withoutImplicitCIDR(api, "", nil))
+
+	// Deny all comes back as an empty list rather than nil
+	assert.Equal(t, []string{}, withoutImplicitCIDR([]string{"10.0.0.0/16"}, "10.0.0.0/16", nil))
+}
+
+func TestExpandAllowedCIDRs(t *testing.T) {
+	ctx := context.Background()
+
+	cidrs, diags := expandAllowedCIDRs(ctx, types.SetNull(types.StringType))
+	assert.False(t, diags.HasError())
+	assert.Nil(t, cidrs)
+
+	cidrs, diags = expandAllowedCIDRs(ctx, types.SetValueMust(types.StringType, nil))
+	assert.False(t, diags.HasError())
+	assert.NotNil(t, cidrs)
+	assert.Empty(t, cidrs)
+}
+
+func TestCIDRSetValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(values ...string) bool {
+		set, diags := types.SetValueFrom(ctx, types.StringType, values)
+		assert.False(t, diags.HasError())
+
+		resp := &validator.SetResponse{}
+		cidrSetValidator{}.ValidateSet(ctx, validator.SetRequest{Path: path.Root("allowed_cidrs"), ConfigValue: set}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate("10.0.1.0/24", "10.0.2.0/24"))
+	assert.True(t, validate("10.0.1.0/24", "10.0.2.1"))
+	assert.True(t, validate("not-a-cidr"))
+}
+
+func TestDatabaseValidateConfigDenyAll(t *testing.T) {
+	ctx := context.Background()
+	r := NewDatabaseResource().(*DatabaseResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(config map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	empty := tftypes.NewValue(stringSet, []tftypes.Value{})
+
+	assert.False(t, validate(nil))
+	assert.False(t, validate(map[string]tftypes.Value{
+		"allowed_cidrs": tftypes.NewValue(stringSet, []tftypes.Value{tftypes.NewValue(tftypes.String, "10.0.1.0/24")}),
+	}))
+	assert.True(t, validate(map[string]tftypes.Value{"allowed_cidrs": empty}))
+	assert.True(t, validate(map[string]tftypes.Value{
+		"allowed_cidrs":    empty,
+		"confirm_deny_all": tftypes.NewValue(tftypes.Bool, false),
+	}))
+	assert.False(t, validate(map[string]tftypes.Value{
+		"allowed_cidrs":    empty,
+		"confirm_deny_all": tftypes.NewValue(tftypes.Bool, true),
+	}))
+}
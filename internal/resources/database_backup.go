@@ -0,0 +1,284 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const defaultDatabaseBackupTimeout = 30 * time.Minute
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &DatabaseBackupResource{}
+var _ resource.ResourceWithImportState = &DatabaseBackupResource{}
+
+func NewDatabaseBackupResource() resource.Resource {
+	return &DatabaseBackupResource{}
+}
+
+// DatabaseBackupResource defines the resource implementation.
+type DatabaseBackupResource struct {
+	client *client.Client
+}
+
+// DatabaseBackupResourceModel describes the resource data model.
+type DatabaseBackupResourceModel struct {
+	ID          types.String   `tfsdk:"id"` // Format: {database_id}:{backup_id}
+	BackupID    types.String   `tfsdk:"backup_id"`
+	DatabaseID  types.String   `tfsdk:"database_id"`
+	Description types.String   `tfsdk:"description"`
+	Status      types.String   `tfsdk:"status"`
+	SizeGB      types.Int64    `tfsdk:"size_gb"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabaseBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_backup"
+}
+
+func (r *DatabaseBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Database Backup resource allows you to manage point-in-time backups of databases.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composite ID of the backup (database_id:backup_id). Use it as a database's `restore_from_backup_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the backup within the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the database to back up.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the backup.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the backup.",
+			},
+			"size_gb": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size of the backup in GB.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the backup was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabaseBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabaseBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDatabaseBackupTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.CreateDatabaseBackup(ctx, data.DatabaseID.ValueString(), data.Description.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create database backup", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.DatabaseID.ValueString(), backup.ID))
+	data.setBackup(backup)
+
+	// Wait for the backup to finish so databases restored from it don't start from a
+	// backup that's still being taken
+	backup, err = waitForDatabaseBackupAvailable(ctx, r.client, data.DatabaseID.ValueString(), backup.ID, createTimeout)
+	if backup != nil {
+		data.setBackup(backup)
+	}
+
+	// The backup is saved even when it didn't become available, so it's tainted rather
+	// than left untracked
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Create Timeout", fmt.Sprintf("Timed out waiting for database backup %s to become available. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Database backup %s did not become available", data.ID.ValueString()), err)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a Database Backup resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.GetDatabaseBackup(ctx, data.DatabaseID.ValueString(), data.BackupID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read database backup", err)
+		return
+	}
+
+	if backup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setBackup(backup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabaseBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other change replaces the backup, so only new timeouts are recorded
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatabaseBackup(ctx, data.DatabaseID.ValueString(), data.BackupID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete database backup", err)
+		return
+	}
+}
+
+func (r *DatabaseBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import requires database_id:backup_id
+	databaseID, backupID, ok := parseDatabaseBackupID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database_id:backup_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), databaseID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_id"), backupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (m *DatabaseBackupResourceModel) setBackup(backup *client.DatabaseBackup) {
+	m.BackupID = types.StringValue(backup.ID)
+	if !m.Description.IsNull() || backup.Description != "" {
+		m.Description = types.StringValue(backup.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+	m.Status = types.StringValue(backup.Status)
+	m.SizeGB = types.Int64Value(int64(backup.SizeGB))
+	m.CreatedAt = types.StringValue(backup.CreatedAt)
+}
+
+// waitForDatabaseBackupAvailable polls a backup until it is available. The backup as last
+// observed is returned, also with an error, and is nil if it was never observed.
+func waitForDatabaseBackupAvailable(ctx context.Context, c *client.Client, databaseID, backupID string, timeout time.Duration) (*client.DatabaseBackup, error) {
+	var backup *client.DatabaseBackup
+
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetDatabaseBackup(ctx, databaseID, backupID)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		backup = current
+		return current.Status, false, nil
+	}, []string{"available"}, []string{"creating", "pending", "in-progress"}, client.WaitOpts{Timeout: timeout})
+
+	return backup, err
+}
+
+// parseDatabaseBackupID splits a database_id:backup_id backup ID into its parts.
+func parseDatabaseBackupID(id string) (databaseID, backupID string, ok bool) {
+	databaseID, backupID, ok = strings.Cut(id, ":")
+	if !ok || databaseID == "" || backupID == "" || strings.Contains(backupID, ":") {
+		return "", "", false
+	}
+	return databaseID, backupID, true
+}
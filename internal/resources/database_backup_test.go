@@ -0,0 +1,69 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const databaseBackupResourceName = "thecloud_database_backup.test"
+
+func TestAccDatabaseBackupResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dbName := fmt.Sprintf("test-db-bk-%s", rName)
+
+	config := providerConfig() + fmt.Sprintf(`
+resource "thecloud_database" "source" {
+  name           = "%[1]s"
+  engine         = "postgres"
+  version        = "14"
+  instance_class = "db.small"
+}
+
+resource "thecloud_database_backup" "test" {
+  database_id = thecloud_database.source.id
+  description = "nightly"
+}
+`, dbName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(databaseBackupResourceName, "database_id", "thecloud_database.source", "id"),
+					resource.TestCheckResourceAttr(databaseBackupResourceName, "description", "nightly"),
+					resource.TestCheckResourceAttrSet(databaseBackupResourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(databaseBackupResourceName, "status"),
+					resource.TestCheckResourceAttrSet(databaseBackupResourceName, "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      databaseBackupResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Restore into a new database
+			{
+				Config: config + fmt.Sprintf(`
+resource "thecloud_database" "restored" {
+  name                   = "%s-restored"
+  engine                 = "postgres"
+  version                = "14"
+  instance_class         = "db.small"
+  restore_from_backup_id = thecloud_database_backup.test.id
+}
+`, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("thecloud_database.restored", "status", "available"),
+					resource.TestCheckResourceAttrPair("thecloud_database.restored", "restore_from_backup_id", databaseBackupResourceName, "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageNotShrunk(t *testing.T) {
+	ctx := context.Background()
+
+	rejected := func(state, plan types.Int64) bool {
+		resp := &planmodifier.Int64Response{PlanValue: plan}
+		storageNotShrunk{}.PlanModifyInt64(ctx, planmodifier.Int64Request{Path: path.Root("storage_gb"), StateValue: state, PlanValue: plan}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, rejected(types.Int64Value(20), types.Int64Value(50)))
+	assert.False(t, rejected(types.Int64Value(20), types.Int64Value(20)))
+	assert.True(t, rejected(types.Int64Value(50), types.Int64Value(20)))
+
+	// Creates and values still being computed can't be compared
+	assert.False(t, rejected(types.Int64Null(), types.Int64Value(20)))
+	assert.False(t, rejected(types.Int64Value(50), types.Int64Unknown()))
+}
+
+func TestDatabaseSetSize(t *testing.T) {
+	data := DatabaseResourceModel{InstanceClass: types.StringValue("db.small"), StorageGB: types.Int64Unknown()}
+
+	data.setSize(&client.Database{InstanceClass: "db.small", StorageGB: 20})
+	assert.Equal(t, types.Int64Value(20), data.StorageGB)
+
+	// Values missing from the API response are left as they were
+	data.setSize(&client.Database{})
+	assert.Equal(t, types.StringValue("db.small"), data.InstanceClass)
+	assert.Equal(t, types.Int64Value(20), data.StorageGB)
+}
+
+func TestGeneratedPasswordUntilSet(t *testing.T) {
+	ctx := context.Background()
+	r := NewDatabaseResource().(*DatabaseResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	modify := func(password interface{}) types.String {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["password"] = tftypes.NewValue(tftypes.String, password)
+
+		req := planmodifier.StringRequest{
+			Path:       path.Root("generated_password"),
+			Config:     tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			StateValue: types.StringValue("generated"),
+			PlanValue:  types.StringValue("generated"),
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		generatedPasswordUntilSet{}.PlanModifyString(ctx, req, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		return resp.PlanValue
+	}
+
+	assert.Equal(t, types.StringValue("generated"), modify(nil))
+	assert.True(t, modify("chosen").IsNull())
+	assert.True(t, modify(tftypes.UnknownValue).IsNull())
+}
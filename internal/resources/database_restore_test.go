@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDatabaseBackupID(t *testing.T) {
+	databaseID, backupID, ok := parseDatabaseBackupID("db-123:bk-456")
+	assert.True(t, ok)
+	assert.Equal(t, "db-123", databaseID)
+	assert.Equal(t, "bk-456", backupID)
+
+	for _, id := range []string{"bk-456", "db-123:", ":bk-456", "db-123:bk-456:extra", ""} {
+		_, _, ok := parseDatabaseBackupID(id)
+		assert.False(t, ok, id)
+	}
+}
+
+func TestDatabaseValidateConfigRestoreFromBackup(t *testing.T) {
+	ctx := context.Background()
+	r := NewDatabaseResource().(*DatabaseResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(backupID tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["restore_from_backup_id"] = backupID
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(tftypes.NewValue(tftypes.String, nil)))
+	assert.False(t, validate(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)))
+	assert.False(t, validate(tftypes.NewValue(tftypes.String, "db-123:bk-456")))
+	assert.True(t, validate(tftypes.NewValue(tftypes.String, "bk-456")))
+}
+
+func TestWaitForDatabaseBackupAvailable(t *testing.T) {
+	statuses := map[string]string{"bk-ok": "available", "bk-failed": "failed"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/databases/db-1/backups/"):]
+		raw, err := json.Marshal(client.DatabaseBackup{ID: id, DatabaseID: "db-1", Status: statuses[id]})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	backup, err := waitForDatabaseBackupAvailable(ctx, c, "db-1", "bk-ok", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "available", backup.Status)
+
+	// The failed backup is returned so it can be saved to the state
+	backup, err = waitForDatabaseBackupAvailable(ctx, c, "db-1", "bk-failed", time.Minute)
+	var unexpected *client.UnexpectedStateError
+	assert.True(t, errors.As(err, &unexpected))
+	assert.Equal(t, "bk-failed", backup.ID)
+}
@@ -2,10 +2,12 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const databaseResourceName = "thecloud_database.test"
@@ -21,25 +23,190 @@ func TestAccDatabaseResource(t *testing.T) {
 			{
 				Config: providerConfig() + fmt.Sprintf(`
 resource "thecloud_database" "test" {
-  name    = "%s"
-  engine  = "postgres"
-  version = "14"
+  name           = "%s"
+  engine         = "postgres"
+  version        = "14"
+  instance_class = "db.small"
 }
 `, dbName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(databaseResourceName, "name", dbName),
 					resource.TestCheckResourceAttr(databaseResourceName, "engine", "postgres"),
 					resource.TestCheckResourceAttr(databaseResourceName, "version", "14"),
+					resource.TestCheckResourceAttr(databaseResourceName, "instance_class", "db.small"),
+					resource.TestCheckResourceAttrSet(databaseResourceName, "storage_gb"),
+					resource.TestCheckResourceAttrSet(databaseResourceName, "generated_password"),
+					resource.TestCheckResourceAttrSet(databaseResourceName, "host"),
+					resource.TestCheckResourceAttrSet(databaseResourceName, "address"),
 					resource.TestCheckResourceAttrSet(databaseResourceName, "id"),
 					resource.TestCheckResourceAttrSet(databaseResourceName, "status"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      databaseResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            databaseResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generated_password"},
 			},
 		},
 	})
 }
+
+func TestAccDatabaseResourceResize(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dbName := fmt.Sprintf("test-db-resize-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSizeConfig(dbName, "db.small", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(databaseResourceName, "instance_class", "db.small"),
+					resource.TestCheckResourceAttr(databaseResourceName, "storage_gb", "20"),
+				),
+			},
+			// Scaling up happens in place
+			{
+				Config: testAccDatabaseSizeConfig(dbName, "db.medium", 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(databaseResourceName, "instance_class", "db.medium"),
+					resource.TestCheckResourceAttr(databaseResourceName, "storage_gb", "50"),
+					resource.TestCheckResourceAttr(databaseResourceName, "status", "available"),
+				),
+			},
+			// Storage can't shrink
+			{
+				Config:      testAccDatabaseSizeConfig(dbName, "db.medium", 30),
+				ExpectError: regexp.MustCompile("Storage Cannot Be Reduced"),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSizeConfig(dbName, instanceClass string, storageGB int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_database" "test" {
+  name           = "%s"
+  engine         = "postgres"
+  version        = "14"
+  instance_class = "%s"
+  storage_gb     = %d
+}
+`, dbName, instanceClass, storageGB)
+}
+
+func TestAccDatabaseResourcePassword(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dbName := fmt.Sprintf("test-db-pw-%s", rName)
+
+	var connectionString string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabasePasswordConfig(dbName, "first-Passw0rd"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(databaseResourceName, "generated_password"),
+					resource.TestCheckResourceAttrWith(databaseResourceName, "connection_string", func(value string) error {
+						connectionString = value
+						return nil
+					}),
+				),
+			},
+			// Rotating the password happens in place and refreshes the connection string
+			{
+				Config: testAccDatabasePasswordConfig(dbName, "second-Passw0rd"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith(databaseResourceName, "connection_string", func(value string) error {
+					if value == connectionString {
+						return fmt.Errorf("connection_string was not refreshed after the password was reset")
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccDatabasePasswordConfig(dbName, password string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_database" "test" {
+  name           = "%s"
+  engine         = "postgres"
+  version        = "14"
+  instance_class = "db.small"
+  password       = "%s"
+}
+`, dbName, password)
+}
+
+func TestAccDatabaseResourceAllowedCIDRs(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dbName := fmt.Sprintf("test-db-acl-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseACLConfig(dbName, `allowed_cidrs = ["10.0.1.0/24", "10.0.2.0/24"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(databaseResourceName, "allowed_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(databaseResourceName, "allowed_cidrs.*", "10.0.1.0/24"),
+				),
+			},
+			// Reordering the set is not a change
+			{
+				Config:   testAccDatabaseACLConfig(dbName, `allowed_cidrs = ["10.0.2.0/24", "10.0.1.0/24"]`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccDatabaseACLConfig(dbName, `allowed_cidrs = ["10.0.1.0/24"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(databaseResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(databaseResourceName, "allowed_cidrs.#", "1"),
+			},
+			// Denying all access needs confirmation
+			{
+				Config:      testAccDatabaseACLConfig(dbName, `allowed_cidrs = []`),
+				ExpectError: regexp.MustCompile("Empty Network ACL"),
+			},
+			{
+				Config: testAccDatabaseACLConfig(dbName, "allowed_cidrs    = []\n  confirm_deny_all = true"),
+				Check:  resource.TestCheckResourceAttr(databaseResourceName, "allowed_cidrs.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccDatabaseACLConfig(dbName, acl string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "db_vpc" {
+  name       = "%[1]s-vpc"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_database" "test" {
+  name           = "%[1]s"
+  engine         = "postgres"
+  version        = "14"
+  instance_class = "db.small"
+  vpc_id         = thecloud_vpc.db_vpc.id
+  %[2]s
+}
+`, dbName, acl)
+}
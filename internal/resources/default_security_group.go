@@ -0,0 +1,467 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &DefaultSecurityGroupResource{}
+var _ resource.ResourceWithImportState = &DefaultSecurityGroupResource{}
+
+// defaultSecurityGroupRestoreKey is the private state key holding the rules the group
+// had when it was adopted, so they can be put back on destroy.
+const defaultSecurityGroupRestoreKey = "restore_rules"
+
+// platformDefaultSecurityRules are the rules the platform puts in a new VPC's default
+// group. They are restored on destroy when the adopted rules were never recorded.
+var platformDefaultSecurityRules = []client.SecurityRule{
+	{Direction: "egress", Protocol: "all", CIDR: "0.0.0.0/0"},
+}
+
+func NewDefaultSecurityGroupResource() resource.Resource {
+	return &DefaultSecurityGroupResource{}
+}
+
+// DefaultSecurityGroupResource defines the resource implementation.
+type DefaultSecurityGroupResource struct {
+	client *client.Client
+}
+
+// DefaultSecurityGroupResourceModel describes the resource data model.
+type DefaultSecurityGroupResourceModel struct {
+	ID          types.String                    `tfsdk:"id"`
+	VpcID       types.String                    `tfsdk:"vpc_id"`
+	Name        types.String                    `tfsdk:"name"`
+	Description types.String                    `tfsdk:"description"`
+	Rules       []DefaultSecurityGroupRuleModel `tfsdk:"rule"`
+}
+
+type DefaultSecurityGroupRuleModel struct {
+	Direction types.String `tfsdk:"direction"`
+	Protocol  types.String `tfsdk:"protocol"`
+	PortMin   types.Int64  `tfsdk:"port_min"`
+	PortMax   types.Int64  `tfsdk:"port_max"`
+	CIDR      types.String `tfsdk:"cidr"`
+	Priority  types.Int64  `tfsdk:"priority"`
+}
+
+func (r *DefaultSecurityGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_security_group"
+}
+
+func (r *DefaultSecurityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Default Security Group resource adopts the security group created with a VPC and manages its rules. " +
+			"Rules not declared in the configuration are removed; omitting `rule` removes them all. " +
+			"Destroying this resource restores the group's original rules instead of deleting it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the default security group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the VPC whose default security group is adopted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the default security group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the default security group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule": schema.SetNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The complete set of rules for the group.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The direction of traffic (ingress or egress).",
+						},
+						"protocol": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The protocol (tcp, udp, icmp, all).",
+						},
+						"port_min": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The minimum port number.",
+						},
+						"port_max": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The maximum port number.",
+						},
+						"cidr": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The CIDR block for the rule.",
+						},
+						"priority": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "The evaluation priority of the rule. When omitted the API assigns one.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DefaultSecurityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DefaultSecurityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DefaultSecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sg, err := r.client.GetDefaultSecurityGroup(ctx, data.VpcID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read default security group", err)
+		return
+	}
+
+	if sg == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vpc_id"),
+			"Default Security Group Not Found",
+			fmt.Sprintf("VPC %s has no default security group.", data.VpcID.ValueString()),
+		)
+		return
+	}
+
+	// Remember the rules the group came with before touching them.
+	restore, err := json.Marshal(stripSecurityRuleIDs(sg.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to record default security group rules, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, defaultSecurityGroupRestoreKey, restore)...)
+
+	sg, err = r.reconcileRules(ctx, sg, expandDefaultSecurityGroupRules(data.Rules))
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update default security group rules", err)
+		return
+	}
+
+	data.setSecurityGroup(sg)
+
+	tflog.Trace(ctx, "adopted a Default Security Group resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultSecurityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DefaultSecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sg, err := r.client.GetSecurityGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read default security group", err)
+		return
+	}
+
+	if sg == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setSecurityGroup(sg)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultSecurityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DefaultSecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sg, err := r.client.GetSecurityGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read default security group", err)
+		return
+	}
+
+	if sg == nil {
+		resp.Diagnostics.AddError("Default Security Group Not Found", fmt.Sprintf("Security group %s no longer exists.", data.ID.ValueString()))
+		return
+	}
+
+	sg, err = r.reconcileRules(ctx, sg, expandDefaultSecurityGroupRules(data.Rules))
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update default security group rules", err)
+		return
+	}
+
+	data.setSecurityGroup(sg)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete hands the group back to the platform with its original rules. The group itself
+// belongs to the VPC and is never deleted.
+func (r *DefaultSecurityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DefaultSecurityGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore := platformDefaultSecurityRules
+
+	raw, diags := req.Private.GetKey(ctx, defaultSecurityGroupRestoreKey)
+	resp.Diagnostics.Append(diags...)
+	if len(raw) > 0 {
+		var recorded []client.SecurityRule
+		if err := json.Unmarshal(raw, &recorded); err == nil {
+			restore = recorded
+		} else {
+			tflog.Warn(ctx, "unable to decode recorded default security group rules, restoring platform defaults", map[string]interface{}{"error": err.Error()})
+		}
+	}
+
+	sg, err := r.client.GetSecurityGroup(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read default security group", err)
+		return
+	}
+
+	// The VPC and its default group are already gone.
+	if sg == nil {
+		return
+	}
+
+	// The VPC, and the default group with it, may be destroyed in the same apply
+	if _, err := r.reconcileRules(ctx, sg, restore); err != nil && !errors.Is(err, client.ErrNotFound) {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to restore default security group rules", err)
+		return
+	}
+}
+
+func (r *DefaultSecurityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// An imported group is managed exactly like an adopted one, so an empty rule set is
+	// reported as such rather than as unmanaged.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), []DefaultSecurityGroupRuleModel{})...)
+}
+
+// reconcileRules makes the group's rules exactly match desired and returns the refreshed
+// group. Missing rules are added before stale ones are removed so that traffic allowed by
+// both the old and new rule sets is never interrupted.
+func (r *DefaultSecurityGroupResource) reconcileRules(ctx context.Context, sg *client.SecurityGroup, desired []client.SecurityRule) (*client.SecurityGroup, error) {
+	remove, add := diffSecurityRules(sg.Rules, desired)
+	if len(remove) == 0 && len(add) == 0 {
+		return sg, nil
+	}
+
+	for _, rule := range add {
+		rule.GroupID = sg.ID
+		if _, err := r.client.AddSecurityRule(ctx, sg.ID, rule); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rule := range remove {
+		if err := r.client.RemoveSecurityRule(ctx, rule.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	refreshed, err := r.client.GetSecurityGroup(ctx, sg.ID)
+	if err != nil {
+		return nil, err
+	}
+	if refreshed == nil {
+		return nil, fmt.Errorf("security group %s disappeared while updating its rules", sg.ID)
+	}
+
+	return refreshed, nil
+}
+
+// securityRuleMatches reports whether an existing rule satisfies a desired one. A desired
+// priority of zero accepts whatever priority the API assigned.
+func securityRuleMatches(existing, desired client.SecurityRule) bool {
+	return strings.EqualFold(existing.Direction, desired.Direction) &&
+		strings.EqualFold(existing.Protocol, desired.Protocol) &&
+		existing.PortMin == desired.PortMin &&
+		existing.PortMax == desired.PortMax &&
+		existing.CIDR == desired.CIDR &&
+		(desired.Priority == 0 || existing.Priority == desired.Priority)
+}
+
+// diffSecurityRules returns the existing rules to remove and the desired rules to add so
+// that a group ends up with exactly the desired rules.
+func diffSecurityRules(existing, desired []client.SecurityRule) (remove, add []client.SecurityRule) {
+	matched := make([]bool, len(existing))
+
+	for _, want := range desired {
+		found := false
+		for i, have := range existing {
+			if !matched[i] && securityRuleMatches(have, want) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			add = append(add, want)
+		}
+	}
+
+	for i, have := range existing {
+		if !matched[i] {
+			remove = append(remove, have)
+		}
+	}
+
+	return remove, add
+}
+
+func stripSecurityRuleIDs(rules []client.SecurityRule) []client.SecurityRule {
+	stripped := make([]client.SecurityRule, 0, len(rules))
+	for _, rule := range rules {
+		rule.ID = ""
+		rule.GroupID = ""
+		stripped = append(stripped, rule)
+	}
+	return stripped
+}
+
+func (m DefaultSecurityGroupRuleModel) expand() client.SecurityRule {
+	return client.SecurityRule{
+		Direction: m.Direction.ValueString(),
+		Protocol:  m.Protocol.ValueString(),
+		PortMin:   int(m.PortMin.ValueInt64()),
+		PortMax:   int(m.PortMax.ValueInt64()),
+		CIDR:      m.CIDR.ValueString(),
+		Priority:  int(m.Priority.ValueInt64()),
+	}
+}
+
+func expandDefaultSecurityGroupRules(rules []DefaultSecurityGroupRuleModel) []client.SecurityRule {
+	expanded := make([]client.SecurityRule, 0, len(rules))
+	for _, rule := range rules {
+		expanded = append(expanded, rule.expand())
+	}
+	return expanded
+}
+
+// setSecurityGroup copies the group into the model. Rules are matched against the prior
+// rules so that attributes the practitioner omitted stay null.
+func (m *DefaultSecurityGroupResourceModel) setSecurityGroup(sg *client.SecurityGroup) {
+	m.ID = types.StringValue(sg.ID)
+	m.VpcID = types.StringValue(sg.VPCID)
+	m.Name = types.StringValue(sg.Name)
+	m.Description = types.StringValue(sg.Description)
+
+	if m.Rules == nil && len(sg.Rules) == 0 {
+		return
+	}
+
+	prior := m.Rules
+	used := make([]bool, len(prior))
+	rules := make([]DefaultSecurityGroupRuleModel, 0, len(sg.Rules))
+
+	for _, rule := range sg.Rules {
+		var match *DefaultSecurityGroupRuleModel
+		for i := range prior {
+			if !used[i] && securityRuleMatches(rule, prior[i].expand()) {
+				used[i] = true
+				match = &prior[i]
+				break
+			}
+		}
+
+		flat := DefaultSecurityGroupRuleModel{
+			Direction: types.StringValue(rule.Direction),
+			Protocol:  types.StringValue(rule.Protocol),
+			PortMin:   types.Int64Value(int64(rule.PortMin)),
+			PortMax:   types.Int64Value(int64(rule.PortMax)),
+			CIDR:      types.StringValue(rule.CIDR),
+			Priority:  types.Int64Value(int64(rule.Priority)),
+		}
+		if match != nil {
+			// Keep the practitioner's spelling of case-insensitive values.
+			flat.Direction = match.Direction
+			flat.Protocol = match.Protocol
+			if match.PortMin.IsNull() && rule.PortMin == 0 {
+				flat.PortMin = types.Int64Null()
+			}
+			if match.PortMax.IsNull() && rule.PortMax == 0 {
+				flat.PortMax = types.Int64Null()
+			}
+			if match.Priority.IsNull() {
+				flat.Priority = types.Int64Null()
+			}
+		} else {
+			if rule.PortMin == 0 {
+				flat.PortMin = types.Int64Null()
+			}
+			if rule.PortMax == 0 {
+				flat.PortMax = types.Int64Null()
+			}
+		}
+		rules = append(rules, flat)
+	}
+
+	m.Rules = rules
+}
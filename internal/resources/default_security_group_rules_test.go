@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testEgressAll = client.SecurityRule{Direction: "egress", Protocol: "all", CIDR: "0.0.0.0/0"}
+	testHTTPS     = client.SecurityRule{Direction: "ingress", Protocol: "tcp", PortMin: 443, PortMax: 443, CIDR: "0.0.0.0/0", Priority: 100}
+)
+
+// fakeSecurityGroupAPI keeps a single security group's rules in memory.
+type fakeSecurityGroupAPI struct {
+	t      *testing.T
+	mu     sync.Mutex
+	group  client.SecurityGroup
+	nextID int
+	calls  []string
+}
+
+func (f *fakeSecurityGroupAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+
+	var data interface{}
+	switch {
+	case r.Method == http.MethodGet:
+		data = f.group
+	case r.Method == http.MethodPost:
+		var rule client.SecurityRule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.nextID++
+		rule.ID = fmt.Sprintf("rule-%d", f.nextID)
+		if rule.Priority == 0 {
+			rule.Priority = 1000
+		}
+		f.group.Rules = append(f.group.Rules, rule)
+		data = rule
+	case r.Method == http.MethodDelete:
+		id := strings.TrimPrefix(r.URL.Path, "/security-groups/rules/")
+		for i, rule := range f.group.Rules {
+			if rule.ID == id {
+				f.group.Rules = append(f.group.Rules[:i], f.group.Rules[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	raw, err := json.Marshal(data)
+	assert.NoError(f.t, err)
+	assert.NoError(f.t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+}
+
+func TestDiffSecurityRules(t *testing.T) {
+	existing := []client.SecurityRule{
+		{ID: "rule-1", Direction: "EGRESS", Protocol: "all", CIDR: "0.0.0.0/0", Priority: 1000},
+		{ID: "rule-2", Direction: "ingress", Protocol: "tcp", PortMin: 22, PortMax: 22, CIDR: "0.0.0.0/0", Priority: 100},
+	}
+
+	remove, add := diffSecurityRules(existing, []client.SecurityRule{testEgressAll, testHTTPS})
+	assert.Equal(t, []client.SecurityRule{existing[1]}, remove)
+	assert.Equal(t, []client.SecurityRule{testHTTPS}, add)
+
+	// Priorities are compared when the desired rule sets one.
+	pinned := testEgressAll
+	pinned.Priority = 10
+	remove, add = diffSecurityRules(existing[:1], []client.SecurityRule{pinned})
+	assert.Len(t, remove, 1)
+	assert.Len(t, add, 1)
+
+	// An empty desired set removes everything.
+	remove, add = diffSecurityRules(existing, nil)
+	assert.Equal(t, existing, remove)
+	assert.Empty(t, add)
+}
+
+func TestDefaultSecurityGroupReconcileAndRestore(t *testing.T) {
+	api := &fakeSecurityGroupAPI{t: t, group: client.SecurityGroup{
+		ID:    "sg-default",
+		VPCID: "vpc-123",
+		Name:  "default",
+		Rules: []client.SecurityRule{{ID: "rule-0", Direction: "egress", Protocol: "all", CIDR: "0.0.0.0/0", Priority: 1000}},
+	}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	r := &DefaultSecurityGroupResource{client: client.NewClient(server.URL, "test-key")}
+	ctx := context.Background()
+
+	original := stripSecurityRuleIDs(api.group.Rules)
+
+	// Adopt with an ingress-only policy: the allow-all egress rule is dropped.
+	sg, err := r.reconcileRules(ctx, &api.group, []client.SecurityRule{testHTTPS})
+	assert.NoError(t, err)
+	assert.Len(t, sg.Rules, 1)
+	assert.Equal(t, 443, sg.Rules[0].PortMin)
+	assert.Equal(t, []string{"POST /security-groups/sg-default/rules", "DELETE /security-groups/rules/rule-0", "GET /security-groups/sg-default"}, api.calls)
+
+	// Reconciling to the same rules makes no calls.
+	api.calls = nil
+	_, err = r.reconcileRules(ctx, sg, []client.SecurityRule{testHTTPS})
+	assert.NoError(t, err)
+	assert.Empty(t, api.calls)
+
+	// Destroy puts the original rules back.
+	sg, err = r.reconcileRules(ctx, sg, original)
+	assert.NoError(t, err)
+	assert.Len(t, sg.Rules, 1)
+	assert.Equal(t, "egress", sg.Rules[0].Direction)
+	assert.Equal(t, 1000, sg.Rules[0].Priority)
+}
+
+func TestDefaultSecurityGroupSetSecurityGroup(t *testing.T) {
+	sg := &client.SecurityGroup{
+		ID:    "sg-default",
+		VPCID: "vpc-123",
+		Name:  "default",
+		Rules: []client.SecurityRule{
+			{ID: "rule-1", Direction: "ingress", Protocol: "TCP", PortMin: 443, PortMax: 443, CIDR: "0.0.0.0/0", Priority: 1000},
+		},
+	}
+
+	// Omitted attributes stay null and the configured spelling is kept.
+	data := DefaultSecurityGroupResourceModel{Rules: []DefaultSecurityGroupRuleModel{{
+		Direction: types.StringValue("ingress"),
+		Protocol:  types.StringValue("tcp"),
+		PortMin:   types.Int64Value(443),
+		PortMax:   types.Int64Value(443),
+		CIDR:      types.StringValue("0.0.0.0/0"),
+		Priority:  types.Int64Null(),
+	}}}
+	data.setSecurityGroup(sg)
+	assert.Len(t, data.Rules, 1)
+	assert.Equal(t, types.StringValue("tcp"), data.Rules[0].Protocol)
+	assert.True(t, data.Rules[0].Priority.IsNull())
+
+	// Rules added outside Terraform show up as drift.
+	data = DefaultSecurityGroupResourceModel{}
+	data.setSecurityGroup(sg)
+	assert.Len(t, data.Rules, 1)
+	assert.Equal(t, types.Int64Value(1000), data.Rules[0].Priority)
+
+	// No rules configured and none present stays null; an explicit empty set stays empty.
+	sg.Rules = nil
+	data = DefaultSecurityGroupResourceModel{}
+	data.setSecurityGroup(sg)
+	assert.Nil(t, data.Rules)
+
+	data = DefaultSecurityGroupResourceModel{Rules: []DefaultSecurityGroupRuleModel{}}
+	data.setSecurityGroup(sg)
+	assert.NotNil(t, data.Rules)
+	assert.Empty(t, data.Rules)
+}
@@ -0,0 +1,68 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const defaultSGResourceName = "thecloud_default_security_group.test"
+
+func testAccDefaultSecurityGroupConfig(vpcName, rules string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "default_sg_vpc" {
+  name       = "%s"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_default_security_group" "test" {
+  vpc_id = thecloud_vpc.default_sg_vpc.id
+  %s
+}
+`, vpcName, rules)
+}
+
+func TestAccDefaultSecurityGroupResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	vpcName := fmt.Sprintf("default-sg-vpc-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt and replace the allow-all egress rule
+			{
+				Config: testAccDefaultSecurityGroupConfig(vpcName, `
+  rule = [
+    {
+      direction = "ingress"
+      protocol  = "tcp"
+      port_min  = 443
+      port_max  = 443
+      cidr      = "10.0.0.0/8"
+    },
+  ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(defaultSGResourceName, "id"),
+					resource.TestCheckResourceAttr(defaultSGResourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(defaultSGResourceName, "rule.*", map[string]string{
+						"direction": "ingress",
+						"port_min":  "443",
+					}),
+				),
+			},
+			// Empty set removes every rule
+			{
+				Config: testAccDefaultSecurityGroupConfig(vpcName, "rule = []"),
+				Check:  resource.TestCheckResourceAttr(defaultSGResourceName, "rule.#", "0"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      defaultSGResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
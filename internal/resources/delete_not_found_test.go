@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// deleteFromState runs Delete on r with a state holding only the given id, against a
+// client talking to url.
+func deleteFromState(t *testing.T, r resource.Resource, url, id string) resource.DeleteResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: client.NewClient(url, "test-key", client.WithRetryMax(0)),
+	}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, id)
+
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	var resp resource.DeleteResponse
+	r.Delete(ctx, req, &resp)
+
+	return resp
+}
+
+func TestDeleteAlreadyGone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"not_found","message":"not found","code":"NOT_FOUND"}}`))
+	}))
+	defer server.Close()
+
+	resp := deleteFromState(t, NewVpcResource(), server.URL, "vpc-1")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	resp = deleteFromState(t, NewInstanceResource(), server.URL, "inst-1")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
@@ -2,21 +2,39 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &DeploymentResource{}
 var _ resource.ResourceWithImportState = &DeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &DeploymentResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentResource{}
+
+// defaultDeploymentUpdateTimeout bounds how long an update waits for all replicas to run,
+// unless the timeouts block sets another update timeout.
+const defaultDeploymentUpdateTimeout = 20 * time.Minute
+
+// rolloutErrorLogLines is the number of log lines added to a failed rollout's error.
+const rolloutErrorLogLines = 20
 
 func NewDeploymentResource() resource.Resource {
 	return &DeploymentResource{}
@@ -36,6 +54,20 @@ type DeploymentResourceModel struct {
 	CurrentCount types.Int64  `tfsdk:"current_count"`
 	Ports        types.String `tfsdk:"ports"`
 	Status       types.String `tfsdk:"status"`
+	Revision     types.Int64  `tfsdk:"revision"`
+
+	Env          types.Map                   `tfsdk:"env"`
+	SensitiveEnv types.Map                   `tfsdk:"sensitive_env"`
+	HealthCheck  *DeploymentHealthCheckModel `tfsdk:"health_check"`
+	Timeouts     timeouts.Value              `tfsdk:"timeouts"`
+}
+
+// DeploymentHealthCheckModel describes the health_check block.
+type DeploymentHealthCheckModel struct {
+	Path                types.String `tfsdk:"path"`
+	Port                types.Int64  `tfsdk:"port"`
+	InitialDelaySeconds types.Int64  `tfsdk:"initial_delay_seconds"`
+	PeriodSeconds       types.Int64  `tfsdk:"period_seconds"`
 }
 
 func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,7 +95,7 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"image": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The container image to deploy (e.g. redis:alpine).",
+				MarkdownDescription: "The container image to deploy (e.g. redis:alpine). Changing this rolls out new containers.",
 			},
 			"replicas": schema.Int64Attribute{
 				Required:            true,
@@ -75,12 +107,76 @@ func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"ports": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Exposed ports (e.g. 80:8080).",
+				MarkdownDescription: "Exposed ports (e.g. 80:8080). Changing this rolls out new containers.",
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the deployment.",
 			},
+			"revision": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The revision of the deployment, incremented by every rollout of a new `image` or `ports`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"env": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Environment variables of the containers. Changing this rolls out new containers.",
+			},
+			"sensitive_env": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				MarkdownDescription: "Environment variables of the containers whose values are hidden from plan output. " +
+					"A variable can't be in both `env` and `sensitive_env`. Changing this rolls out new containers.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+
+		Blocks: map[string]schema.Block{
+			"health_check": schema.SingleNestedBlock{
+				MarkdownDescription: "An HTTP probe containers must pass before they receive traffic. Changing this rolls out new containers.",
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The path requested by the probe (e.g. /healthz).",
+					},
+					"port": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "The container port the probe connects to.",
+						Validators: []validator.Int64{
+							int64BetweenValidator{min: 1, max: 65535},
+						},
+					},
+					"initial_delay_seconds": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Seconds to wait after a container starts before probing it.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							validators.Int64AtLeast(0),
+						},
+					},
+					"period_seconds": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Seconds between probes.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							validators.Int64AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -104,6 +200,28 @@ func (r *DeploymentResource) Configure(ctx context.Context, req resource.Configu
 	r.client = client
 }
 
+func (r *DeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var env, sensitiveEnv types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("env"), &env)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_env"), &sensitiveEnv)...)
+
+	if resp.Diagnostics.HasError() || env.IsUnknown() || sensitiveEnv.IsUnknown() {
+		return
+	}
+
+	// Read couldn't tell which map a variable belongs in
+	for name := range env.Elements() {
+		if _, ok := sensitiveEnv.Elements()[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_env"),
+				"Duplicate Environment Variable",
+				fmt.Sprintf("Environment variable %q is set in both \"env\" and \"sensitive_env\". Set it in only one of them.", name),
+			)
+		}
+	}
+}
+
 func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DeploymentResourceModel
 
@@ -113,22 +231,46 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultImageWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForImageAvailable(ctx, r.client, data.Image.ValueString(), createTimeout)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, diags := data.expandEnv(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	deployReq := client.CreateDeploymentRequest{
-		Name:     data.Name.ValueString(),
-		Image:    data.Image.ValueString(),
-		Replicas: int(data.Replicas.ValueInt64()),
-		Ports:    data.Ports.ValueString(),
+		Name:        data.Name.ValueString(),
+		Image:       data.Image.ValueString(),
+		Replicas:    int(data.Replicas.ValueInt64()),
+		Ports:       data.Ports.ValueString(),
+		Env:         env,
+		HealthCheck: data.HealthCheck.expand(),
 	}
 
 	dep, err := r.client.CreateDeployment(ctx, deployReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Deployment, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Deployment", err)
 		return
 	}
 
 	data.ID = types.StringValue(dep.ID)
 	data.Status = types.StringValue(dep.Status)
 	data.CurrentCount = types.Int64Value(int64(dep.CurrentCount))
+	data.Revision = types.Int64Value(int64(dep.Revision))
+	data.setHealthCheck(dep.HealthCheck)
 
 	tflog.Trace(ctx, "created a Deployment resource")
 
@@ -146,7 +288,7 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	dep, err := r.client.GetDeployment(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Deployment, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Deployment", err)
 		return
 	}
 
@@ -162,6 +304,9 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.CurrentCount = types.Int64Value(int64(dep.CurrentCount))
 	data.Ports = types.StringValue(dep.Ports)
 	data.Status = types.StringValue(dep.Status)
+	data.Revision = types.Int64Value(int64(dep.Revision))
+	data.setHealthCheck(dep.HealthCheck)
+	resp.Diagnostics.Append(data.setEnv(ctx, dep.Env)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -176,20 +321,147 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.Replicas.Equal(state.Replicas) {
 		err := r.client.ScaleDeployment(ctx, plan.ID.ValueString(), int(plan.Replicas.ValueInt64()))
 		if err != nil {
-			resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to scale Deployment, got error: %s", err))
+			apierror.AddClientError(&resp.Diagnostics, "Unable to scale Deployment", err)
 			return
 		}
 	}
 
-	// For Image or Ports, the current backend Scale only handles replicas.
-	// We might need RequiresReplace for those if Update isn't supported.
+	if plan.needsRollout(state) {
+		resp.Diagnostics.Append(waitForImageAvailable(ctx, r.client, plan.Image.ValueString(), updateTimeout)...)
+
+		env, diags := plan.expandEnv(ctx)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := r.client.RolloutDeployment(ctx, plan.ID.ValueString(), client.RolloutDeploymentRequest{
+			Image:       plan.Image.ValueString(),
+			Ports:       plan.Ports.ValueString(),
+			Env:         env,
+			HealthCheck: plan.HealthCheck.expand(),
+		})
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to roll out Deployment", err)
+			return
+		}
+	}
+
+	dep, diags := r.waitForReplicas(ctx, plan.ID.ValueString(), int(plan.Replicas.ValueInt64()), updateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	// The scale and rollout were accepted even if the wait failed, so the new image and
+	// replicas are saved rather than lost from state
+	if dep != nil {
+		plan.CurrentCount = types.Int64Value(int64(dep.CurrentCount))
+		plan.Status = types.StringValue(dep.Status)
+		plan.Revision = types.Int64Value(int64(dep.Revision))
+		plan.setHealthCheck(dep.HealthCheck)
+	} else {
+		plan.keepUnknownsFrom(state)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		tflog.Trace(ctx, "updated a Deployment resource")
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// waitForReplicas waits until the deployment is running with all of its replicas, so a
+// rollout has replaced every container before the update is reported done. The last
+// observed deployment is returned with any error, or nil if it couldn't be read.
+func (r *DeploymentResource) waitForReplicas(ctx context.Context, id string, replicas int, timeout time.Duration) (*client.Deployment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var dep *client.Deployment
+
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := r.client.GetDeployment(ctx, id)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		dep = current
+		// A deployment still replacing containers can already report running
+		if strings.EqualFold(current.Status, "running") && current.CurrentCount != replicas {
+			return "scaling", false, nil
+		}
+		return current.Status, false, nil
+	}, []string{"running"}, []string{"pending", "updating", "scaling"}, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError("Update Timeout", fmt.Sprintf("Timed out waiting for Deployment %s to finish updating. Last observed status: %q, with %d of %d replicas running.",
+			id, timeoutErr.LastStatus, dep.CurrentCount, replicas))
+		return dep, r.withRolloutLogs(ctx, id, diags)
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Deployment %s did not become running after updating", id), err)
+		return dep, r.withRolloutLogs(ctx, id, diags)
+	}
+
+	return dep, diags
+}
+
+// withRolloutLogs adds the deployment's most recent log lines to the errors in diags,
+// when the provider is configured with include_logs_in_errors. The errors are returned
+// unchanged when the logs can't be read, so they don't hide the rollout's failure.
+func (r *DeploymentResource) withRolloutLogs(ctx context.Context, id string, diags diag.Diagnostics) diag.Diagnostics {
+	if !r.client.IncludeLogsInErrors() {
+		return diags
+	}
+
+	entries, err := r.client.GetDeploymentLogs(ctx, id, client.DeploymentLogsOptions{Tail: rolloutErrorLogLines})
+	if err != nil {
+		tflog.Debug(ctx, "unable to read Deployment logs for rollout error", map[string]interface{}{"id": id, "error": err.Error()})
+		return diags
+	}
+	if len(entries) == 0 {
+		return diags
+	}
+
+	logs := fmt.Sprintf("\n\nRecent logs of Deployment %s:\n%s", id, client.FormatDeploymentLogs(entries))
+
+	out := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityError {
+			d = diag.NewErrorDiagnostic(d.Summary(), d.Detail()+logs)
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only updates of an existing deployment can roll out
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A rollout bumps the revision
+	if plan.needsRollout(state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revision"), types.Int64Unknown())...)
+	}
+}
+
 func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data DeploymentResourceModel
 
@@ -201,11 +473,137 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	err := r.client.DeleteDeployment(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Deployment, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Deployment", err)
 		return
 	}
 }
 
 func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrName(ctx, req, resp, "Deployment", r.client.ListDeployments, func(dep client.Deployment) (string, string) {
+		return dep.ID, dep.Name
+	})
+}
+
+// needsRollout reports whether the container settings differ between m and state.
+func (m DeploymentResourceModel) needsRollout(state DeploymentResourceModel) bool {
+	if !m.Image.Equal(state.Image) || !m.Ports.Equal(state.Ports) {
+		return true
+	}
+	if !m.Env.Equal(state.Env) || !m.SensitiveEnv.Equal(state.SensitiveEnv) {
+		return true
+	}
+	if (m.HealthCheck == nil) != (state.HealthCheck == nil) {
+		return true
+	}
+	return m.HealthCheck != nil && *m.HealthCheck != *state.HealthCheck
+}
+
+// expandEnv merges env and sensitive_env into the single map the API takes.
+func (m DeploymentResourceModel) expandEnv(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var env, sensitiveEnv map[string]string
+
+	if !m.Env.IsNull() {
+		diags.Append(m.Env.ElementsAs(ctx, &env, false)...)
+	}
+	if !m.SensitiveEnv.IsNull() {
+		diags.Append(m.SensitiveEnv.ElementsAs(ctx, &sensitiveEnv, false)...)
+	}
+
+	if env == nil && sensitiveEnv == nil {
+		return nil, diags
+	}
+
+	merged := make(map[string]string, len(env)+len(sensitiveEnv))
+	for name, value := range env {
+		merged[name] = value
+	}
+	for name, value := range sensitiveEnv {
+		merged[name] = value
+	}
+	return merged, diags
+}
+
+// setEnv splits the API's environment back into env and sensitive_env. Variables already
+// in sensitive_env stay there; any other variable, including ones added outside Terraform,
+// goes in env. An empty map is kept null when it was null.
+func (m *DeploymentResourceModel) setEnv(ctx context.Context, apiEnv map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	env := map[string]string{}
+	sensitiveEnv := map[string]string{}
+	for name, value := range apiEnv {
+		if _, ok := m.SensitiveEnv.Elements()[name]; ok {
+			sensitiveEnv[name] = value
+		} else {
+			env[name] = value
+		}
+	}
+
+	if len(env) > 0 || !m.Env.IsNull() {
+		value, d := types.MapValueFrom(ctx, types.StringType, env)
+		diags.Append(d...)
+		m.Env = value
+	}
+	if len(sensitiveEnv) > 0 || !m.SensitiveEnv.IsNull() {
+		value, d := types.MapValueFrom(ctx, types.StringType, sensitiveEnv)
+		diags.Append(d...)
+		m.SensitiveEnv = value
+	}
+
+	return diags
+}
+
+func (m *DeploymentHealthCheckModel) expand() *client.DeploymentHealthCheck {
+	if m == nil {
+		return nil
+	}
+	return &client.DeploymentHealthCheck{
+		Path:                m.Path.ValueString(),
+		Port:                int(m.Port.ValueInt64()),
+		InitialDelaySeconds: int(m.InitialDelaySeconds.ValueInt64()),
+		PeriodSeconds:       int(m.PeriodSeconds.ValueInt64()),
+	}
+}
+
+func (m *DeploymentResourceModel) setHealthCheck(healthCheck *client.DeploymentHealthCheck) {
+	if healthCheck == nil {
+		m.HealthCheck = nil
+		return
+	}
+	m.HealthCheck = &DeploymentHealthCheckModel{
+		Path:                types.StringValue(healthCheck.Path),
+		Port:                types.Int64Value(int64(healthCheck.Port)),
+		InitialDelaySeconds: types.Int64Value(int64(healthCheck.InitialDelaySeconds)),
+		PeriodSeconds:       types.Int64Value(int64(healthCheck.PeriodSeconds)),
+	}
+}
+
+// keepUnknownsFrom replaces the computed values an update couldn't read back with
+// their values in state, so the model can be saved.
+func (m *DeploymentResourceModel) keepUnknownsFrom(state DeploymentResourceModel) {
+	if m.CurrentCount.IsUnknown() {
+		m.CurrentCount = state.CurrentCount
+	}
+	if m.Status.IsUnknown() {
+		m.Status = state.Status
+	}
+	if m.Revision.IsUnknown() {
+		m.Revision = state.Revision
+	}
+	if m.HealthCheck == nil {
+		return
+	}
+	if m.HealthCheck.InitialDelaySeconds.IsUnknown() {
+		m.HealthCheck.InitialDelaySeconds = types.Int64Null()
+		if state.HealthCheck != nil {
+			m.HealthCheck.InitialDelaySeconds = state.HealthCheck.InitialDelaySeconds
+		}
+	}
+	if m.HealthCheck.PeriodSeconds.IsUnknown() {
+		m.HealthCheck.PeriodSeconds = types.Int64Null()
+		if state.HealthCheck != nil {
+			m.HealthCheck.PeriodSeconds = state.HealthCheck.PeriodSeconds
+		}
+	}
 }
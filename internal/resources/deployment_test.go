@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentEnvRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	data := DeploymentResourceModel{
+		Env:          types.MapValueMust(types.StringType, map[string]attr.Value{"LOG_LEVEL": types.StringValue("info")}),
+		SensitiveEnv: types.MapValueMust(types.StringType, map[string]attr.Value{"DB_PASSWORD": types.StringValue("hunter2")}),
+	}
+
+	env, diags := data.expandEnv(ctx)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]string{"LOG_LEVEL": "info", "DB_PASSWORD": "hunter2"}, env)
+
+	// A variable added outside Terraform shows up in env
+	env["EXTRA"] = "1"
+	env["DB_PASSWORD"] = "changed"
+	assert.False(t, data.setEnv(ctx, env).HasError())
+
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"LOG_LEVEL": types.StringValue("info"),
+		"EXTRA":     types.StringValue("1"),
+	}), data.Env)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"DB_PASSWORD": types.StringValue("changed"),
+	}), data.SensitiveEnv)
+}
+
+func TestDeploymentEnvUnset(t *testing.T) {
+	ctx := context.Background()
+
+	data := DeploymentResourceModel{Env: types.MapNull(types.StringType), SensitiveEnv: types.MapNull(types.StringType)}
+
+	env, diags := data.expandEnv(ctx)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, env)
+
+	assert.False(t, data.setEnv(ctx, nil).HasError())
+	assert.True(t, data.Env.IsNull())
+	assert.True(t, data.SensitiveEnv.IsNull())
+}
+
+func TestDeploymentNeedsRollout(t *testing.T) {
+	healthCheck := func(period int64) *DeploymentHealthCheckModel {
+		return &DeploymentHealthCheckModel{
+			Path:                types.StringValue("/healthz"),
+			Port:                types.Int64Value(8080),
+			InitialDelaySeconds: types.Int64Value(0),
+			PeriodSeconds:       types.Int64Value(period),
+		}
+	}
+
+	state := DeploymentResourceModel{
+		Image:        types.StringValue("nginx:1.27"),
+		Ports:        types.StringValue("80:8080"),
+		Replicas:     types.Int64Value(2),
+		Env:          types.MapNull(types.StringType),
+		SensitiveEnv: types.MapNull(types.StringType),
+		HealthCheck:  healthCheck(10),
+	}
+
+	plan := state
+	plan.Replicas = types.Int64Value(3)
+	assert.False(t, plan.needsRollout(state))
+
+	plan.HealthCheck = healthCheck(10)
+	assert.False(t, plan.needsRollout(state))
+
+	plan.HealthCheck = healthCheck(30)
+	assert.True(t, plan.needsRollout(state))
+
+	plan.HealthCheck = nil
+	assert.True(t, plan.needsRollout(state))
+
+	plan = state
+	plan.SensitiveEnv = types.MapValueMust(types.StringType, map[string]attr.Value{"TOKEN": types.StringValue("x")})
+	assert.True(t, plan.needsRollout(state))
+}
+
+func TestDeploymentRolloutErrorLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/containers/deployments/dep-1/logs" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		assert.Equal(t, "20", r.URL.Query().Get("tail"))
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("2024-01-02T15:04:05Z web-0 panic: missing DATABASE_URL\n")) // nolint:errcheck
+	}))
+	defer server.Close()
+
+	failed := func() diag.Diagnostics {
+		var diags diag.Diagnostics
+		diags.AddError("Update Timeout", "Timed out waiting for Deployment dep-1 to finish updating.")
+		return diags
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		r := &DeploymentResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+		diags := r.withRolloutLogs(context.Background(), "dep-1", failed())
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Timed out waiting for Deployment dep-1 to finish updating.", diags[0].Detail())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		r := &DeploymentResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0), client.WithLogsInErrors(true))}
+		diags := r.withRolloutLogs(context.Background(), "dep-1", failed())
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Update Timeout", diags[0].Summary())
+		assert.Equal(t, "Timed out waiting for Deployment dep-1 to finish updating.\n\n"+
+			"Recent logs of Deployment dep-1:\n2024-01-02T15:04:05Z web-0 panic: missing DATABASE_URL", diags[0].Detail())
+	})
+
+	t.Run("logs unavailable", func(t *testing.T) {
+		r := &DeploymentResource{client: client.NewClient(server.URL+"/missing", "test-key", client.WithRetryMax(0), client.WithLogsInErrors(true))}
+		diags := r.withRolloutLogs(context.Background(), "dep-1", failed())
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Timed out waiting for Deployment dep-1 to finish updating.", diags[0].Detail())
+	})
+}
+
+func TestDeploymentWaitForReplicas(t *testing.T) {
+	statuses := map[string]client.Deployment{
+		"dep-ok":     {ID: "dep-ok", Status: "running", CurrentCount: 2},
+		"dep-failed": {ID: "dep-failed", Status: "failed", CurrentCount: 0},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dep := statuses[strings.TrimPrefix(r.URL.Path, "/containers/deployments/")]
+		raw, err := json.Marshal(dep)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	r := &DeploymentResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	dep, diags := r.waitForReplicas(context.Background(), "dep-ok", 2, time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "dep-ok", dep.ID)
+
+	// A failed rollout is reported right away instead of when the wait times out
+	dep, diags = r.waitForReplicas(context.Background(), "dep-failed", 2, time.Minute)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "failed")
+
+	// The last observed deployment is still returned, so Update can save it
+	require.NotNil(t, dep)
+	assert.Equal(t, "failed", dep.Status)
+}
+
+func TestDeploymentKeepUnknownsFrom(t *testing.T) {
+	state := DeploymentResourceModel{
+		CurrentCount: types.Int64Value(2),
+		Status:       types.StringValue("running"),
+		Revision:     types.Int64Value(3),
+	}
+	plan := DeploymentResourceModel{
+		Image:        types.StringValue("nginx:1.27"),
+		CurrentCount: types.Int64Unknown(),
+		Status:       types.StringUnknown(),
+		Revision:     types.Int64Unknown(),
+		HealthCheck: &DeploymentHealthCheckModel{
+			Path:                types.StringValue("/healthz"),
+			Port:                types.Int64Value(8080),
+			InitialDelaySeconds: types.Int64Unknown(),
+			PeriodSeconds:       types.Int64Value(10),
+		},
+	}
+
+	plan.keepUnknownsFrom(state)
+
+	assert.Equal(t, "nginx:1.27", plan.Image.ValueString())
+	assert.Equal(t, int64(2), plan.CurrentCount.ValueInt64())
+	assert.Equal(t, "running", plan.Status.ValueString())
+	assert.Equal(t, int64(3), plan.Revision.ValueInt64())
+	assert.True(t, plan.HealthCheck.InitialDelaySeconds.IsNull())
+	assert.Equal(t, int64(10), plan.HealthCheck.PeriodSeconds.ValueInt64())
+}
@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = dnsNameType{}
+
+// dnsNameType is a string holding a DNS name. Values that differ only by the trailing
+// dot of a fully qualified name are semantically equal, so the API adding or removing
+// the dot doesn't show up as a change.
+type dnsNameType struct {
+	basetypes.StringType
+}
+
+func (t dnsNameType) Equal(o attr.Type) bool {
+	other, ok := o.(dnsNameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t dnsNameType) String() string {
+	return "dnsNameType"
+}
+
+func (t dnsNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return dnsNameValue{StringValue: in}, nil
+}
+
+func (t dnsNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t dnsNameType) ValueType(ctx context.Context) attr.Value {
+	return dnsNameValue{}
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = dnsNameValue{}
+
+// dnsNameValue is a value of dnsNameType.
+type dnsNameValue struct {
+	basetypes.StringValue
+}
+
+func newDNSNameValue(value string) dnsNameValue {
+	return dnsNameValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v dnsNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(dnsNameValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v dnsNameValue) Type(ctx context.Context) attr.Type {
+	return dnsNameType{}
+}
+
+func (v dnsNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(dnsNameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.TrimSuffix(v.ValueString(), ".") == strings.TrimSuffix(newValue.ValueString(), "."), diags
+}
@@ -3,20 +3,29 @@ package resources
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &DNSRecordResource{}
 var _ resource.ResourceWithImportState = &DNSRecordResource{}
+var _ resource.ResourceWithConfigValidators = &DNSRecordResource{}
+var _ resource.ResourceWithValidateConfig = &DNSRecordResource{}
+
+// dnsRecordTypes are the DNS record types the API supports.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV"}
 
 func NewDNSRecordResource() resource.Resource {
 	return &DNSRecordResource{}
@@ -31,9 +40,9 @@ type DNSRecordResource struct {
 type DNSRecordResourceModel struct {
 	ID       types.String `tfsdk:"id"`
 	ZoneID   types.String `tfsdk:"zone_id"`
-	Name     types.String `tfsdk:"name"`
+	Name     dnsNameValue `tfsdk:"name"`
 	Type     types.String `tfsdk:"type"`
-	Content  types.String `tfsdk:"content"`
+	Content  dnsNameValue `tfsdk:"content"`
 	TTL      types.Int64  `tfsdk:"ttl"`
 	Priority types.Int64  `tfsdk:"priority"`
 }
@@ -63,15 +72,21 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the DNS Record (e.g., www).",
+				MarkdownDescription: "The name of the DNS Record (e.g., www). A trailing dot is ignored when comparing with the API.",
+				CustomType:          dnsNameType{},
 			},
 			"type": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The type of the DNS Record (A, AAAA, CNAME, MX, TXT, SRV).",
+				Validators: []validator.String{
+					stringOneOfValidator{values: dnsRecordTypes},
+				},
 			},
 			"content": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The content of the DNS Record (e.g., IP address).",
+				Required: true,
+				MarkdownDescription: "The content of the DNS Record (e.g., IP address). Must be an IPv4 address for A records and an IPv6 address for AAAA records. " +
+					"A trailing dot is ignored when comparing with the API.",
+				CustomType: dnsNameType{},
 			},
 			"ttl": schema.Int64Attribute{
 				Optional:            true,
@@ -80,7 +95,7 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			"priority": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "The priority of the DNS Record (for MX, SRV).",
+				MarkdownDescription: "The priority of the DNS Record. Required for MX and SRV records, and not allowed for other types.",
 			},
 		},
 	}
@@ -105,6 +120,54 @@ func (r *DNSRecordResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *DNSRecordResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		typeSpecificAttributesValidator{
+			typeAttribute: path.Root("type"),
+			required: map[string][]path.Path{
+				"MX":  {path.Root("priority")},
+				"SRV": {path.Root("priority")},
+			},
+		},
+	}
+}
+
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() || data.Content.IsNull() || data.Content.IsUnknown() {
+		return
+	}
+
+	content := data.Content.ValueString()
+	ip := net.ParseIP(content)
+
+	switch data.Type.ValueString() {
+	case "A":
+		if ip == nil || ip.To4() == nil || strings.Contains(content, ":") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Record Content",
+				fmt.Sprintf("A records must point to an IPv4 address such as \"10.0.0.10\", got %q. Use a CNAME record to point to a hostname.", content),
+			)
+		}
+	case "AAAA":
+		if ip == nil || !strings.Contains(content, ":") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Record Content",
+				fmt.Sprintf("AAAA records must point to an IPv6 address such as \"fd00::10\", got %q. Use a CNAME record to point to a hostname.", content),
+			)
+		}
+	}
+}
+
 func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DNSRecordResourceModel
 
@@ -128,7 +191,7 @@ func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateReque
 
 	res, err := r.client.CreateDNSRecord(ctx, data.ZoneID.ValueString(), record)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create DNS Record, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create DNS Record", err)
 		return
 	}
 
@@ -140,6 +203,8 @@ func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateReque
 		data.Priority = types.Int64Null()
 	}
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, res.ETag)...)
+
 	tflog.Trace(ctx, "created a DNS Record resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -156,7 +221,7 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	record, err := r.client.GetDNSRecord(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read DNS Record, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read DNS Record", err)
 		return
 	}
 
@@ -167,9 +232,9 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	data.ID = types.StringValue(record.ID)
 	data.ZoneID = types.StringValue(record.ZoneID)
-	data.Name = types.StringValue(record.Name)
+	data.Name = newDNSNameValue(record.Name)
 	data.Type = types.StringValue(record.Type)
-	data.Content = types.StringValue(record.Content)
+	data.Content = newDNSNameValue(record.Content)
 	data.TTL = types.Int64Value(int64(record.TTL))
 	if record.Priority != nil {
 		data.Priority = types.Int64Value(int64(*record.Priority))
@@ -177,6 +242,7 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Priority = types.Int64Null()
 	}
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, record.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -203,9 +269,16 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		record.Priority = &p
 	}
 
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	record.ETag = etag
+
 	res, err := r.client.UpdateDNSRecord(ctx, data.ID.ValueString(), record)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to update DNS Record, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update DNS Record", err)
 		return
 	}
 
@@ -216,6 +289,7 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		data.Priority = types.Int64Null()
 	}
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, res.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -230,11 +304,22 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err := r.client.DeleteDNSRecord(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete DNS Record, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete DNS Record", err)
 		return
 	}
 }
 
 func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import requires zone_id:record_id
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: zone_id:record_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
 }
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRecordConfigValidation(t *testing.T) {
+	ctx := context.Background()
+	r := NewDNSRecordResource().(*DNSRecordResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	priority := tftypes.NewValue(tftypes.Number, 10)
+
+	validate := func(config map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, req, resp)
+		}
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("A"), "content": str("10.0.0.10")}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("AAAA"), "content": str("fd00::10")}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("CNAME"), "content": str("web.example.com.")}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("MX"), "content": str("mail.example.com"), "priority": priority}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("A"), "content": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}))
+
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("A"), "content": str("fd00::10")}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("A"), "content": str("web.example.com")}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("AAAA"), "content": str("10.0.0.10")}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("MX"), "content": str("mail.example.com")}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("A"), "content": str("10.0.0.10"), "priority": priority}))
+}
+
+func TestDNSNameSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal := func(a, b string) bool {
+		ok, diags := newDNSNameValue(a).StringSemanticEquals(ctx, newDNSNameValue(b))
+		assert.False(t, diags.HasError())
+		return ok
+	}
+
+	assert.True(t, equal("www.example.com.", "www.example.com"))
+	assert.True(t, equal("www.example.com", "www.example.com."))
+	assert.True(t, equal("10.0.0.10", "10.0.0.10"))
+	assert.False(t, equal("www.example.com", "www.example.org"))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -108,7 +109,7 @@ func (r *DNSZoneResource) Create(ctx context.Context, req resource.CreateRequest
 
 	zone, err := r.client.CreateDNSZone(ctx, data.Name.ValueString(), data.Description.ValueString(), data.VpcID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create DNS Zone, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create DNS Zone", err)
 		return
 	}
 
@@ -131,7 +132,7 @@ func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	zone, err := r.client.GetDNSZone(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read DNS Zone, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read DNS Zone", err)
 		return
 	}
 
@@ -150,7 +151,26 @@ func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *DNSZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// API might support updating description, but let's assume no-op for now.
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the description can change in place; name and vpc_id force replacement.
+	zone, err := r.client.UpdateDNSZone(ctx, data.ID.ValueString(), data.Description.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update DNS Zone", err)
+		return
+	}
+
+	data.Status = types.StringValue(zone.Status)
+
+	tflog.Trace(ctx, "updated a DNS Zone resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -164,7 +184,7 @@ func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	err := r.client.DeleteDNSZone(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete DNS Zone, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete DNS Zone", err)
 		return
 	}
 }
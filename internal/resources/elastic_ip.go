@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &ElasticIPResource{}
 var _ resource.ResourceWithImportState = &ElasticIPResource{}
+var _ resource.ResourceWithUpgradeState = &ElasticIPResource{}
 
 func NewElasticIPResource() resource.Resource {
 	return &ElasticIPResource{}
@@ -29,10 +31,11 @@ type ElasticIPResource struct {
 
 // ElasticIPResourceModel describes the resource data model.
 type ElasticIPResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	PublicIP   types.String `tfsdk:"public_ip"`
-	InstanceID types.String `tfsdk:"instance_id"`
-	Status     types.String `tfsdk:"status"`
+	ID                   types.String `tfsdk:"id"`
+	PublicIP             types.String `tfsdk:"public_ip"`
+	InstanceID           types.String `tfsdk:"instance_id"`
+	AssociatedInstanceID types.String `tfsdk:"associated_instance_id"`
+	Status               types.String `tfsdk:"status"`
 }
 
 func (r *ElasticIPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,6 +45,7 @@ func (r *ElasticIPResource) Metadata(ctx context.Context, req resource.MetadataR
 func (r *ElasticIPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Elastic IP resource allows you to allocate and manage static public IP addresses.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -56,8 +60,13 @@ func (r *ElasticIPResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The allocated public IP address.",
 			},
 			"instance_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The ID of the instance to associate the IP with. Changing it moves the IP to the new instance in place, and removing it disassociates the IP. " +
+					"Don't combine it with a `thecloud_elastic_ip_association` for the same IP.",
+			},
+			"associated_instance_id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The ID of the instance this IP is associated with.",
+				MarkdownDescription: "The ID of the instance the IP is currently associated with, whether through `instance_id`, a `thecloud_elastic_ip_association` or outside Terraform.",
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -97,19 +106,25 @@ func (r *ElasticIPResource) Create(ctx context.Context, req resource.CreateReque
 
 	eip, err := r.client.AllocateElasticIP(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to allocate Elastic IP, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to allocate Elastic IP", err)
 		return
 	}
 
-	data.ID = types.StringValue(eip.ID)
-	data.PublicIP = types.StringValue(eip.PublicIP)
-	data.Status = types.StringValue(eip.Status)
-	if eip.InstanceID != "" {
-		data.InstanceID = types.StringValue(eip.InstanceID)
-	} else {
-		data.InstanceID = types.StringNull()
+	if !data.InstanceID.IsNull() {
+		associated, err := r.client.AssociateElasticIP(ctx, eip.ID, data.InstanceID.ValueString())
+		if err != nil {
+			// Keep the allocated IP in state so it's released rather than leaked
+			data.InstanceID = types.StringNull()
+			data.setElasticIP(eip)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			apierror.AddClientError(&resp.Diagnostics, "Unable to associate Elastic IP", err)
+			return
+		}
+		eip = associated
 	}
 
+	data.setElasticIP(eip)
+
 	tflog.Trace(ctx, "allocated an Elastic IP resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -126,7 +141,7 @@ func (r *ElasticIPResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	eip, err := r.client.GetElasticIP(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Elastic IP, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Elastic IP", err)
 		return
 	}
 
@@ -135,20 +150,69 @@ func (r *ElasticIPResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	data.ID = types.StringValue(eip.ID)
-	data.PublicIP = types.StringValue(eip.PublicIP)
-	data.Status = types.StringValue(eip.Status)
-	if eip.InstanceID != "" {
-		data.InstanceID = types.StringValue(eip.InstanceID)
-	} else {
-		data.InstanceID = types.StringNull()
+	// The association is only tracked once instance_id is set. Recording where the IP
+	// actually is lets the next apply move it back.
+	if !data.InstanceID.IsNull() && eip.InstanceID != data.InstanceID.ValueString() {
+		resp.Diagnostics.AddWarning(
+			"Elastic IP Associated Elsewhere",
+			fmt.Sprintf("Elastic IP %s is associated with %s instead of instance %s from instance_id, so the next apply will move it back. "+
+				"If a thecloud_elastic_ip_association manages the same IP, remove either it or instance_id.",
+				eip.ID, describeAssociation(eip.InstanceID), data.InstanceID.ValueString()),
+		)
+		data.InstanceID = stringValueOrNull(eip.InstanceID)
 	}
 
+	data.setElasticIP(eip)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ElasticIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Elastic IP itself doesn't have many updatable fields. Association is a separate resource or action.
+	var plan, state ElasticIPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eip, err := r.client.GetElasticIP(ctx, plan.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Elastic IP", err)
+		return
+	}
+	if eip == nil {
+		resp.Diagnostics.AddError("Elastic IP Not Found", fmt.Sprintf("Elastic IP %s was deleted while it was being updated.", plan.ID.ValueString()))
+		return
+	}
+
+	want := plan.InstanceID.ValueString()
+
+	// Only detach the IP from an instance it was attached to through instance_id, or to
+	// move it to the configured one
+	detach := eip.InstanceID != "" && eip.InstanceID != want && (!plan.InstanceID.IsNull() || eip.InstanceID == state.InstanceID.ValueString())
+	if detach {
+		eip, err = r.client.DisassociateElasticIP(ctx, eip.ID)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to disassociate Elastic IP", err)
+			return
+		}
+	}
+
+	if !plan.InstanceID.IsNull() && eip.InstanceID != want {
+		eip, err = r.client.AssociateElasticIP(ctx, eip.ID, want)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to associate Elastic IP", err)
+			return
+		}
+	}
+
+	plan.setElasticIP(eip)
+
+	tflog.Trace(ctx, "reassociated an Elastic IP resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ElasticIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -162,7 +226,7 @@ func (r *ElasticIPResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err := r.client.ReleaseElasticIP(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to release Elastic IP, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to release Elastic IP", err)
 		return
 	}
 }
@@ -170,3 +234,58 @@ func (r *ElasticIPResource) Delete(ctx context.Context, req resource.DeleteReque
 func (r *ElasticIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *ElasticIPResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 had a computed instance_id reporting any association. It moves to
+		// associated_instance_id, so IPs associated through thecloud_elastic_ip_association
+		// aren't disassociated for missing from the configuration.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"public_ip":   schema.StringAttribute{Computed: true},
+					"instance_id": schema.StringAttribute{Computed: true},
+					"status":      schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					ID         types.String `tfsdk:"id"`
+					PublicIP   types.String `tfsdk:"public_ip"`
+					InstanceID types.String `tfsdk:"instance_id"`
+					Status     types.String `tfsdk:"status"`
+				}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, ElasticIPResourceModel{
+					ID:                   prior.ID,
+					PublicIP:             prior.PublicIP,
+					InstanceID:           types.StringNull(),
+					AssociatedInstanceID: prior.InstanceID,
+					Status:               prior.Status,
+				})...)
+			},
+		},
+	}
+}
+
+// setElasticIP copies the API's view of the Elastic IP into the computed attributes.
+func (m *ElasticIPResourceModel) setElasticIP(eip *client.ElasticIP) {
+	m.ID = types.StringValue(eip.ID)
+	m.PublicIP = types.StringValue(eip.PublicIP)
+	m.Status = types.StringValue(eip.Status)
+	m.AssociatedInstanceID = stringValueOrNull(eip.InstanceID)
+}
+
+func describeAssociation(instanceID string) string {
+	if instanceID == "" {
+		return "no instance"
+	}
+	return fmt.Sprintf("instance %s", instanceID)
+}
@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -29,9 +32,10 @@ type ElasticIPAssociationResource struct {
 
 // ElasticIPAssociationResourceModel describes the resource data model.
 type ElasticIPAssociationResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	EipID      types.String `tfsdk:"eip_id"`
-	InstanceID types.String `tfsdk:"instance_id"`
+	ID                 types.String `tfsdk:"id"`
+	EipID              types.String `tfsdk:"eip_id"`
+	InstanceID         types.String `tfsdk:"instance_id"`
+	AllowReassociation types.Bool   `tfsdk:"allow_reassociation"`
 }
 
 func (r *ElasticIPAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,10 +63,12 @@ func (r *ElasticIPAssociationResource) Schema(ctx context.Context, req resource.
 			},
 			"instance_id": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The ID of the instance to associate with.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The ID of the instance to associate with. Changing this moves the Elastic IP to the new instance.",
+			},
+			"allow_reassociation": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether the Elastic IP may be detached from an instance Terraform didn't attach it to. " +
+					"When `true`, an Elastic IP attached elsewhere is moved to `instance_id`, including after it was moved outside Terraform. Defaults to `false`.",
 			},
 		},
 	}
@@ -96,9 +102,9 @@ func (r *ElasticIPAssociationResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	eip, err := r.client.AssociateElasticIP(ctx, data.EipID.ValueString(), data.InstanceID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to associate Elastic IP, got error: %s", err))
+	eip, diags := r.associate(ctx, data, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -120,7 +126,7 @@ func (r *ElasticIPAssociationResource) Read(ctx context.Context, req resource.Re
 
 	eip, err := r.client.GetElasticIP(ctx, data.EipID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Elastic IP for association, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Elastic IP for association", err)
 		return
 	}
 
@@ -131,13 +137,47 @@ func (r *ElasticIPAssociationResource) Read(ctx context.Context, req resource.Re
 
 	data.ID = types.StringValue(eip.ID)
 	data.EipID = types.StringValue(eip.ID)
-	data.InstanceID = types.StringValue(eip.InstanceID)
+
+	// The Elastic IP was moved outside Terraform. Recording the new instance lets the next
+	// apply move it back, which is only allowed with allow_reassociation.
+	if eip.InstanceID != data.InstanceID.ValueString() && !data.InstanceID.IsNull() {
+		if data.AllowReassociation.ValueBool() {
+			data.InstanceID = types.StringValue(eip.InstanceID)
+		} else {
+			resp.Diagnostics.AddWarning(
+				"Elastic IP Associated Elsewhere",
+				fmt.Sprintf("Elastic IP %s is now associated with instance %s instead of %s. "+
+					"Set allow_reassociation to true to move it back, or update instance_id to match.", eip.ID, eip.InstanceID, data.InstanceID.ValueString()),
+			)
+		}
+	} else {
+		data.InstanceID = types.StringValue(eip.InstanceID)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ElasticIPAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported, handled by RequiresReplace
+	var plan, state ElasticIPAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.InstanceID.Equal(state.InstanceID) {
+		_, diags := r.associate(ctx, plan, state.InstanceID.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "reassociated an Elastic IP resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ElasticIPAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -149,9 +189,21 @@ func (r *ElasticIPAssociationResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.DisassociateElasticIP(ctx, data.EipID.ValueString())
+	eip, err := r.client.GetElasticIP(ctx, data.EipID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to disassociate Elastic IP, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Elastic IP for association", err)
+		return
+	}
+
+	// Leave the Elastic IP alone if it's no longer associated with our instance
+	if eip == nil || eip.InstanceID != data.InstanceID.ValueString() {
+		return
+	}
+
+	// The Elastic IP or the instance may be deleted in the meantime
+	_, err = r.client.DisassociateElasticIP(ctx, data.EipID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to disassociate Elastic IP", err)
 		return
 	}
 }
@@ -159,3 +211,58 @@ func (r *ElasticIPAssociationResource) Delete(ctx context.Context, req resource.
 func (r *ElasticIPAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// associate attaches the Elastic IP to data.InstanceID. An Elastic IP attached to another
+// instance is detached first if that's ownedInstanceID, the instance Terraform attached
+// it to, or if allow_reassociation is set. Otherwise the API would reject the request
+// with a conflict, so a clearer error is returned instead.
+func (r *ElasticIPAssociationResource) associate(ctx context.Context, data ElasticIPAssociationResourceModel, ownedInstanceID string) (*client.ElasticIP, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	eipID := data.EipID.ValueString()
+	instanceID := data.InstanceID.ValueString()
+
+	eip, err := r.client.GetElasticIP(ctx, eipID)
+	if err != nil {
+		apierror.AddClientError(&diags, "Unable to read Elastic IP for association", err)
+		return nil, diags
+	}
+	if eip == nil {
+		diags.AddError("Elastic IP Not Found", fmt.Sprintf("Elastic IP %s does not exist.", eipID))
+		return nil, diags
+	}
+
+	if eip.InstanceID == instanceID {
+		return eip, diags
+	}
+
+	if eip.InstanceID != "" {
+		if eip.InstanceID != ownedInstanceID && !data.AllowReassociation.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("instance_id"),
+				"Elastic IP Already Associated",
+				fmt.Sprintf("Elastic IP %s is associated with instance %s. Set allow_reassociation to true to move it to instance %s. "+
+					"If the thecloud_elastic_ip sets instance_id, associate the IP there or here, not both.", eipID, eip.InstanceID, instanceID),
+			)
+			return nil, diags
+		}
+
+		tflog.Debug(ctx, "disassociating Elastic IP before reassociating it", map[string]interface{}{
+			"eip_id":      eipID,
+			"instance_id": eip.InstanceID,
+		})
+
+		if _, err := r.client.DisassociateElasticIP(ctx, eipID); err != nil {
+			apierror.AddClientError(&diags, "Unable to disassociate Elastic IP", err)
+			return nil, diags
+		}
+	}
+
+	eip, err = r.client.AssociateElasticIP(ctx, eipID, instanceID)
+	if err != nil {
+		apierror.AddClientError(&diags, "Unable to associate Elastic IP", err)
+		return nil, diags
+	}
+
+	return eip, diags
+}
@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeElasticIPAPI holds one Elastic IP and records the calls made to it.
+type fakeElasticIPAPI struct {
+	t     *testing.T
+	eip   client.ElasticIP
+	calls []string
+}
+
+func (f *fakeElasticIPAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/elastic-ips/"+f.eip.ID:
+	case r.Method == http.MethodPost && r.URL.Path == "/elastic-ips/"+f.eip.ID+"/disassociate":
+		f.eip.InstanceID = ""
+	case r.Method == http.MethodPost && r.URL.Path == "/elastic-ips/"+f.eip.ID+"/associate":
+		if f.eip.InstanceID != "" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		var body map[string]string
+		assert.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+		f.eip.InstanceID = body["instance_id"]
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	raw, err := json.Marshal(f.eip)
+	assert.NoError(f.t, err)
+	assert.NoError(f.t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+}
+
+func newFakeElasticIPAPI(t *testing.T, instanceID string) (*fakeElasticIPAPI, *ElasticIPAssociationResource, func()) {
+	api := &fakeElasticIPAPI{t: t, eip: client.ElasticIP{ID: "eip-1", InstanceID: instanceID}}
+	server := httptest.NewServer(api)
+	return api, &ElasticIPAssociationResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}, server.Close
+}
+
+func testElasticIPAssociation(instanceID string, allowReassociation bool) ElasticIPAssociationResourceModel {
+	return ElasticIPAssociationResourceModel{
+		EipID:              types.StringValue("eip-1"),
+		InstanceID:         types.StringValue(instanceID),
+		AllowReassociation: types.BoolValue(allowReassociation),
+	}
+}
+
+func TestElasticIPAssociateUnattached(t *testing.T) {
+	api, r, done := newFakeElasticIPAPI(t, "")
+	defer done()
+
+	_, diags := r.associate(context.Background(), testElasticIPAssociation("inst-1", false), "")
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "inst-1", api.eip.InstanceID)
+	assert.Equal(t, []string{"GET /elastic-ips/eip-1", "POST /elastic-ips/eip-1/associate"}, api.calls)
+}
+
+func TestElasticIPAssociateAttachedElsewhere(t *testing.T) {
+	api, r, done := newFakeElasticIPAPI(t, "inst-other")
+	defer done()
+
+	_, diags := r.associate(context.Background(), testElasticIPAssociation("inst-1", false), "")
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Elastic IP Already Associated", diags[0].Summary())
+	assert.Equal(t, "inst-other", api.eip.InstanceID)
+	assert.Equal(t, []string{"GET /elastic-ips/eip-1"}, api.calls)
+}
+
+func TestElasticIPAssociateAllowReassociation(t *testing.T) {
+	api, r, done := newFakeElasticIPAPI(t, "inst-other")
+	defer done()
+
+	_, diags := r.associate(context.Background(), testElasticIPAssociation("inst-1", true), "")
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "inst-1", api.eip.InstanceID)
+	assert.Equal(t, []string{
+		"GET /elastic-ips/eip-1",
+		"POST /elastic-ips/eip-1/disassociate",
+		"POST /elastic-ips/eip-1/associate",
+	}, api.calls)
+}
+
+func TestElasticIPAssociateMovesOwnInstance(t *testing.T) {
+	api, r, done := newFakeElasticIPAPI(t, "inst-1")
+	defer done()
+
+	// Moving from the instance Terraform attached it to doesn't need allow_reassociation
+	_, diags := r.associate(context.Background(), testElasticIPAssociation("inst-2", false), "inst-1")
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "inst-2", api.eip.InstanceID)
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateElasticIP runs Update against api, moving instance_id from the state's value
+// to the plan's.
+func updateElasticIP(t *testing.T, api *fakeElasticIPAPI, stateInstanceID, planInstanceID types.String) (ElasticIPResourceModel, resource.UpdateResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(api)
+	defer server.Close()
+	r := &ElasticIPResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := ElasticIPResourceModel{
+		ID:                   types.StringValue("eip-1"),
+		PublicIP:             types.StringValue("203.0.113.5"),
+		InstanceID:           stateInstanceID,
+		AssociatedInstanceID: types.StringValue(api.eip.InstanceID),
+		Status:               types.StringValue("allocated"),
+	}
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	require.False(t, req.State.Set(ctx, model).HasError())
+	model.InstanceID = planInstanceID
+	model.AssociatedInstanceID = types.StringUnknown()
+	require.False(t, req.Plan.Set(ctx, model).HasError())
+
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, req, &resp)
+
+	var got ElasticIPResourceModel
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+	}
+	return got, resp
+}
+
+func TestElasticIPUpdateMovesInstance(t *testing.T) {
+	api := &fakeElasticIPAPI{t: t, eip: client.ElasticIP{ID: "eip-1", InstanceID: "inst-1"}}
+
+	got, resp := updateElasticIP(t, api, types.StringValue("inst-1"), types.StringValue("inst-2"))
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, "inst-2", got.InstanceID.ValueString())
+	assert.Equal(t, "inst-2", got.AssociatedInstanceID.ValueString())
+	assert.Equal(t, []string{"GET /elastic-ips/eip-1", "POST /elastic-ips/eip-1/disassociate", "POST /elastic-ips/eip-1/associate"}, api.calls)
+}
+
+func TestElasticIPUpdateUnsetDisassociates(t *testing.T) {
+	api := &fakeElasticIPAPI{t: t, eip: client.ElasticIP{ID: "eip-1", InstanceID: "inst-1"}}
+
+	got, resp := updateElasticIP(t, api, types.StringValue("inst-1"), types.StringNull())
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, got.InstanceID.IsNull())
+	assert.True(t, got.AssociatedInstanceID.IsNull())
+	assert.Equal(t, "", api.eip.InstanceID)
+}
+
+func TestElasticIPUpdateUnsetLeavesOtherAssociation(t *testing.T) {
+	// Moved by a thecloud_elastic_ip_association since instance_id was last applied
+	api := &fakeElasticIPAPI{t: t, eip: client.ElasticIP{ID: "eip-1", InstanceID: "inst-other"}}
+
+	got, resp := updateElasticIP(t, api, types.StringValue("inst-1"), types.StringNull())
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, "inst-other", got.AssociatedInstanceID.ValueString())
+	assert.Equal(t, []string{"GET /elastic-ips/eip-1"}, api.calls)
+}
+
+func TestElasticIPUpgradeStateV0(t *testing.T) {
+	resp := upgradeState(t, &ElasticIPResource{}, 0, []byte(`{"id": "eip-1", "public_ip": "203.0.113.5", "instance_id": "inst-1", "status": "associated"}`))
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var data ElasticIPResourceModel
+	require.False(t, resp.State.Get(context.Background(), &data).HasError())
+
+	// An association that may come from thecloud_elastic_ip_association isn't adopted
+	// into instance_id, where a config without it would disassociate the IP
+	assert.True(t, data.InstanceID.IsNull())
+	assert.Equal(t, "inst-1", data.AssociatedInstanceID.ValueString())
+	assert.Equal(t, "203.0.113.5", data.PublicIP.ValueString())
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// isAlreadyExists reports whether err is the API's name conflict error.
+func isAlreadyExists(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code != "" {
+		return strings.EqualFold(apiErr.Code, "ALREADY_EXISTS")
+	}
+	return apiErr.Status == http.StatusConflict
+}
+
+// isInUse reports whether err is the API's error for a resource that other resources
+// still depend on.
+func isInUse(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code != "" {
+		return strings.EqualFold(apiErr.Code, "RESOURCE_IN_USE")
+	}
+	return apiErr.Status == http.StatusConflict
+}
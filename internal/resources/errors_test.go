@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAlreadyExists(t *testing.T) {
+	assert.True(t, isAlreadyExists(&client.APIError{Status: http.StatusConflict, Code: "ALREADY_EXISTS"}))
+	assert.True(t, isAlreadyExists(&client.APIError{Status: http.StatusBadRequest, Code: "already_exists"}))
+	assert.True(t, isAlreadyExists(&client.APIError{Status: http.StatusConflict}))
+	assert.False(t, isAlreadyExists(&client.APIError{Status: http.StatusConflict, Code: "RESOURCE_IN_USE"}))
+	assert.False(t, isAlreadyExists(errors.New("conflict")))
+	assert.False(t, isAlreadyExists(nil))
+}
+
+func TestIsInUse(t *testing.T) {
+	assert.True(t, isInUse(&client.APIError{Status: http.StatusConflict, Code: "RESOURCE_IN_USE"}))
+	assert.True(t, isInUse(&client.APIError{Status: http.StatusConflict}))
+	assert.False(t, isInUse(&client.APIError{Status: http.StatusConflict, Code: "ALREADY_EXISTS"}))
+	assert.False(t, isInUse(errors.New("conflict")))
+	assert.False(t, isInUse(nil))
+}
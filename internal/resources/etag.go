@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// etagPrivateKey is the private state key holding the ETag of the version of the object
+// Terraform last read or wrote. Updates send it as If-Match, so the API rejects them when
+// the object was changed outside Terraform in the meantime.
+const etagPrivateKey = "etag"
+
+// privateState is the private state of a resource request or response.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setPrivateETag records etag in private state. An empty etag removes the recorded one,
+// so later updates are sent unconditionally.
+func setPrivateETag(ctx context.Context, private privateState, etag string) diag.Diagnostics {
+	if etag == "" {
+		return private.SetKey(ctx, etagPrivateKey, nil)
+	}
+
+	raw, err := json.Marshal(etag)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to record ETag, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, etagPrivateKey, raw)
+}
+
+// privateETag returns the ETag recorded in private state, or "" when there is none.
+func privateETag(ctx context.Context, private privateState) (string, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, etagPrivateKey)
+	if len(raw) == 0 || diags.HasError() {
+		return "", diags
+	}
+
+	var etag string
+	if err := json.Unmarshal(raw, &etag); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode recorded ETag, got error: %s", err))
+		return "", diags
+	}
+
+	return etag, diags
+}
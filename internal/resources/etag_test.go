@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePrivateState is an in-memory private state.
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+	return nil
+}
+
+func TestPrivateETag(t *testing.T) {
+	ctx := context.Background()
+	private := fakePrivateState{}
+
+	etag, diags := privateETag(ctx, private)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, etag)
+
+	assert.False(t, setPrivateETag(ctx, private, `"v1"`).HasError())
+	assert.JSONEq(t, `"\"v1\""`, string(private[etagPrivateKey]))
+
+	etag, diags = privateETag(ctx, private)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, `"v1"`, etag)
+
+	// An object read without an ETag drops the recorded one
+	assert.False(t, setPrivateETag(ctx, private, "").HasError())
+	assert.NotContains(t, private, etagPrivateKey)
+
+	private[etagPrivateKey] = []byte("42")
+	_, diags = privateETag(ctx, private)
+	assert.True(t, diags.HasError())
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// fileSHA256 is the hex SHA-256 of a file's contents. The file is streamed, so large
+// files are never held in memory.
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close() // nolint:errcheck
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// bytesSHA256 is the hex SHA-256 of data, matching fileSHA256 for a file with those contents.
+func bytesSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+var _ planmodifier.String = fileHashFromPlan{}
+
+// fileHashFromPlan plans a hash attribute as the hash of the file named by another
+// attribute, so uploads happen only when the file's contents change, not its name.
+type fileHashFromPlan struct {
+	filename path.Path
+}
+
+func (m fileHashFromPlan) Description(ctx context.Context) string {
+	return "The hash of the planned file contents."
+}
+
+func (m fileHashFromPlan) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m fileHashFromPlan) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filename types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.filename, &filename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if filename.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	hash, err := fileSHA256(filename.ValueString())
+	if err != nil {
+		// The file may only be written during apply; it is read again then.
+		tflog.Debug(ctx, "unable to read file while planning", map[string]interface{}{"filename": filename.ValueString(), "error": err.Error()})
+		if req.StateValue.IsNull() {
+			resp.PlanValue = types.StringUnknown()
+		} else {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+
+	resp.PlanValue = types.StringValue(hash)
+}
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSHA256(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "image.qcow2")
+	assert.NoError(t, os.WriteFile(name, []byte("image-bytes"), 0o600))
+
+	hash, err := fileSHA256(name)
+	assert.NoError(t, err)
+	assert.Equal(t, bytesSHA256([]byte("image-bytes")), hash)
+
+	_, err = fileSHA256(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestFileHashFromPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewFunctionResource().(*FunctionResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	dir := t.TempDir()
+	original := filepath.Join(dir, "v1.zip")
+	renamed := filepath.Join(dir, "renamed.zip")
+	changed := filepath.Join(dir, "v2.zip")
+	assert.NoError(t, os.WriteFile(original, []byte("code-v1"), 0o600))
+	assert.NoError(t, os.WriteFile(renamed, []byte("code-v1"), 0o600))
+	assert.NoError(t, os.WriteFile(changed, []byte("code-v2"), 0o600))
+
+	plan := func(filename tftypes.Value) tfsdk.Plan {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+		}
+		values["filename"] = filename
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	deployed := types.StringValue(bytesSHA256([]byte("code-v1")))
+
+	modify := func(p tfsdk.Plan, state types.String) types.String {
+		resp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+		fileHashFromPlan{filename: path.Root("filename")}.PlanModifyString(ctx, planmodifier.StringRequest{Path: path.Root("source_code_hash"), Plan: p, StateValue: state}, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		return resp.PlanValue
+	}
+
+	// A renamed file with the same contents keeps the deployed hash
+	assert.Equal(t, deployed, modify(plan(tftypes.NewValue(tftypes.String, renamed)), deployed))
+	assert.Equal(t, types.StringValue(bytesSHA256([]byte("code-v2"))), modify(plan(tftypes.NewValue(tftypes.String, changed)), deployed))
+	assert.True(t, modify(plan(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)), deployed).IsUnknown())
+
+	// A missing file is read again during apply
+	missing := tftypes.NewValue(tftypes.String, filepath.Join(dir, "missing.zip"))
+	assert.Equal(t, deployed, modify(plan(missing), deployed))
+	assert.True(t, modify(plan(missing), types.StringNull()).IsUnknown())
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -30,13 +31,15 @@ type FunctionResource struct {
 
 // FunctionResourceModel describes the resource data model.
 type FunctionResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Runtime   types.String `tfsdk:"runtime"`
-	Handler   types.String `tfsdk:"handler"`
-	Filename  types.String `tfsdk:"filename"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Runtime        types.String `tfsdk:"runtime"`
+	Handler        types.String `tfsdk:"handler"`
+	Filename       types.String `tfsdk:"filename"`
+	SourceCodeHash types.String `tfsdk:"source_code_hash"`
+	Status         types.String `tfsdk:"status"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Version        types.Int64  `tfsdk:"version"`
 }
 
 func (r *FunctionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,15 +67,22 @@ func (r *FunctionResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"runtime": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The runtime of the function (e.g., python3.9, go1.21).",
+				MarkdownDescription: "The runtime of the function (e.g., python3.9, go1.21). Can be changed in place.",
 			},
 			"handler": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The entry point of the function.",
+				MarkdownDescription: "The entry point of the function. Can be changed in place.",
 			},
 			"filename": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The path to the zip file containing the function code.",
+				MarkdownDescription: "The path to the zip file containing the function code. The code is redeployed when the file's contents change.",
+			},
+			"source_code_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the deployed zip file, used to detect code changes.",
+				PlanModifiers: []planmodifier.String{
+					fileHashFromPlan{filename: path.Root("filename")},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -81,6 +91,16 @@ func (r *FunctionResource) Schema(ctx context.Context, req resource.SchemaReques
 			"created_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the function was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the deployed code. It is incremented each time new code is deployed and can be routed to with `thecloud_function_alias`.",
+				PlanModifiers: []planmodifier.Int64{
+					versionUnchangedWithCode{filename: path.Root("filename"), hash: path.Root("source_code_hash")},
+				},
 			},
 		},
 	}
@@ -120,21 +140,17 @@ func (r *FunctionResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	function, err := r.client.CreateFunction(
-		ctx,
-		data.Name.ValueString(),
-		data.Runtime.ValueString(),
-		data.Handler.ValueString(),
-		code,
-	)
+	function, err := r.createOrResume(ctx, data, code)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Function, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Function", err)
 		return
 	}
 
 	data.ID = types.StringValue(function.ID)
+	data.SourceCodeHash = types.StringValue(bytesSHA256(code))
 	data.Status = types.StringValue(function.Status)
 	data.CreatedAt = types.StringValue(function.CreatedAt.String())
+	data.Version = types.Int64Value(int64(function.Version))
 
 	tflog.Trace(ctx, "created a Function resource")
 
@@ -152,7 +168,7 @@ func (r *FunctionResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	function, err := r.client.GetFunction(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Function, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Function", err)
 		return
 	}
 
@@ -167,13 +183,59 @@ func (r *FunctionResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.Handler = types.StringValue(function.Handler)
 	data.Status = types.StringValue(function.Status)
 	data.CreatedAt = types.StringValue(function.CreatedAt.String())
+	data.Version = types.Int64Value(int64(function.Version))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// API might handle updates by re-creating or a separate endpoint.
-	// For now, we'll mark fields as RequiresReplace where appropriate or let it be no-op.
+	var plan, state FunctionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var function *client.Function
+
+	// Only new contents are redeployed; moving or renaming the file is not a change.
+	if !plan.SourceCodeHash.Equal(state.SourceCodeHash) {
+		code, err := os.ReadFile(plan.Filename.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read code file %s, got error: %s", plan.Filename.ValueString(), err))
+			return
+		}
+
+		function, err = r.client.UpdateFunctionCode(ctx, plan.ID.ValueString(), code)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Function code", err)
+			return
+		}
+		plan.SourceCodeHash = types.StringValue(bytesSHA256(code))
+	}
+
+	if !plan.Runtime.Equal(state.Runtime) || !plan.Handler.Equal(state.Handler) {
+		var err error
+		function, err = r.client.UpdateFunction(ctx, plan.ID.ValueString(), plan.Runtime.ValueString(), plan.Handler.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Function", err)
+			return
+		}
+	}
+
+	if function != nil {
+		plan.Status = types.StringValue(function.Status)
+		plan.Version = types.Int64Value(int64(function.Version))
+	} else {
+		plan.Status = state.Status
+		plan.Version = state.Version
+	}
+
+	tflog.Trace(ctx, "updated a Function resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *FunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -187,11 +249,88 @@ func (r *FunctionResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	err := r.client.DeleteFunction(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Function, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Function", err)
 		return
 	}
 }
 
 func (r *FunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrName(ctx, req, resp, "Function", r.client.ListFunctions, func(fn client.Function) (string, string) {
+		return fn.ID, fn.Name
+	})
+}
+
+// createOrResume creates the function with its code. The code is sent with the create
+// request, so a request that fails after the API has registered the function leaves one
+// without code behind. When the name is taken by such a function, its code is deployed
+// instead of failing on the conflict.
+func (r *FunctionResource) createOrResume(ctx context.Context, data FunctionResourceModel, code []byte) (*client.Function, error) {
+	name := data.Name.ValueString()
+
+	function, err := r.client.CreateFunction(ctx, name, data.Runtime.ValueString(), data.Handler.ValueString(), code)
+	if err == nil || !isAlreadyExists(err) {
+		return function, err
+	}
+
+	functions, listErr := r.client.ListFunctions(ctx, client.ListFilter{Name: name})
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	for _, existing := range functions {
+		if existing.Name != name || existing.CodePath != "" {
+			continue
+		}
+
+		tflog.Info(ctx, "deploying code to registered function", map[string]interface{}{"id": existing.ID, "name": name})
+
+		function, err := r.client.UpdateFunctionCode(ctx, existing.ID, code)
+		if err != nil {
+			return nil, err
+		}
+		if function.Runtime == data.Runtime.ValueString() && function.Handler == data.Handler.ValueString() {
+			return function, nil
+		}
+		return r.client.UpdateFunction(ctx, existing.ID, data.Runtime.ValueString(), data.Handler.ValueString())
+	}
+
+	// The name belongs to a deployed function, so report the original error
+	return nil, err
+}
+
+var _ planmodifier.Int64 = versionUnchangedWithCode{}
+
+// versionUnchangedWithCode keeps the version from state unless the planned file contents
+// differ from the deployed hash, in which case the API assigns a new version on apply.
+type versionUnchangedWithCode struct {
+	filename path.Path
+	hash     path.Path
+}
+
+func (m versionUnchangedWithCode) Description(ctx context.Context) string {
+	return "The version only changes when new code is deployed."
+}
+
+func (m versionUnchangedWithCode) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m versionUnchangedWithCode) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filename, deployedHash types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.filename, &filename)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, m.hash, &deployedHash)...)
+
+	if resp.Diagnostics.HasError() || filename.IsUnknown() {
+		return
+	}
+
+	// Like source_code_hash, an unreadable file keeps the deployed code and version
+	hash, err := fileSHA256(filename.ValueString())
+	if err != nil || hash == deployedHash.ValueString() {
+		resp.PlanValue = req.StateValue
+	}
 }
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &FunctionAliasResource{}
+var _ resource.ResourceWithImportState = &FunctionAliasResource{}
+
+func NewFunctionAliasResource() resource.Resource {
+	return &FunctionAliasResource{}
+}
+
+// FunctionAliasResource defines the resource implementation.
+type FunctionAliasResource struct {
+	client *client.Client
+}
+
+// FunctionAliasResourceModel describes the resource data model.
+type FunctionAliasResourceModel struct {
+	ID         types.String `tfsdk:"id"` // Format: {function_id}:{name}
+	FunctionID types.String `tfsdk:"function_id"`
+	Name       types.String `tfsdk:"name"`
+	Routing    types.Map    `tfsdk:"routing"`
+	InvokeURL  types.String `tfsdk:"invoke_url"`
+}
+
+func (r *FunctionAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_alias"
+}
+
+func (r *FunctionAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Function Alias resource allows you to publish a named endpoint for a function and split its traffic between code versions, e.g. for canary releases.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composite ID of the alias (function_id:name).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the function.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the alias, unique within the function.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routing": schema.MapAttribute{
+				ElementType:         types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "The percentage of invocations each function version receives, keyed by version (see the function's `version` attribute). The weights must add up to `100`. Changing them shifts traffic in place.",
+				Validators: []validator.Map{
+					routingWeightsValidator{},
+				},
+			},
+			"invoke_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL that invokes the function through the alias. Use it as a gateway route's `target_url` to route to the alias instead of the latest code.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FunctionAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FunctionAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FunctionAliasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routing, diags := expandRouting(ctx, data.Routing)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.CreateFunctionAlias(ctx, data.FunctionID.ValueString(), data.Name.ValueString(), routing)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Function Alias", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.FunctionID.ValueString(), data.Name.ValueString()))
+	resp.Diagnostics.Append(data.setAlias(ctx, alias)...)
+
+	tflog.Trace(ctx, "created a Function Alias resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FunctionAliasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.GetFunctionAlias(ctx, data.FunctionID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Function Alias", err)
+		return
+	}
+
+	if alias == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setAlias(ctx, alias)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FunctionAliasResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routing, diags := expandRouting(ctx, data.Routing)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.UpdateFunctionAliasRouting(ctx, data.FunctionID.ValueString(), data.Name.ValueString(), routing)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Function Alias routing", err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setAlias(ctx, alias)...)
+
+	tflog.Trace(ctx, "updated a Function Alias resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FunctionAliasResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFunctionAlias(ctx, data.FunctionID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Function Alias", err)
+		return
+	}
+}
+
+func (r *FunctionAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import requires function_id:name
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: function_id:name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setAlias copies the API's view of an alias into the model. The invoke URL is kept
+// from the plan or state when the response leaves it out.
+func (m *FunctionAliasResourceModel) setAlias(ctx context.Context, alias *client.FunctionAlias) diag.Diagnostics {
+	if alias.InvokeURL != "" {
+		m.InvokeURL = types.StringValue(alias.InvokeURL)
+	} else if m.InvokeURL.IsUnknown() {
+		m.InvokeURL = types.StringNull()
+	}
+
+	routing := make(map[string]int64, len(alias.Routing))
+	for version, weight := range alias.Routing {
+		routing[version] = int64(weight)
+	}
+
+	var diags diag.Diagnostics
+	m.Routing, diags = types.MapValueFrom(ctx, types.Int64Type, routing)
+	return diags
+}
+
+// expandRouting converts the routing map for the API.
+func expandRouting(ctx context.Context, routing types.Map) (map[string]int, diag.Diagnostics) {
+	weights := map[string]int64{}
+	diags := routing.ElementsAs(ctx, &weights, false)
+
+	out := make(map[string]int, len(weights))
+	for version, weight := range weights {
+		out[version] = int(weight)
+	}
+	return out, diags
+}
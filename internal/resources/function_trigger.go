@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &FunctionTriggerResource{}
+var _ resource.ResourceWithImportState = &FunctionTriggerResource{}
+var _ resource.ResourceWithConfigValidators = &FunctionTriggerResource{}
+
+// functionTriggerTypes are the kinds of events that can invoke a function.
+var functionTriggerTypes = []string{"http", "queue"}
+
+func NewFunctionTriggerResource() resource.Resource {
+	return &FunctionTriggerResource{}
+}
+
+// FunctionTriggerResource defines the resource implementation.
+type FunctionTriggerResource struct {
+	client *client.Client
+}
+
+// FunctionTriggerResourceModel describes the resource data model.
+type FunctionTriggerResourceModel struct {
+	ID         types.String   `tfsdk:"id"` // Format: {function_id}:{trigger_id}
+	FunctionID types.String   `tfsdk:"function_id"`
+	TriggerID  types.String   `tfsdk:"trigger_id"`
+	Type       types.String   `tfsdk:"type"`
+	Path       types.String   `tfsdk:"path"`
+	Methods    []types.String `tfsdk:"methods"`
+	QueueID    types.String   `tfsdk:"queue_id"`
+	BatchSize  types.Int64    `tfsdk:"batch_size"`
+	URL        types.String   `tfsdk:"url"`
+}
+
+func (r *FunctionTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_trigger"
+}
+
+func (r *FunctionTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Function Trigger resource allows you to invoke a function from an HTTP route or a queue.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composite ID of the trigger (function_id:trigger_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the function to invoke.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the trigger within the function.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The kind of trigger (http or queue).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: functionTriggerTypes},
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The route path that invokes the function, such as `/orders`. Required for http triggers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"methods": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The HTTP methods accepted on the path. Only for http triggers. Defaults to all methods.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the queue whose messages invoke the function. Required for queue triggers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of messages passed to each invocation. Only for queue triggers.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL that invokes the function. Only set for http triggers.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FunctionTriggerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		typeSpecificAttributesValidator{
+			typeAttribute: path.Root("type"),
+			required: map[string][]path.Path{
+				"http":  {path.Root("path")},
+				"queue": {path.Root("queue_id")},
+			},
+			optional: map[string][]path.Path{
+				"http":  {path.Root("methods")},
+				"queue": {path.Root("batch_size")},
+			},
+		},
+	}
+}
+
+func (r *FunctionTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FunctionTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FunctionTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger := client.FunctionTrigger{
+		Type:      data.Type.ValueString(),
+		Path:      data.Path.ValueString(),
+		QueueID:   data.QueueID.ValueString(),
+		BatchSize: int(data.BatchSize.ValueInt64()),
+	}
+	for _, m := range data.Methods {
+		trigger.Methods = append(trigger.Methods, m.ValueString())
+	}
+
+	created, err := r.client.CreateFunctionTrigger(ctx, data.FunctionID.ValueString(), trigger)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Function Trigger", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.FunctionID.ValueString(), created.ID))
+	data.setTrigger(created)
+
+	tflog.Trace(ctx, "created a Function Trigger resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FunctionTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := r.client.GetFunctionTrigger(ctx, data.FunctionID.ValueString(), data.TriggerID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Function Trigger", err)
+		return
+	}
+
+	if trigger == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setTrigger(trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes: every configurable attribute forces replacement.
+func (r *FunctionTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FunctionTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FunctionTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFunctionTrigger(ctx, data.FunctionID.ValueString(), data.TriggerID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Function Trigger", err)
+		return
+	}
+}
+
+func (r *FunctionTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import requires function_id:trigger_id
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: function_id:trigger_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trigger_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setTrigger copies the API's view of a trigger into the model. Attributes that don't
+// apply to the trigger's type are null.
+func (m *FunctionTriggerResourceModel) setTrigger(trigger *client.FunctionTrigger) {
+	m.TriggerID = types.StringValue(trigger.ID)
+	m.Type = types.StringValue(trigger.Type)
+	m.Path = types.StringNull()
+	m.QueueID = types.StringNull()
+	m.URL = types.StringNull()
+
+	if trigger.Path != "" {
+		m.Path = types.StringValue(trigger.Path)
+	}
+	if trigger.QueueID != "" {
+		m.QueueID = types.StringValue(trigger.QueueID)
+	}
+	if trigger.URL != "" {
+		m.URL = types.StringValue(trigger.URL)
+	}
+
+	// The API fills in defaults for omitted settings; those stay null so they don't show
+	// up as changes.
+	if !m.BatchSize.IsNull() {
+		m.BatchSize = types.Int64Value(int64(trigger.BatchSize))
+	}
+	if m.Methods != nil {
+		m.Methods = flattenStrings(trigger.Methods)
+	}
+}
@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctionTriggerConfigValidation(t *testing.T) {
+	ctx := context.Background()
+	r := NewFunctionTriggerResource().(*FunctionTriggerResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	validate := func(config map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, req, resp)
+		}
+		return resp.Diagnostics.HasError()
+	}
+
+	methods := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{str("GET")})
+
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("http"), "path": str("/orders"), "methods": methods}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("queue"), "queue_id": str("q-1"), "batch_size": tftypes.NewValue(tftypes.Number, 10)}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": str("queue"), "queue_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}))
+	assert.False(t, validate(map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, tftypes.UnknownValue), "path": str("/orders")}))
+
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("http")}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("queue"), "queue_id": str("q-1"), "methods": methods}))
+	assert.True(t, validate(map[string]tftypes.Value{"type": str("http"), "path": str("/orders"), "queue_id": str("q-1")}))
+}
@@ -7,16 +7,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &GatewayRouteResource{}
 var _ resource.ResourceWithImportState = &GatewayRouteResource{}
+var _ resource.ResourceWithValidateConfig = &GatewayRouteResource{}
+var _ resource.ResourceWithModifyPlan = &GatewayRouteResource{}
+
+// httpMethods are the HTTP methods a route can match.
+var httpMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
 
 func NewGatewayRouteResource() resource.Resource {
 	return &GatewayRouteResource{}
@@ -33,10 +42,15 @@ type GatewayRouteResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	PathPrefix  types.String `tfsdk:"path_prefix"`
 	TargetURL   types.String `tfsdk:"target_url"`
-	Methods     types.List   `tfsdk:"methods"`
+	Methods     types.Set    `tfsdk:"methods"`
 	StripPrefix types.Bool   `tfsdk:"strip_prefix"`
 	RateLimit   types.Int64  `tfsdk:"rate_limit"`
 	Priority    types.Int64  `tfsdk:"priority"`
+	Position    types.Int64  `tfsdk:"position"`
+
+	AuthRequired     types.Bool `tfsdk:"auth_required"`
+	AllowedAPIKeyIDs types.Set  `tfsdk:"allowed_api_key_ids"`
+	RequestHeaders   types.Map  `tfsdk:"request_headers"`
 }
 
 func (r *GatewayRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,7 +71,10 @@ func (r *GatewayRouteResource) Schema(ctx context.Context, req resource.SchemaRe
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the route.",
+				MarkdownDescription: "The name of the route. Changing this forces a new route to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"path_prefix": schema.StringAttribute{
 				Required:            true,
@@ -67,25 +84,60 @@ func (r *GatewayRouteResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 				MarkdownDescription: "The destination URL to proxy to.",
 			},
-			"methods": schema.ListAttribute{
+			"methods": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				MarkdownDescription: "HTTP methods to match (e.g., GET, POST).",
+				MarkdownDescription: "HTTP methods to match (GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS). Defaults to all methods.",
+				Validators: []validator.Set{
+					stringSetOneOfValidator{values: httpMethods},
+				},
 			},
 			"strip_prefix": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Whether to strip the path prefix before forwarding.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"rate_limit": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Maximum requests per second per IP.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"priority": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Priority for route matching.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"position": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The route's place in the order the gateway evaluates routes, starting at `1`. Routes earlier in the order take precedence.",
+			},
+			"auth_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether requests must present an API key.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_api_key_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the `thecloud_api_key`s accepted by the route. Defaults to any key. Requires `auth_required`.",
+			},
+			"request_headers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Headers added to requests before they're forwarded to `target_url`, e.g. to authenticate with the target. Sensitive, as they usually carry credentials.",
 			},
 		},
 	}
@@ -110,6 +162,59 @@ func (r *GatewayRouteResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *GatewayRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GatewayRouteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys can't be checked on a route that doesn't ask for one
+	if !data.AllowedAPIKeyIDs.IsNull() && !data.AuthRequired.IsNull() && !data.AuthRequired.IsUnknown() && !data.AuthRequired.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_api_key_ids"),
+			"Invalid Attribute Combination",
+			"Attribute \"allowed_api_key_ids\" can only be specified when \"auth_required\" is true.",
+		)
+	}
+}
+
+func (r *GatewayRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// An explicit priority settles the order, so overlaps are intended
+	var priority types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority"), &priority)...)
+
+	var id, pathPrefix types.String
+	var methods types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("path_prefix"), &pathPrefix)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("methods"), &methods)...)
+
+	if resp.Diagnostics.HasError() || !priority.IsNull() {
+		return
+	}
+
+	// An existing route was already checked when its path or methods last changed
+	if !req.State.Raw.IsNull() {
+		var statePathPrefix types.String
+		var stateMethods types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("path_prefix"), &statePathPrefix)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("methods"), &stateMethods)...)
+		if statePathPrefix.Equal(pathPrefix) && stateMethods.Equal(methods) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(warnOverlappingRoutes(ctx, r.client, id, pathPrefix, methods)...)
+}
+
 func (r *GatewayRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data GatewayRouteResourceModel
 
@@ -119,24 +224,38 @@ func (r *GatewayRouteResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	var methods []string
+	var methods, keyIDs []string
 	if !data.Methods.IsNull() {
 		resp.Diagnostics.Append(data.Methods.ElementsAs(ctx, &methods, false)...)
 	}
+	if !data.AllowedAPIKeyIDs.IsNull() {
+		resp.Diagnostics.Append(data.AllowedAPIKeyIDs.ElementsAs(ctx, &keyIDs, false)...)
+	}
+	var headers map[string]string
+	if !data.RequestHeaders.IsNull() {
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &headers, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	routeReq := client.CreateRouteRequest{
-		Name:        data.Name.ValueString(),
-		PathPrefix:  data.PathPrefix.ValueString(),
-		TargetURL:   data.TargetURL.ValueString(),
-		Methods:     methods,
-		StripPrefix: data.StripPrefix.ValueBool(),
-		RateLimit:   int(data.RateLimit.ValueInt64()),
-		Priority:    int(data.Priority.ValueInt64()),
+		Name:             data.Name.ValueString(),
+		PathPrefix:       data.PathPrefix.ValueString(),
+		TargetURL:        data.TargetURL.ValueString(),
+		Methods:          methods,
+		StripPrefix:      data.StripPrefix.ValueBool(),
+		RateLimit:        int(data.RateLimit.ValueInt64()),
+		Priority:         int(data.Priority.ValueInt64()),
+		AuthRequired:     data.AuthRequired.ValueBool(),
+		AllowedAPIKeyIDs: keyIDs,
+		RequestHeaders:   headers,
 	}
 
 	route, err := r.client.CreateGatewayRoute(ctx, routeReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Gateway Route, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Gateway Route", err)
 		return
 	}
 
@@ -144,6 +263,10 @@ func (r *GatewayRouteResource) Create(ctx context.Context, req resource.CreateRe
 	data.StripPrefix = types.BoolValue(route.StripPrefix)
 	data.RateLimit = types.Int64Value(int64(route.RateLimit))
 	data.Priority = types.Int64Value(int64(route.Priority))
+	data.Position = types.Int64Value(int64(route.Position))
+	data.AuthRequired = types.BoolValue(route.AuthRequired)
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, route.ETag)...)
 
 	tflog.Trace(ctx, "created a Gateway Route resource")
 
@@ -161,7 +284,7 @@ func (r *GatewayRouteResource) Read(ctx context.Context, req resource.ReadReques
 
 	route, err := r.client.GetGatewayRoute(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Gateway Route, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Gateway Route", err)
 		return
 	}
 
@@ -177,16 +300,83 @@ func (r *GatewayRouteResource) Read(ctx context.Context, req resource.ReadReques
 	data.StripPrefix = types.BoolValue(route.StripPrefix)
 	data.RateLimit = types.Int64Value(int64(route.RateLimit))
 	data.Priority = types.Int64Value(int64(route.Priority))
+	data.Position = types.Int64Value(int64(route.Position))
 
-	methods, diags := types.ListValueFrom(ctx, types.StringType, route.Methods)
-	resp.Diagnostics.Append(diags...)
-	data.Methods = methods
+	// No methods means all of them, which is how an unset attribute reads back
+	if len(route.Methods) > 0 || !data.Methods.IsNull() {
+		methods, diags := types.SetValueFrom(ctx, types.StringType, route.Methods)
+		resp.Diagnostics.Append(diags...)
+		data.Methods = methods
+	}
 
+	data.AuthRequired = types.BoolValue(route.AuthRequired)
+	if len(route.AllowedAPIKeyIDs) > 0 || !data.AllowedAPIKeyIDs.IsNull() {
+		keyIDs, diags := types.SetValueFrom(ctx, types.StringType, route.AllowedAPIKeyIDs)
+		resp.Diagnostics.Append(diags...)
+		data.AllowedAPIKeyIDs = keyIDs
+	}
+	if len(route.RequestHeaders) > 0 || !data.RequestHeaders.IsNull() {
+		headers, diags := types.MapValueFrom(ctx, types.StringType, route.RequestHeaders)
+		resp.Diagnostics.Append(diags...)
+		data.RequestHeaders = headers
+	}
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, route.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GatewayRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// API might not support updates, handled by RequiresReplace if needed or no-op
+	var data GatewayRouteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route := client.GatewayRoute{
+		ID:           data.ID.ValueString(),
+		Name:         data.Name.ValueString(),
+		PathPrefix:   data.PathPrefix.ValueString(),
+		TargetURL:    data.TargetURL.ValueString(),
+		StripPrefix:  data.StripPrefix.ValueBool(),
+		RateLimit:    int(data.RateLimit.ValueInt64()),
+		Priority:     int(data.Priority.ValueInt64()),
+		AuthRequired: data.AuthRequired.ValueBool(),
+	}
+	if !data.Methods.IsNull() {
+		resp.Diagnostics.Append(data.Methods.ElementsAs(ctx, &route.Methods, false)...)
+	}
+	if !data.AllowedAPIKeyIDs.IsNull() {
+		resp.Diagnostics.Append(data.AllowedAPIKeyIDs.ElementsAs(ctx, &route.AllowedAPIKeyIDs, false)...)
+	}
+	if !data.RequestHeaders.IsNull() {
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &route.RequestHeaders, false)...)
+	}
+
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	route.ETag = etag
+
+	res, err := r.client.UpdateGatewayRoute(ctx, data.ID.ValueString(), route)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Gateway Route", err)
+		return
+	}
+
+	data.StripPrefix = types.BoolValue(res.StripPrefix)
+	data.RateLimit = types.Int64Value(int64(res.RateLimit))
+	data.Priority = types.Int64Value(int64(res.Priority))
+	data.Position = types.Int64Value(int64(res.Position))
+	data.AuthRequired = types.BoolValue(res.AuthRequired)
+
+	tflog.Trace(ctx, "updated a Gateway Route resource")
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, res.ETag)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *GatewayRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -200,7 +390,7 @@ func (r *GatewayRouteResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	err := r.client.DeleteGatewayRoute(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Gateway Route, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Gateway Route", err)
 		return
 	}
 }
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// warnOverlappingRoutes warns about existing routes other than id that match the same
+// path prefix and at least one of the same methods. Without a priority, which of them
+// handles a request depends on the order the gateway happens to evaluate them in. The
+// check is skipped when the routes can't be listed.
+func warnOverlappingRoutes(ctx context.Context, c *client.Client, id, pathPrefix types.String, methods types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil || pathPrefix.IsNull() || pathPrefix.IsUnknown() || methods.IsUnknown() {
+		return diags
+	}
+
+	var planned []string
+	diags.Append(methods.ElementsAs(ctx, &planned, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	routes, err := c.ListGatewayRoutes(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "skipping gateway route overlap check, routes unavailable", map[string]interface{}{"error": err.Error()})
+		return diags
+	}
+
+	for _, route := range routes {
+		if route.ID == id.ValueString() || route.PathPrefix != pathPrefix.ValueString() || !methodsOverlap(planned, route.Methods) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root("path_prefix"),
+			"Overlapping Gateway Route",
+			fmt.Sprintf("Gateway route %q (%s) already matches path prefix %q for %s, so one of the routes will shadow the other. "+
+				"Set priority to choose which route handles the overlapping requests.", route.Name, route.ID, route.PathPrefix, describeMethods(route.Methods)),
+		)
+	}
+
+	return diags
+}
+
+// methodsOverlap reports whether two routes' methods share a method. No methods means
+// every method.
+func methodsOverlap(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return true
+	}
+	for _, method := range a {
+		if slices.ContainsFunc(b, func(other string) bool { return strings.EqualFold(method, other) }) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeMethods(methods []string) string {
+	if len(methods) == 0 {
+		return "all methods"
+	}
+	return strings.Join(methods, ", ")
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethodsOverlap(t *testing.T) {
+	assert.True(t, methodsOverlap(nil, []string{"GET"}))
+	assert.True(t, methodsOverlap([]string{"POST"}, nil))
+	assert.True(t, methodsOverlap([]string{"GET", "POST"}, []string{"post"}))
+	assert.False(t, methodsOverlap([]string{"GET"}, []string{"POST", "PUT"}))
+}
+
+func TestWarnOverlappingRoutes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gateway/routes", r.URL.Path)
+		raw, err := json.Marshal([]client.GatewayRoute{
+			{ID: "rt-1", Name: "orders-read", PathPrefix: "/orders", Methods: []string{"GET"}},
+			{ID: "rt-2", Name: "users", PathPrefix: "/users"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	methods := func(values ...string) types.Set {
+		elements := []attr.Value{}
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	diags := warnOverlappingRoutes(ctx, c, types.StringUnknown(), types.StringValue("/orders"), types.SetNull(types.StringType))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "Overlapping Gateway Route", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), `"orders-read" (rt-1)`)
+		assert.False(t, diags.HasError())
+	}
+
+	assert.Empty(t, warnOverlappingRoutes(ctx, c, types.StringUnknown(), types.StringValue("/orders"), methods("POST")))
+	assert.Empty(t, warnOverlappingRoutes(ctx, c, types.StringValue("rt-1"), types.StringValue("/orders"), methods("GET")))
+	assert.Empty(t, warnOverlappingRoutes(ctx, c, types.StringUnknown(), types.StringUnknown(), methods("GET")))
+	assert.Len(t, warnOverlappingRoutes(ctx, c, types.StringUnknown(), types.StringValue("/users"), methods("DELETE")), 1)
+}
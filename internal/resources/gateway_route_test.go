@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayRouteValidateConfigAuth(t *testing.T) {
+	ctx := context.Background()
+	r := NewGatewayRouteResource().(*GatewayRouteResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(config map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	keys := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "key-1")})
+
+	assert.False(t, validate(nil))
+	assert.False(t, validate(map[string]tftypes.Value{"auth_required": tftypes.NewValue(tftypes.Bool, true), "allowed_api_key_ids": keys}))
+	assert.False(t, validate(map[string]tftypes.Value{"auth_required": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), "allowed_api_key_ids": keys}))
+	assert.True(t, validate(map[string]tftypes.Value{"auth_required": tftypes.NewValue(tftypes.Bool, false), "allowed_api_key_ids": keys}))
+}
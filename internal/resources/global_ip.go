@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &GlobalIPResource{}
+var _ resource.ResourceWithImportState = &GlobalIPResource{}
+
+func NewGlobalIPResource() resource.Resource {
+	return &GlobalIPResource{}
+}
+
+// GlobalIPResource defines the resource implementation.
+type GlobalIPResource struct {
+	client *client.Client
+}
+
+// GlobalIPResourceModel describes the resource data model.
+type GlobalIPResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Address     types.String `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (r *GlobalIPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_ip"
+}
+
+func (r *GlobalIPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Global IP resource allows you to allocate a stable anycast IP address and bind it to a global load balancer with `global_ip_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the global IP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The allocated anycast IP address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A description of the global IP. Can be changed in place.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the global IP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GlobalIPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GlobalIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GlobalIPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.client.AllocateGlobalIP(ctx, data.Description.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to allocate Global IP", err)
+		return
+	}
+
+	data.ID = types.StringValue(ip.ID)
+	data.Address = types.StringValue(ip.Address)
+	data.Status = types.StringValue(ip.Status)
+
+	tflog.Trace(ctx, "created a Global IP resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GlobalIPResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.client.GetGlobalIP(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Global IP", err)
+		return
+	}
+
+	if ip == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(ip.ID)
+	data.Address = types.StringValue(ip.Address)
+	if !data.Description.IsNull() || ip.Description != "" {
+		data.Description = types.StringValue(ip.Description)
+	}
+	data.Status = types.StringValue(ip.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GlobalIPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.client.UpdateGlobalIPDescription(ctx, data.ID.ValueString(), data.Description.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Global IP", err)
+		return
+	}
+
+	data.Status = types.StringValue(ip.Status)
+
+	tflog.Trace(ctx, "updated a Global IP resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GlobalIPResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ReleaseGlobalIP(ctx, data.ID.ValueString())
+	if err == nil {
+		return
+	}
+
+	if isInUse(err) {
+		if diags, ok := r.inUseError(ctx, data.ID.ValueString()); ok {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	apierror.AddClientError(&resp.Diagnostics, "Unable to release Global IP", err)
+}
+
+func (r *GlobalIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// inUseError explains a rejected release by naming the Global LB the IP is bound to.
+// It reports false when the binding can't be looked up, so the API error is shown
+// instead.
+func (r *GlobalIPResource) inUseError(ctx context.Context, id string) (diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+
+	ip, err := r.client.GetGlobalIP(ctx, id)
+	if err != nil || ip == nil || ip.GlobalLBID == "" {
+		return diags, false
+	}
+
+	glb, err := r.client.GetGlobalLB(ctx, ip.GlobalLBID)
+	if err != nil || glb == nil {
+		return diags, false
+	}
+
+	diags.AddError(
+		"Global IP In Use",
+		fmt.Sprintf("Global IP %s (%s) is still bound to Global LB %q (%s) and can't be released. "+
+			"Remove global_ip_id from the Global LB, or destroy it, before releasing the address.", id, ip.Address, glb.Name, glb.ID),
+	)
+	return diags, true
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobalIPDeleteInUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/global-ips/gip-1":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"type":"conflict","message":"global ip is bound","code":"RESOURCE_IN_USE"}}`))
+			return
+		case r.Method == http.MethodGet && r.URL.Path == "/global-ips/gip-1":
+			data = client.GlobalIP{ID: "gip-1", Address: "198.51.100.7", GlobalLBID: "glb-1", Status: "bound"}
+		case r.Method == http.MethodGet && r.URL.Path == "/global-lb/glb-1":
+			data = client.GlobalLB{ID: "glb-1", Name: "storefront", Status: "active"}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		raw, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	resp := deleteFromState(t, NewGlobalIPResource(), server.URL, "gip-1")
+
+	if assert.Len(t, resp.Diagnostics, 1) {
+		assert.Equal(t, "Global IP In Use", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), `Global LB "storefront" (glb-1)`)
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "198.51.100.7")
+	}
+}
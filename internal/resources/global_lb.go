@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -18,6 +20,9 @@ import (
 var _ resource.Resource = &GlobalLBResource{}
 var _ resource.ResourceWithImportState = &GlobalLBResource{}
 
+// globalLBPolicies are the routing policies the API supports.
+var globalLBPolicies = []string{"LATENCY", "GEOLOCATION", "WEIGHTED", "FAILOVER"}
+
 func NewGlobalLBResource() resource.Resource {
 	return &GlobalLBResource{}
 }
@@ -35,6 +40,7 @@ type GlobalLBResourceModel struct {
 	Policy      types.String           `tfsdk:"policy"`
 	Status      types.String           `tfsdk:"status"`
 	HealthCheck GlobalHealthCheckModel `tfsdk:"health_check"`
+	GlobalIPID  types.String           `tfsdk:"global_ip_id"`
 }
 
 type GlobalHealthCheckModel struct {
@@ -65,19 +71,35 @@ func (r *GlobalLBResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the GLB.",
+				MarkdownDescription: "The name of the GLB. Changing this forces a new GLB to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"hostname": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The hostname for the GLB.",
+				MarkdownDescription: "The hostname for the GLB. Changing this forces a new GLB to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"policy": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The routing policy (LATENCY, GEOLOCATION, WEIGHTED, FAILOVER).",
+				Validators: []validator.String{
+					stringOneOfValidator{values: globalLBPolicies, ignoreCase: true},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the GLB.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"global_ip_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of a `thecloud_global_ip` to bind to the GLB's hostname, for clients that need a stable IP instead of a CNAME. Can be changed or removed in place.",
 			},
 			"health_check": schema.SingleNestedAttribute{
 				Required: true,
@@ -87,6 +109,9 @@ func (r *GlobalLBResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 					"port": schema.Int64Attribute{
 						Required: true,
+						Validators: []validator.Int64{
+							portValidator,
+						},
 					},
 					"path": schema.StringAttribute{
 						Optional: true,
@@ -138,23 +163,16 @@ func (r *GlobalLBResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	glbReq := client.CreateGlobalLBRequest{
-		Name:     data.Name.ValueString(),
-		Hostname: data.Hostname.ValueString(),
-		Policy:   data.Policy.ValueString(),
-		HealthCheck: client.GlobalHealthCheck{
-			Protocol:       data.HealthCheck.Protocol.ValueString(),
-			Port:           int(data.HealthCheck.Port.ValueInt64()),
-			Path:           data.HealthCheck.Path.ValueString(),
-			IntervalSec:    int(data.HealthCheck.IntervalSec.ValueInt64()),
-			TimeoutSec:     int(data.HealthCheck.TimeoutSec.ValueInt64()),
-			HealthyCount:   int(data.HealthCheck.HealthyCount.ValueInt64()),
-			UnhealthyCount: int(data.HealthCheck.UnhealthyCount.ValueInt64()),
-		},
+		Name:        data.Name.ValueString(),
+		Hostname:    data.Hostname.ValueString(),
+		Policy:      data.Policy.ValueString(),
+		HealthCheck: data.HealthCheck.expand(),
+		GlobalIPID:  data.GlobalIPID.ValueString(),
 	}
 
 	glb, err := r.client.CreateGlobalLB(ctx, glbReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Global LB, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Global LB", err)
 		return
 	}
 
@@ -177,7 +195,7 @@ func (r *GlobalLBResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	glb, err := r.client.GetGlobalLB(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Global LB, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Global LB", err)
 		return
 	}
 
@@ -189,8 +207,11 @@ func (r *GlobalLBResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.ID = types.StringValue(glb.ID)
 	data.Name = types.StringValue(glb.Name)
 	data.Hostname = types.StringValue(glb.Hostname)
-	data.Policy = types.StringValue(glb.Policy)
+	data.Policy = stringValueIgnoringCase(data.Policy, glb.Policy)
 	data.Status = types.StringValue(glb.Status)
+	if !data.GlobalIPID.IsNull() || glb.GlobalIPID != "" {
+		data.GlobalIPID = types.StringValue(glb.GlobalIPID)
+	}
 	data.HealthCheck = GlobalHealthCheckModel{
 		Protocol:       types.StringValue(glb.HealthCheck.Protocol),
 		Port:           types.Int64Value(int64(glb.HealthCheck.Port)),
@@ -205,7 +226,63 @@ func (r *GlobalLBResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *GlobalLBResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not fully supported by API yet
+	var plan, state GlobalLBResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.update(ctx, plan, state)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Global LB", err)
+	}
+
+	// Save whatever was applied, even on failure, so the next plan retries only the rest
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// update applies the health check, the routing policy and the global IP binding, each in
+// its own request.
+// It returns state with the changes that were applied, which is less than plan when a
+// request fails.
+func (r *GlobalLBResource) update(ctx context.Context, plan, state GlobalLBResourceModel) (GlobalLBResourceModel, error) {
+	if plan.HealthCheck != state.HealthCheck {
+		healthCheck := plan.HealthCheck.expand()
+		glb, err := r.client.UpdateGlobalLB(ctx, state.ID.ValueString(), client.UpdateGlobalLBRequest{HealthCheck: &healthCheck})
+		if err != nil {
+			return state, err
+		}
+		state.HealthCheck = plan.HealthCheck
+		state.Status = types.StringValue(glb.Status)
+	}
+
+	if !plan.Policy.Equal(state.Policy) {
+		policy := plan.Policy.ValueString()
+		glb, err := r.client.UpdateGlobalLB(ctx, state.ID.ValueString(), client.UpdateGlobalLBRequest{Policy: &policy})
+		if err != nil {
+			return state, err
+		}
+		state.Policy = plan.Policy
+		state.Status = types.StringValue(glb.Status)
+	}
+
+	// Removing global_ip_id unbinds the IP, which is sent as an empty ID
+	if !plan.GlobalIPID.Equal(state.GlobalIPID) {
+		globalIPID := plan.GlobalIPID.ValueString()
+		glb, err := r.client.UpdateGlobalLB(ctx, state.ID.ValueString(), client.UpdateGlobalLBRequest{GlobalIPID: &globalIPID})
+		if err != nil {
+			return state, err
+		}
+		state.GlobalIPID = plan.GlobalIPID
+		state.Status = types.StringValue(glb.Status)
+	}
+
+	tflog.Trace(ctx, "updated a Global LB resource")
+
+	return state, nil
 }
 
 func (r *GlobalLBResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -219,7 +296,7 @@ func (r *GlobalLBResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	err := r.client.DeleteGlobalLB(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Global LB, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Global LB", err)
 		return
 	}
 }
@@ -227,3 +304,15 @@ func (r *GlobalLBResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *GlobalLBResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (m GlobalHealthCheckModel) expand() client.GlobalHealthCheck {
+	return client.GlobalHealthCheck{
+		Protocol:       m.Protocol.ValueString(),
+		Port:           int(m.Port.ValueInt64()),
+		Path:           m.Path.ValueString(),
+		IntervalSec:    int(m.IntervalSec.ValueInt64()),
+		TimeoutSec:     int(m.TimeoutSec.ValueInt64()),
+		HealthyCount:   int(m.HealthyCount.ValueInt64()),
+		UnhealthyCount: int(m.UnhealthyCount.ValueInt64()),
+	}
+}
@@ -8,16 +8,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &GlobalLBEndpointResource{}
 var _ resource.ResourceWithImportState = &GlobalLBEndpointResource{}
+var _ resource.ResourceWithValidateConfig = &GlobalLBEndpointResource{}
+var _ resource.ResourceWithConfigValidators = &GlobalLBEndpointResource{}
+
+// globalLBEndpointTargetTypes are the kinds of targets a Global LB endpoint can point at.
+var globalLBEndpointTargetTypes = []string{"LB", "IP"}
 
 func NewGlobalLBEndpointResource() resource.Resource {
 	return &GlobalLBEndpointResource{}
@@ -38,6 +46,7 @@ type GlobalLBEndpointResourceModel struct {
 	TargetIP   types.String `tfsdk:"target_ip"`
 	Weight     types.Int64  `tfsdk:"weight"`
 	Priority   types.Int64  `tfsdk:"priority"`
+	Drain      types.Bool   `tfsdk:"drain"`
 	Healthy    types.Bool   `tfsdk:"healthy"`
 }
 
@@ -67,28 +76,53 @@ func (r *GlobalLBEndpointResource) Schema(ctx context.Context, req resource.Sche
 			"region": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The region of the target.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"target_type": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Target type (LB or IP).",
+				MarkdownDescription: "Target type (LB or IP), case-insensitive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: globalLBEndpointTargetTypes, ignoreCase: true},
+				},
 			},
 			"target_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the regional Load Balancer.",
+				MarkdownDescription: "The ID of the regional Load Balancer. Required when target_type is LB, and not allowed otherwise.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"target_ip": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Static IP if target_type is IP.",
+				MarkdownDescription: "The IP address of the target. Required when target_type is IP, and not allowed otherwise.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
 			},
 			"weight": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Traffic weight (1-100).",
+				Default:             int64default.StaticInt64(100),
+				MarkdownDescription: "Traffic weight (1-100). Defaults to `100`. Can be changed in place.",
 			},
 			"priority": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Failover priority.",
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Failover priority. Defaults to `1`. Can be changed in place.",
+			},
+			"drain": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, the endpoint's weight is set to 0 on the API so it receives no traffic, " +
+					"while `weight` keeps its configured value. Setting it back to `false` restores `weight`. Requires `weight`.",
 			},
 			"healthy": schema.BoolAttribute{
 				Computed:            true,
@@ -117,6 +151,38 @@ func (r *GlobalLBEndpointResource) Configure(ctx context.Context, req resource.C
 	r.client = client
 }
 
+func (r *GlobalLBEndpointResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		typeSpecificAttributesValidator{
+			typeAttribute: path.Root("target_type"),
+			required: map[string][]path.Path{
+				"LB": {path.Root("target_id")},
+				"IP": {path.Root("target_ip")},
+			},
+			ignoreCase: true,
+		},
+	}
+}
+
+func (r *GlobalLBEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GlobalLBEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a configured weight there'd be nothing to restore when undraining
+	if data.Drain.ValueBool() && data.Weight.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weight"),
+			"Missing Attribute Configuration",
+			"Attribute \"weight\" must be specified when \"drain\" is true, so the weight can be restored when the endpoint is undrained.",
+		)
+	}
+}
+
 func (r *GlobalLBEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data GlobalLBEndpointResourceModel
 
@@ -128,27 +194,22 @@ func (r *GlobalLBEndpointResource) Create(ctx context.Context, req resource.Crea
 
 	epReq := client.AddGlobalEndpointRequest{
 		Region:     data.Region.ValueString(),
-		TargetType: data.TargetType.ValueString(),
+		TargetType: strings.ToUpper(data.TargetType.ValueString()),
 		TargetID:   data.TargetID.ValueString(),
 		TargetIP:   data.TargetIP.ValueString(),
-		Weight:     int(data.Weight.ValueInt64()),
 		Priority:   int(data.Priority.ValueInt64()),
 	}
+	weight := data.apiWeight()
+	epReq.Weight = &weight
 
 	ep, err := r.client.AddGlobalEndpoint(ctx, data.GlobalLBID.ValueString(), epReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to add GLB Endpoint, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to add GLB Endpoint", err)
 		return
 	}
 
 	data.ID = types.StringValue(ep.ID)
 	data.Healthy = types.BoolValue(ep.Healthy)
-	if data.Weight.IsNull() {
-		data.Weight = types.Int64Value(int64(ep.Weight))
-	}
-	if data.Priority.IsNull() {
-		data.Priority = types.Int64Value(int64(ep.Priority))
-	}
 
 	tflog.Trace(ctx, "added a Global LB Endpoint")
 
@@ -166,7 +227,7 @@ func (r *GlobalLBEndpointResource) Read(ctx context.Context, req resource.ReadRe
 
 	glb, err := r.client.GetGlobalLB(ctx, data.GlobalLBID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Global LB for endpoint, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Global LB for endpoint", err)
 		return
 	}
 
@@ -176,9 +237,9 @@ func (r *GlobalLBEndpointResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	var found *client.GlobalEndpoint
-	for _, ep := range glb.Endpoints {
-		if ep.ID == data.ID.ValueString() {
-			found = &ep
+	for i := range glb.Endpoints {
+		if glb.Endpoints[i].ID == data.ID.ValueString() {
+			found = &glb.Endpoints[i]
 			break
 		}
 	}
@@ -189,10 +250,9 @@ func (r *GlobalLBEndpointResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	data.Region = types.StringValue(found.Region)
-	data.TargetType = types.StringValue(found.TargetType)
-	data.TargetID = types.StringValue(found.TargetID)
-	data.TargetIP = types.StringValue(found.TargetIP)
-	data.Weight = types.Int64Value(int64(found.Weight))
+	data.TargetType = stringValueIgnoringCase(data.TargetType, found.TargetType)
+	data.setTargets(found)
+	data.setWeight(found.Weight)
 	data.Priority = types.Int64Value(int64(found.Priority))
 	data.Healthy = types.BoolValue(found.Healthy)
 
@@ -200,7 +260,37 @@ func (r *GlobalLBEndpointResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *GlobalLBEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported, use RequiresReplace or API update if available
+	var plan, state GlobalLBEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var epReq client.UpdateGlobalEndpointRequest
+	if weight := plan.apiWeight(); weight != state.apiWeight() {
+		epReq.Weight = &weight
+	}
+	if !plan.Priority.Equal(state.Priority) {
+		priority := int(plan.Priority.ValueInt64())
+		epReq.Priority = &priority
+	}
+
+	plan.Healthy = state.Healthy
+	if epReq.Weight != nil || epReq.Priority != nil {
+		ep, err := r.client.UpdateGlobalEndpoint(ctx, plan.GlobalLBID.ValueString(), plan.ID.ValueString(), epReq)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update Global LB Endpoint", err)
+			return
+		}
+		plan.Healthy = types.BoolValue(ep.Healthy)
+	}
+
+	tflog.Trace(ctx, "updated a Global LB Endpoint")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *GlobalLBEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -214,7 +304,7 @@ func (r *GlobalLBEndpointResource) Delete(ctx context.Context, req resource.Dele
 
 	err := r.client.RemoveGlobalEndpoint(ctx, data.GlobalLBID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to remove Global LB Endpoint, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to remove Global LB Endpoint", err)
 		return
 	}
 }
@@ -233,3 +323,37 @@ func (r *GlobalLBEndpointResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("global_lb_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
 }
+
+// apiWeight returns the weight the endpoint should have on the API, which is 0 while
+// it's drained.
+func (m GlobalLBEndpointResourceModel) apiWeight() int {
+	if m.Drain.ValueBool() {
+		return 0
+	}
+	return int(m.Weight.ValueInt64())
+}
+
+// setTargets sets target_id and target_ip from the API. The API leaves out the target
+// that doesn't match the type, and an empty one keeps the value in state, so a target
+// that was never set stays null rather than being replaced on every plan.
+func (m *GlobalLBEndpointResourceModel) setTargets(ep *client.GlobalEndpoint) {
+	if ep.TargetID != "" {
+		m.TargetID = types.StringValue(ep.TargetID)
+	}
+	if ep.TargetIP != "" {
+		m.TargetIP = types.StringValue(ep.TargetIP)
+	}
+}
+
+// setWeight records the weight read from the API. A drained endpoint keeps its configured
+// weight, unless the API weight was changed from 0 elsewhere, in which case it's no
+// longer drained.
+func (m *GlobalLBEndpointResourceModel) setWeight(weight int) {
+	if m.Drain.ValueBool() {
+		if weight == 0 {
+			return
+		}
+		m.Drain = types.BoolValue(false)
+	}
+	m.Weight = types.Int64Value(int64(weight))
+}
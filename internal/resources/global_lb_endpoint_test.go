@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobalLBEndpointDrainWeight(t *testing.T) {
+	drained := GlobalLBEndpointResourceModel{Weight: types.Int64Value(50), Drain: types.BoolValue(true)}
+	assert.Equal(t, 0, drained.apiWeight())
+
+	// Reading the drained weight keeps the configured one
+	drained.setWeight(0)
+	assert.Equal(t, int64(50), drained.Weight.ValueInt64())
+	assert.True(t, drained.Drain.ValueBool())
+
+	// A weight set elsewhere means the endpoint is no longer drained
+	drained.setWeight(20)
+	assert.Equal(t, int64(20), drained.Weight.ValueInt64())
+	assert.False(t, drained.Drain.ValueBool())
+
+	active := GlobalLBEndpointResourceModel{Weight: types.Int64Value(50), Drain: types.BoolNull()}
+	assert.Equal(t, 50, active.apiWeight())
+
+	active.setWeight(0)
+	assert.Equal(t, int64(0), active.Weight.ValueInt64())
+	assert.True(t, active.Drain.IsNull())
+}
+
+func TestGlobalLBEndpointSetTargets(t *testing.T) {
+	lb := GlobalLBEndpointResourceModel{TargetID: types.StringValue("lb-1"), TargetIP: types.StringNull()}
+	lb.setTargets(&client.GlobalEndpoint{TargetType: "LB", TargetID: "lb-1"})
+	assert.Equal(t, "lb-1", lb.TargetID.ValueString())
+	assert.True(t, lb.TargetIP.IsNull())
+
+	// An empty target keeps the one in state
+	ip := GlobalLBEndpointResourceModel{TargetID: types.StringNull(), TargetIP: types.StringValue("203.0.113.10")}
+	ip.setTargets(&client.GlobalEndpoint{TargetType: "IP"})
+	assert.True(t, ip.TargetID.IsNull())
+	assert.Equal(t, "203.0.113.10", ip.TargetIP.ValueString())
+
+	// A target changed elsewhere is picked up
+	ip.setTargets(&client.GlobalEndpoint{TargetType: "IP", TargetIP: "203.0.113.20"})
+	assert.Equal(t, "203.0.113.20", ip.TargetIP.ValueString())
+}
+
+func TestGlobalLBEndpointConfigValidation(t *testing.T) {
+	ctx := context.Background()
+	r := NewGlobalLBEndpointResource().(*GlobalLBEndpointResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	validate := func(config map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range config {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, req, resp)
+		}
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(map[string]tftypes.Value{"target_type": str("LB"), "target_id": str("lb-1")}))
+	assert.False(t, validate(map[string]tftypes.Value{"target_type": str("ip"), "target_ip": str("203.0.113.10")}))
+	assert.False(t, validate(map[string]tftypes.Value{"target_type": str("lb"), "target_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}))
+
+	// Neither or both targets
+	assert.True(t, validate(map[string]tftypes.Value{"target_type": str("LB")}))
+	assert.True(t, validate(map[string]tftypes.Value{"target_type": str("IP")}))
+	assert.True(t, validate(map[string]tftypes.Value{"target_type": str("LB"), "target_id": str("lb-1"), "target_ip": str("203.0.113.10")}))
+	assert.True(t, validate(map[string]tftypes.Value{"target_type": str("ip"), "target_id": str("lb-1"), "target_ip": str("203.0.113.10")}))
+}
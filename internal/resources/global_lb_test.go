@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGlobalLBAPI accepts health check updates and rejects routing policy updates.
+type fakeGlobalLBAPI struct {
+	t      *testing.T
+	bodies []map[string]interface{}
+}
+
+func (f *fakeGlobalLBAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, http.MethodPatch, r.Method)
+	assert.Equal(f.t, "/global-lb/glb-1", r.URL.Path)
+
+	var body map[string]interface{}
+	assert.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+	f.bodies = append(f.bodies, body)
+
+	if _, ok := body["routing_policy"]; ok {
+		w.WriteHeader(http.StatusBadRequest)
+		assert.NoError(f.t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": map[string]string{"type": "invalid_input", "message": "unsupported routing policy"},
+		}))
+		return
+	}
+
+	raw, err := json.Marshal(client.GlobalLB{ID: "glb-1", Status: "updating"})
+	assert.NoError(f.t, err)
+	assert.NoError(f.t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+}
+
+func testGlobalLBModel(policy string, intervalSec int64) GlobalLBResourceModel {
+	return GlobalLBResourceModel{
+		ID:       types.StringValue("glb-1"),
+		Name:     types.StringValue("web"),
+		Hostname: types.StringValue("web.example.com"),
+		Policy:   types.StringValue(policy),
+		Status:   types.StringValue("active"),
+		HealthCheck: GlobalHealthCheckModel{
+			Protocol:       types.StringValue("HTTP"),
+			Port:           types.Int64Value(80),
+			Path:           types.StringValue("/health"),
+			IntervalSec:    types.Int64Value(intervalSec),
+			TimeoutSec:     types.Int64Value(5),
+			HealthyCount:   types.Int64Value(2),
+			UnhealthyCount: types.Int64Value(3),
+		},
+	}
+}
+
+func TestGlobalLBUpdatePartialFailure(t *testing.T) {
+	api := &fakeGlobalLBAPI{t: t}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	r := &GlobalLBResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	state := testGlobalLBModel("LATENCY", 30)
+	plan := testGlobalLBModel("GEOLOCATION", 10)
+
+	data, err := r.update(context.Background(), plan, state)
+
+	assert.Error(t, err)
+	assert.Len(t, api.bodies, 2)
+	assert.Contains(t, api.bodies[0], "health_check")
+	assert.Equal(t, map[string]interface{}{"routing_policy": "GEOLOCATION"}, api.bodies[1])
+
+	// The health check was applied, the policy wasn't
+	assert.Equal(t, plan.HealthCheck, data.HealthCheck)
+	assert.Equal(t, "LATENCY", data.Policy.ValueString())
+	assert.Equal(t, "updating", data.Status.ValueString())
+}
+
+func TestGlobalLBUpdateUnchangedHealthCheck(t *testing.T) {
+	api := &fakeGlobalLBAPI{t: t}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	r := &GlobalLBResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	state := testGlobalLBModel("LATENCY", 30)
+	data, err := r.update(context.Background(), state, state)
+
+	assert.NoError(t, err)
+	assert.Empty(t, api.bodies)
+	assert.Equal(t, state, data)
+}
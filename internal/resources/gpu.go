@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// knownGPUTypes is used to validate gpu_type when the API does not list GPU types.
+var knownGPUTypes = []string{"nvidia-t4", "nvidia-l4", "nvidia-a10g", "nvidia-a100", "nvidia-h100"}
+
+// maxGPUCount is the most GPUs that can be attached to a single instance.
+const maxGPUCount = 8
+
+// validateGPUType reports an error on the gpu_type attribute when the type is not
+// offered by the platform. The check is skipped when the type is unknown or the GPU
+// types cannot be listed.
+func validateGPUType(ctx context.Context, c *client.Client, gpuType types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil || gpuType.IsNull() || gpuType.IsUnknown() {
+		return diags
+	}
+
+	gpuTypes, err := c.ListGPUTypes(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "skipping GPU type check, GPU types unavailable", map[string]interface{}{"error": err.Error()})
+		return diags
+	}
+
+	names := knownGPUTypes
+	if gpuTypes != nil {
+		names = make([]string, 0, len(gpuTypes))
+		for _, t := range gpuTypes {
+			names = append(names, t.Name)
+		}
+	}
+
+	if err := checkGPUType(gpuType.ValueString(), names); err != nil {
+		diags.AddAttributeError(path.Root("gpu_type"), "Invalid GPU Type", err.Error())
+	}
+
+	return diags
+}
+
+// gpuTypeChanged reports whether a plan creates the resource or changes its gpu_type, so
+// the offered types are only listed when the check can have a different result.
+func gpuTypeChanged(ctx context.Context, req resource.ModifyPlanRequest, gpuType types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return true, diags
+	}
+
+	var stateGPUType types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("gpu_type"), &stateGPUType)...)
+
+	return !gpuType.Equal(stateGPUType), diags
+}
+
+// checkGPUType verifies the GPU type is one of the offered types.
+func checkGPUType(gpuType string, offered []string) error {
+	if slices.Contains(offered, gpuType) {
+		return nil
+	}
+
+	return fmt.Errorf("GPU type %q is not offered. Available GPU types: %s", gpuType, strings.Join(offered, ", "))
+}
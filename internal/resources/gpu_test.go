@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGPUType(t *testing.T) {
+	assert.NoError(t, checkGPUType("nvidia-a100", knownGPUTypes))
+
+	err := checkGPUType("nvidia-a1000", knownGPUTypes)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "nvidia-h100")
+}
+
+func TestValidateGPUType(t *testing.T) {
+	ctx := context.Background()
+
+	listed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gpu-types", r.URL.Path)
+
+		data, err := json.Marshal([]client.GPUType{{Name: "nvidia-b200", MemoryGB: 192}})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: data}))
+	}))
+	defer listed.Close()
+
+	unlisted := httptest.NewServer(http.NotFoundHandler())
+	defer unlisted.Close()
+
+	// Types listed by the API take precedence over the static list
+	c := client.NewClient(listed.URL, "test-key")
+	assert.False(t, validateGPUType(ctx, c, types.StringValue("nvidia-b200")).HasError())
+	assert.True(t, validateGPUType(ctx, c, types.StringValue("nvidia-a100")).HasError())
+	assert.False(t, validateGPUType(ctx, c, types.StringUnknown()).HasError())
+
+	// Without the endpoint, the static list is used
+	c = client.NewClient(unlisted.URL, "test-key")
+	assert.False(t, validateGPUType(ctx, c, types.StringValue("nvidia-a100")).HasError())
+	assert.True(t, validateGPUType(ctx, c, types.StringValue("nvidia-b200")).HasError())
+}
+
+func TestInstanceGPUConfigValidation(t *testing.T) {
+	ctx := context.Background()
+	r := NewInstanceResource().(*InstanceResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(gpu map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range gpu {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, req, resp)
+		}
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(nil))
+	assert.False(t, validate(map[string]tftypes.Value{
+		"gpu_type":  tftypes.NewValue(tftypes.String, "nvidia-a100"),
+		"gpu_count": tftypes.NewValue(tftypes.Number, 2),
+	}))
+	assert.True(t, validate(map[string]tftypes.Value{"gpu_type": tftypes.NewValue(tftypes.String, "nvidia-a100")}))
+	assert.True(t, validate(map[string]tftypes.Value{"gpu_count": tftypes.NewValue(tftypes.Number, 2)}))
+}
+
+func TestGPUTypeChanged(t *testing.T) {
+	ctx := context.Background()
+	r := NewInstanceResource().(*InstanceResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	state := func(gpuType interface{}) tfsdk.State {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["gpu_type"] = tftypes.NewValue(tftypes.String, gpuType)
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	changed := func(req resource.ModifyPlanRequest, gpuType types.String) bool {
+		result, diags := gpuTypeChanged(ctx, req, gpuType)
+		assert.False(t, diags.HasError())
+		return result
+	}
+
+	// Always checked on create
+	create := resource.ModifyPlanRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	assert.True(t, changed(create, types.StringValue("nvidia-t4")))
+
+	update := resource.ModifyPlanRequest{State: state("nvidia-t4")}
+	assert.False(t, changed(update, types.StringValue("nvidia-t4")))
+	assert.True(t, changed(update, types.StringValue("nvidia-l4")))
+
+	assert.False(t, changed(resource.ModifyPlanRequest{State: state(nil)}, types.StringNull()))
+}
@@ -2,8 +2,12 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,9 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
+// imageStatusRegistered is the status of an image that has been registered but whose
+// contents have not been uploaded yet.
+const imageStatusRegistered = "registered"
+
 // Ensure implementation of interfaces
 var _ resource.Resource = &ImageResource{}
 var _ resource.ResourceWithImportState = &ImageResource{}
@@ -30,14 +39,16 @@ type ImageResource struct {
 
 // ImageResourceModel describes the resource data model.
 type ImageResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	OS          types.String `tfsdk:"os"`
-	Version     types.String `tfsdk:"version"`
-	IsPublic    types.Bool   `tfsdk:"is_public"`
-	Filename    types.String `tfsdk:"filename"`
-	Status      types.String `tfsdk:"status"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	OS           types.String `tfsdk:"os"`
+	Version      types.String `tfsdk:"version"`
+	Architecture types.String `tfsdk:"architecture"`
+	IsPublic     types.Bool   `tfsdk:"is_public"`
+	Filename     types.String `tfsdk:"filename"`
+	SourceHash   types.String `tfsdk:"source_hash"`
+	Status       types.String `tfsdk:"status"`
 }
 
 func (r *ImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,6 +83,14 @@ func (r *ImageResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				MarkdownDescription: "The version of the operating system (e.g. 22.04).",
 			},
+			"architecture": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The CPU architecture of the image (e.g. amd64, arm64).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"is_public": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -79,7 +98,14 @@ func (r *ImageResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"filename": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The path to the image file to upload.",
+				MarkdownDescription: "The path to the image file to upload. The file is uploaded again when its contents change.",
+			},
+			"source_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the uploaded image file, used to detect changes to the file.",
+				PlanModifiers: []planmodifier.String{
+					fileHashFromPlan{filename: path.Root("filename")},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -117,35 +143,43 @@ func (r *ImageResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	registerReq := client.RegisterImageRequest{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		OS:          data.OS.ValueString(),
-		Version:     data.Version.ValueString(),
-		IsPublic:    data.IsPublic.ValueBool(),
-	}
-
-	image, err := r.client.RegisterImage(ctx, registerReq)
+	file, size, err := openImageFile(data.Filename.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to register Image, got error: %s", err))
+		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read image file %s, got error: %s", data.Filename.ValueString(), err))
 		return
 	}
+	defer file.Close() // nolint:errcheck
 
-	code, err := os.ReadFile(data.Filename.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read image file %s, got error: %s", data.Filename.ValueString(), err))
-		return
+	registerReq := client.RegisterImageRequest{
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueString(),
+		OS:           data.OS.ValueString(),
+		Version:      data.Version.ValueString(),
+		Architecture: data.Architecture.ValueString(),
+		IsPublic:     data.IsPublic.ValueBool(),
 	}
 
-	err = r.client.UploadImage(ctx, image.ID, code)
+	image, err := r.registerOrResume(ctx, registerReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to upload Image, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to register Image", err)
 		return
 	}
 
 	data.ID = types.StringValue(image.ID)
+	data.Architecture = types.StringValue(image.Architecture)
+	data.IsPublic = types.BoolValue(image.IsPublic)
 	data.Status = types.StringValue(image.Status)
 
+	hash, err := r.upload(ctx, image.ID, file, size)
+	if err != nil {
+		// The image is left out of state so it isn't tainted and replaced: the next apply
+		// finds it by name through registerOrResume and retries only the upload
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Image %s was registered but its upload failed. Apply again to resume the upload", image.ID), err)
+		return
+	}
+
+	data.SourceHash = types.StringValue(hash)
+
 	tflog.Trace(ctx, "created an Image resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -162,7 +196,7 @@ func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	image, err := r.client.GetImage(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Image, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Image", err)
 		return
 	}
 
@@ -176,6 +210,7 @@ func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Description = types.StringValue(image.Description)
 	data.OS = types.StringValue(image.OS)
 	data.Version = types.StringValue(image.Version)
+	data.Architecture = types.StringValue(image.Architecture)
 	data.IsPublic = types.BoolValue(image.IsPublic)
 	data.Status = types.StringValue(image.Status)
 
@@ -183,7 +218,46 @@ func (r *ImageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported
+	var plan, state ImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = state.Status
+
+	// Only new contents are uploaded; moving or renaming the file is not a change.
+	if !plan.SourceHash.Equal(state.SourceHash) {
+		file, size, err := openImageFile(plan.Filename.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("File Error", fmt.Sprintf("Unable to read image file %s, got error: %s", plan.Filename.ValueString(), err))
+			return
+		}
+		defer file.Close() // nolint:errcheck
+
+		hash, err := r.upload(ctx, plan.ID.ValueString(), file, size)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to upload Image", err)
+			return
+		}
+		plan.SourceHash = types.StringValue(hash)
+
+		image, err := r.client.GetImage(ctx, plan.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read Image", err)
+			return
+		}
+		if image != nil {
+			plan.Status = types.StringValue(image.Status)
+		}
+	}
+
+	tflog.Trace(ctx, "updated an Image resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -197,7 +271,7 @@ func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteImage(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Image, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Image", err)
 		return
 	}
 }
@@ -205,3 +279,67 @@ func (r *ImageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *ImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// registerOrResume registers the image. When the name is taken by an image with the same
+// OS and version that was registered but never uploaded, e.g. because an earlier upload
+// failed or the apply was interrupted, that image is reused and only the upload is
+// retried.
+func (r *ImageResource) registerOrResume(ctx context.Context, req client.RegisterImageRequest) (*client.Image, error) {
+	image, err := r.client.RegisterImage(ctx, req)
+	if err == nil || !isAlreadyExists(err) {
+		return image, err
+	}
+
+	images, listErr := r.client.ListImages(ctx, client.ListFilter{Name: req.Name})
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	for _, existing := range images {
+		if existing.Name != req.Name || existing.OS != req.OS || existing.Version != req.Version ||
+			!strings.EqualFold(existing.Status, imageStatusRegistered) {
+			continue
+		}
+
+		tflog.Info(ctx, "resuming upload of registered image", map[string]interface{}{"id": existing.ID, "name": req.Name})
+		return &existing, nil
+	}
+
+	// The name belongs to a complete image, so report the original error
+	return nil, err
+}
+
+// openImageFile opens an image file for upload and returns its size.
+func openImageFile(name string) (*os.File, int64, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close() // nolint:errcheck
+		return nil, 0, err
+	}
+
+	return file, info.Size(), nil
+}
+
+// upload streams the file to the image, logging progress, and returns the hash of what
+// was uploaded.
+func (r *ImageResource) upload(ctx context.Context, id string, file io.Reader, size int64) (string, error) {
+	h := sha256.New()
+
+	err := r.client.UploadImage(ctx, id, io.TeeReader(file, h), size, func(uploaded, total int64) {
+		fields := map[string]interface{}{"image_id": id, "uploaded_bytes": uploaded, "total_bytes": total}
+		if total > 0 {
+			fields["percent"] = uploaded * 100 / total
+		}
+		tflog.Info(ctx, "uploading image", fields)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
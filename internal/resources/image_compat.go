@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// validateImageSizeCompatibility looks up the image and instance size metadata and
+// reports an error on the instance_size attribute when they are incompatible. The
+// check is skipped when either value is unknown or cannot be resolved by the API.
+func validateImageSizeCompatibility(ctx context.Context, c *client.Client, image, size types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil || image.IsNull() || image.IsUnknown() || size.IsNull() || size.IsUnknown() {
+		return diags
+	}
+
+	img, err := c.FindImage(ctx, image.ValueString())
+	if err != nil || img == nil {
+		tflog.Debug(ctx, "skipping image compatibility check, image metadata unavailable", map[string]interface{}{"image": image.ValueString()})
+		return diags
+	}
+
+	instanceSize, err := c.GetInstanceSize(ctx, size.ValueString())
+	if err != nil || instanceSize == nil {
+		tflog.Debug(ctx, "skipping image compatibility check, size metadata unavailable", map[string]interface{}{"instance_size": size.ValueString()})
+		return diags
+	}
+
+	if err := checkImageSizeCompatibility(img, instanceSize); err != nil {
+		diags.AddAttributeError(path.Root("instance_size"), "Incompatible Image and Instance Size", err.Error())
+	}
+
+	return diags
+}
+
+// imageOrSizeChanged reports whether a plan creates the resource or changes its image or
+// instance_size, so their compatibility is only looked up when it can have changed rather
+// than on every plan.
+func imageOrSizeChanged(ctx context.Context, req resource.ModifyPlanRequest, image, size types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.State.Raw.IsNull() {
+		return true, diags
+	}
+
+	var stateImage, stateSize types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("image"), &stateImage)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("instance_size"), &stateSize)...)
+
+	return !image.Equal(stateImage) || !size.Equal(stateSize), diags
+}
+
+// checkImageSizeCompatibility verifies the image architecture is supported by the instance size.
+func checkImageSizeCompatibility(img *client.Image, size *client.InstanceSize) error {
+	if img.Architecture == "" || len(size.Architectures) == 0 {
+		return nil
+	}
+
+	for _, arch := range size.Architectures {
+		if strings.EqualFold(arch, img.Architecture) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"image %q has architecture %q, but instance size %q only supports: %s",
+		img.Name, img.Architecture, size.Name, strings.Join(size.Architectures, ", "),
+	)
+}
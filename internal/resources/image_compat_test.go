@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testArmImage   = client.Image{ID: "img-arm", Name: "ubuntu-arm", Architecture: "arm64"}
+	testAmdImage   = client.Image{ID: "img-amd", Name: "ubuntu-amd", Architecture: "amd64"}
+	testAmdSize    = client.InstanceSize{Name: "standard-2", Architectures: []string{"amd64"}}
+	testSharedSize = client.InstanceSize{Name: "flex-2", Architectures: []string{"amd64", "arm64"}}
+)
+
+func TestCheckImageSizeCompatibility(t *testing.T) {
+	assert.NoError(t, checkImageSizeCompatibility(&testAmdImage, &testAmdSize))
+	assert.NoError(t, checkImageSizeCompatibility(&testArmImage, &testSharedSize))
+	assert.NoError(t, checkImageSizeCompatibility(&client.Image{Name: "legacy"}, &testAmdSize))
+
+	err := checkImageSizeCompatibility(&testArmImage, &testAmdSize)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ubuntu-arm")
+	assert.Contains(t, err.Error(), "standard-2")
+}
+
+func TestValidateImageSizeCompatibility(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch r.URL.Path {
+		case "/images/img-arm":
+			data = testArmImage
+		case "/instance-sizes/standard-2":
+			data = testAmdSize
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key")
+	ctx := context.Background()
+
+	diags := validateImageSizeCompatibility(ctx, c, types.StringValue("img-arm"), types.StringValue("standard-2"))
+	assert.True(t, diags.HasError())
+
+	diags = validateImageSizeCompatibility(ctx, c, types.StringValue("img-arm"), types.StringUnknown())
+	assert.False(t, diags.HasError())
+
+	diags = validateImageSizeCompatibility(ctx, c, types.StringValue("img-arm"), types.StringValue("unknown-size"))
+	assert.False(t, diags.HasError())
+}
+
+func TestImageOrSizeChanged(t *testing.T) {
+	ctx := context.Background()
+	r := NewScalingGroupResource().(*ScalingGroupResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := func(image, size string) tfsdk.State {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["image"] = tftypes.NewValue(tftypes.String, image)
+		values["instance_size"] = tftypes.NewValue(tftypes.String, size)
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	changed := func(req resource.ModifyPlanRequest, image, size string) bool {
+		result, diags := imageOrSizeChanged(ctx, req, types.StringValue(image), types.StringValue(size))
+		assert.False(t, diags.HasError())
+		return result
+	}
+
+	// Always checked on create
+	create := resource.ModifyPlanRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	assert.True(t, changed(create, "img-arm", "standard-2"))
+
+	update := resource.ModifyPlanRequest{State: state("img-arm", "standard-2")}
+	assert.False(t, changed(update, "img-arm", "standard-2"))
+	assert.True(t, changed(update, "img-amd", "standard-2"))
+	assert.True(t, changed(update, "img-arm", "flex-2"))
+}
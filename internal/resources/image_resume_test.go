@@ -0,0 +1,170 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeImageAPI registers images and fails the first failUploads upload parts.
+type fakeImageAPI struct {
+	t           *testing.T
+	images      map[string]*client.Image
+	failUploads int
+}
+
+func (f *fakeImageAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.Split(strings.TrimPrefix(r.URL.Path, "/images/"), "/")[0]
+
+	var data interface{}
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/images":
+		var req client.RegisterImageRequest
+		assert.NoError(f.t, json.NewDecoder(r.Body).Decode(&req))
+		for _, image := range f.images {
+			if image.Name == req.Name {
+				w.WriteHeader(http.StatusConflict)
+				assert.NoError(f.t, json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]string{"type": "conflict", "message": "image name is taken", "code": "ALREADY_EXISTS"},
+				}))
+				return
+			}
+		}
+		image := &client.Image{ID: fmt.Sprintf("img-%d", len(f.images)+1), Name: req.Name, OS: req.OS, Version: req.Version, Architecture: "amd64", Status: imageStatusRegistered}
+		f.images[image.ID] = image
+		data = image
+	case r.Method == http.MethodGet && r.URL.Path == "/images":
+		var images []client.Image
+		for _, image := range f.images {
+			images = append(images, *image)
+		}
+		data = images
+	case r.Method == http.MethodPut && r.URL.Path == "/images/"+id+"/upload":
+		if f.failUploads > 0 {
+			f.failUploads--
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+	case r.Method == http.MethodPost && r.URL.Path == "/images/"+id+"/upload/complete":
+		f.images[id].Status = imageStatusAvailable
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	raw, err := json.Marshal(data)
+	assert.NoError(f.t, err)
+	assert.NoError(f.t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+}
+
+func TestImageCreateResumesFailedUpload(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeImageAPI{t: t, images: map[string]*client.Image{}, failUploads: 1}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	r := &ImageResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	filename := filepath.Join(t.TempDir(), "image.qcow2")
+	assert.NoError(t, os.WriteFile(filename, []byte("image-bytes"), 0o600))
+
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "base")
+	values["description"] = tftypes.NewValue(tftypes.String, nil)
+	values["os"] = tftypes.NewValue(tftypes.String, "ubuntu")
+	values["version"] = tftypes.NewValue(tftypes.String, "22.04")
+	values["filename"] = tftypes.NewValue(tftypes.String, filename)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	create := func() (*resource.CreateResponse, ImageResourceModel) {
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+		var data ImageResourceModel
+		if !resp.State.Raw.IsNull() {
+			assert.False(t, resp.State.Get(ctx, &data).HasError())
+		}
+		return resp, data
+	}
+
+	// The failed upload leaves the registered image out of state, so it isn't tainted
+	resp, _ := create()
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+	assert.Len(t, api.images, 1)
+	assert.Equal(t, imageStatusRegistered, api.images["img-1"].Status)
+
+	// Applying again resumes the upload of the registered image instead of replacing it
+	resp, data := create()
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "img-1", data.ID.ValueString())
+	assert.Equal(t, bytesSHA256([]byte("image-bytes")), data.SourceHash.ValueString())
+	assert.Len(t, api.images, 1)
+	assert.Equal(t, imageStatusAvailable, api.images["img-1"].Status)
+
+	// A complete image with the name is still a conflict
+	resp, _ = create()
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Len(t, api.images, 1)
+}
+
+func TestFunctionCreateResumesRegisteredFunction(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+
+		var data interface{}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/functions":
+			w.WriteHeader(http.StatusConflict)
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]string{"type": "conflict", "message": "function name is taken", "code": "ALREADY_EXISTS"},
+			}))
+			return
+		case r.Method == http.MethodGet && r.URL.Path == "/functions":
+			data = []client.Function{{ID: "fn-1", Name: "hello", Runtime: "python3.9", Handler: "main.handler"}}
+		case r.Method == http.MethodPut && r.URL.Path == "/functions/fn-1/code":
+			data = client.Function{ID: "fn-1", Name: "hello", Runtime: "python3.9", Handler: "main.handler", CodePath: "fn-1/code.zip", Status: "deploying"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		raw, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	r := &FunctionResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	function, err := r.createOrResume(context.Background(), FunctionResourceModel{
+		Name:    types.StringValue("hello"),
+		Runtime: types.StringValue("python3.9"),
+		Handler: types.StringValue("main.handler"),
+	}, []byte("code"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "fn-1", function.ID)
+	assert.Equal(t, []string{"POST /functions", "GET /functions", "PUT /functions/fn-1/code"}, calls)
+}
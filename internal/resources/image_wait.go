@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const (
+	imageStatusAvailable    = "available"
+	defaultImageWaitTimeout = 5 * time.Minute
+)
+
+// imagePendingStatuses are the statuses an image passes through before it is
+// available. Any other status (e.g. failed) ends the wait immediately.
+var imagePendingStatuses = []string{"pending", "registered", "uploading", "importing", "processing", "creating"}
+
+// waitForImageAvailable blocks until an image registered with the API is available.
+// References that do not resolve to a registered image (e.g. public container images)
+// are passed through untouched.
+func waitForImageAvailable(ctx context.Context, c *client.Client, ref string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	image, err := c.FindImage(ctx, ref)
+	if err != nil {
+		tflog.Debug(ctx, "skipping image availability check, image lookup failed", map[string]interface{}{"image": ref, "error": err.Error()})
+		return diags
+	}
+	if image == nil || strings.EqualFold(image.Status, imageStatusAvailable) {
+		return diags
+	}
+
+	tflog.Debug(ctx, "waiting for image to become available", map[string]interface{}{"image": ref, "status": image.Status})
+
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetImage(ctx, image.ID)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		return current.Status, false, nil
+	}, []string{imageStatusAvailable}, imagePendingStatuses, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddAttributeError(
+			path.Root("image"),
+			"Image Not Available",
+			fmt.Sprintf("Timed out after %s waiting for image %q to become available. Last observed status: %q.", timeout, ref, timeoutErr.LastStatus),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("image"),
+			"Image Not Available",
+			fmt.Sprintf("Image %q did not become available, got error: %s", ref, err),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForImageAvailable(t *testing.T) {
+	images := map[string]client.Image{
+		"/images/img-ready":   {ID: "img-ready", Name: "ready", Status: "AVAILABLE"},
+		"/images/img-pending": {ID: "img-pending", Name: "pending", Status: "processing"},
+		"/images/img-failed":  {ID: "img-failed", Name: "failed", Status: "failed"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		image, ok := images[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(image)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key")
+	ctx := context.Background()
+
+	assert.False(t, waitForImageAvailable(ctx, c, "img-ready", time.Minute).HasError())
+	assert.False(t, waitForImageAvailable(ctx, c, "nginx:latest", time.Minute).HasError())
+
+	diags := waitForImageAvailable(ctx, c, "img-pending", time.Millisecond)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "img-pending")
+	assert.Contains(t, diags[0].Detail(), "processing")
+
+	// A failed image ends the wait without running out the timeout
+	start := time.Now()
+	diags = waitForImageAvailable(ctx, c, "img-failed", time.Minute)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "failed")
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
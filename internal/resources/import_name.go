@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// importNamePrefix marks an import identifier that is a resource name rather than an ID.
+const importNamePrefix = "name/"
+
+// maxCloseMatches caps the names suggested when an import name doesn't resolve.
+const maxCloseMatches = 5
+
+// importByIDOrName imports a resource by its ID or, when the identifier is written as
+// name/<name>, by the ID of the resource of that name returned by list. idAndName reads
+// the ID and name of a listed resource, and kind names the resource in diagnostics.
+func importByIDOrName[T any](ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string,
+	list func(context.Context, ...client.ListFilter) ([]T, error), idAndName func(T) (string, string)) {
+	name, ok := strings.CutPrefix(req.ID, importNamePrefix)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or name/<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	existing, err := list(ctx)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to look up %s %q", kind, name), err)
+		return
+	}
+
+	var ids, names []string
+	for _, r := range existing {
+		id, existingName := idAndName(r)
+		names = append(names, existingName)
+		if existingName == name {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids[0])...)
+	case 0:
+		detail := fmt.Sprintf("No %s named %q exists.", kind, name)
+		if matches := closeMatches(name, names); len(matches) > 0 {
+			detail += fmt.Sprintf(" Did you mean one of: %s?", strings.Join(matches, ", "))
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("%s Not Found", kind), detail+" Import by ID to import a specific resource.")
+	default:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Ambiguous %s Name", kind),
+			fmt.Sprintf("%d resources are named %q (%s). Import by ID instead.", len(ids), name, strings.Join(ids, ", ")),
+		)
+	}
+}
+
+// closeMatches returns the names that contain name, are contained in it, or are within
+// two edits of it, ignoring case.
+func closeMatches(name string, names []string) []string {
+	want := strings.ToLower(name)
+
+	var matches []string
+	for _, candidate := range names {
+		have := strings.ToLower(candidate)
+		if strings.Contains(have, want) || strings.Contains(want, have) || editDistance(have, want) <= 2 {
+			matches = append(matches, candidate)
+		}
+	}
+
+	sort.Strings(matches)
+	if len(matches) > maxCloseMatches {
+		matches = matches[:maxCloseMatches]
+	}
+	return matches
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr := make([]int, len(b)+1)
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// importID runs ImportState on r with the given identifier, against a client talking
+// to url, and returns the imported id.
+func importID(t *testing.T, r resource.ResourceWithImportState, url, id string) (types.String, resource.ImportStateResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: client.NewClient(url, "test-key", client.WithRetryMax(0)),
+	}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+
+	var imported types.String
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &imported)...)
+	}
+	return imported, resp
+}
+
+func TestImportByIDOrName(t *testing.T) {
+	lists := map[string]interface{}{
+		"/queues":                 []client.Queue{{ID: "q-1", Name: "orders"}, {ID: "q-2", Name: "invoices"}},
+		"/functions":              []client.Function{{ID: "fn-1", Name: "resize-images"}},
+		"/containers/deployments": []client.Deployment{{ID: "dep-1", Name: "web"}, {ID: "dep-2", Name: "web"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list, ok := lists[r.URL.Path]
+		if !assert.True(t, ok, "unexpected request %s %s", r.Method, r.URL.Path) {
+			return
+		}
+		raw, err := json.Marshal(list)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	// IDs are imported as they are, without calling the API
+	id, resp := importID(t, NewQueueResource().(resource.ResourceWithImportState), "http://127.0.0.1:0", "q-1")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "q-1", id.ValueString())
+
+	id, resp = importID(t, NewQueueResource().(resource.ResourceWithImportState), server.URL, "name/orders")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "q-1", id.ValueString())
+
+	id, resp = importID(t, NewFunctionResource().(resource.ResourceWithImportState), server.URL, "name/resize-images")
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, "fn-1", id.ValueString())
+
+	_, resp = importID(t, NewFunctionResource().(resource.ResourceWithImportState), server.URL, "name/resize-image")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Function Not Found", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "Did you mean one of: resize-images?")
+	}
+
+	_, resp = importID(t, NewDeploymentResource().(resource.ResourceWithImportState), server.URL, "name/web")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Ambiguous Deployment Name", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "dep-1, dep-2")
+	}
+
+	_, resp = importID(t, NewDeploymentResource().(resource.ResourceWithImportState), server.URL, "name/")
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestCloseMatches(t *testing.T) {
+	names := []string{"orders", "orders-dlq", "invoices", "Order", "payments"}
+
+	assert.Equal(t, []string{"Order", "orders", "orders-dlq"}, closeMatches("order", names))
+	assert.Equal(t, []string{"invoices"}, closeMatches("invoice5", names))
+	assert.Empty(t, closeMatches("shipping", names))
+}
@@ -5,19 +5,29 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &InstanceResource{}
 var _ resource.ResourceWithImportState = &InstanceResource{}
+var _ resource.ResourceWithUpgradeState = &InstanceResource{}
+var _ resource.ResourceWithModifyPlan = &InstanceResource{}
+var _ resource.ResourceWithConfigValidators = &InstanceResource{}
+var _ resource.ResourceWithValidateConfig = &InstanceResource{}
 
 func NewInstanceResource() resource.Resource {
 	return &InstanceResource{}
@@ -30,15 +40,23 @@ type InstanceResource struct {
 
 // InstanceResourceModel describes the resource data model.
 type InstanceResourceModel struct {
-	ID        types.String   `tfsdk:"id"`
-	Name      types.String   `tfsdk:"name"`
-	Image     types.String   `tfsdk:"image"`
-	Ports     types.String   `tfsdk:"ports"`
-	VpcID     types.String   `tfsdk:"vpc_id"`
-	SubnetID  types.String   `tfsdk:"subnet_id"`
-	Status    types.String   `tfsdk:"status"`
-	IPAddress types.String   `tfsdk:"ip_address"`
-	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	NamePrefix       types.String   `tfsdk:"name_prefix"`
+	Image            types.String   `tfsdk:"image"`
+	Ports            types.String   `tfsdk:"ports"`
+	VpcID            types.String   `tfsdk:"vpc_id"`
+	SubnetID         types.String   `tfsdk:"subnet_id"`
+	InstanceSize     types.String   `tfsdk:"instance_size"`
+	GPUType          types.String   `tfsdk:"gpu_type"`
+	GPUCount         types.Int64    `tfsdk:"gpu_count"`
+	DesiredState     types.String   `tfsdk:"desired_state"`
+	SecurityGroupIDs types.Set      `tfsdk:"security_group_ids"`
+	SSHKeyName       types.String   `tfsdk:"ssh_key_name"`
+	Status           types.String   `tfsdk:"status"`
+	IPAddress        types.String   `tfsdk:"ip_address"`
+	Labels           types.Map      `tfsdk:"labels"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +66,7 @@ func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRe
 func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Instance resource allows you to manage compute instances.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -58,8 +77,22 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the instance.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the instance. Exactly one of `name` and `name_prefix` must be set. Changing it replaces the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Creates a unique name beginning with this prefix, followed by a random suffix. The generated name is stored in `name`. " +
+					"Useful with `create_before_destroy`, where the replacement must not reuse the old name. Changing it replaces the instance " +
+					"unless the current name already starts with the new prefix.",
+				PlanModifiers: []planmodifier.String{
+					namePrefixRequiresReplace(),
+				},
 			},
 			"image": schema.StringAttribute{
 				Required:            true,
@@ -70,15 +103,73 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"ports": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The port mappings for the instance (e.g. '80:80,443:443').",
+				MarkdownDescription: "The port mappings for the instance (e.g. '80:80,443:443'). Changing them replaces the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"vpc_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the VPC to launch the instance in.",
+				MarkdownDescription: "The ID of the VPC to launch the instance in. Changing it replaces the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"subnet_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The ID of the Subnet to launch the instance in.",
+				MarkdownDescription: "The ID of the Subnet to launch the instance in. Requires `vpc_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_size": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The instance size to launch. Must support the architecture of the selected image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gpu_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The GPU accelerator type to attach, e.g. `nvidia-a100`. Must be set together with `gpu_count`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gpu_count": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of GPUs to attach, from 1 to 8. Must be set together with `gpu_type`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64BetweenValidator{min: 1, max: maxGPUCount},
+				},
+			},
+			"security_group_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The IDs of the security groups to attach. Groups can be added and removed without replacing the instance. When unset, the instance is placed in the VPC's default security group.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_key_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the SSH key to install on the instance at launch. Changing it replaces the instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"desired_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(instanceStateRunning),
+				MarkdownDescription: "Whether the instance should be `running` or `stopped`. Changing it stops or starts the instance in place. Defaults to `running`.",
+				Validators: []validator.String{
+					stringOneOfValidator{values: instanceDesiredStates},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
@@ -88,14 +179,53 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 				MarkdownDescription: "The IP address of the instance.",
 			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Key-value labels attached to the instance. Changing them updates the instance in place.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
 	}
 }
 
+func (r *InstanceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		requiredTogether(path.Root("gpu_type"), path.Root("gpu_count")),
+		conflictsWith(path.Root("name"), path.Root("name_prefix")),
+	}
+}
+
+func (r *InstanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InstanceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() && data.NamePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing Attribute Configuration",
+			"One of \"name\" or \"name_prefix\" must be specified.",
+		)
+	}
+
+	if !data.SubnetID.IsNull() && data.VpcID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vpc_id"),
+			"Missing Attribute Configuration",
+			"Attribute \"vpc_id\" must be specified when \"subnet_id\" is specified.",
+		)
+	}
+}
+
 func (r *InstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -120,21 +250,50 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultInstanceStateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForImageAvailable(ctx, r.client, data.Image.ValueString(), createTimeout)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	name := data.Name.ValueString()
+	if data.Name.IsUnknown() && !data.NamePrefix.IsNull() {
+		name = uniqueName(data.NamePrefix.ValueString())
+	}
+
 	createReq := client.LaunchInstanceRequest{
-		Name:     data.Name.ValueString(),
-		Image:    data.Image.ValueString(),
-		Ports:    data.Ports.ValueString(),
-		VpcID:    data.VpcID.ValueString(),
-		SubnetID: data.SubnetID.ValueString(),
+		Name:         name,
+		Image:        data.Image.ValueString(),
+		Ports:        data.Ports.ValueString(),
+		VpcID:        data.VpcID.ValueString(),
+		SubnetID:     data.SubnetID.ValueString(),
+		InstanceSize: data.InstanceSize.ValueString(),
+		GPUType:      data.GPUType.ValueString(),
+		GPUCount:     int(data.GPUCount.ValueInt64()),
+		SSHKeyName:   data.SSHKeyName.ValueString(),
+	}
+
+	if !data.SecurityGroupIDs.IsNull() && !data.SecurityGroupIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.SecurityGroupIDs.ElementsAs(ctx, &createReq.SecurityGroupIDs, false)...)
+	}
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &createReq.Labels, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	instance, err := r.client.CreateInstance(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create instance, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create instance", err)
 		return
 	}
 
@@ -156,8 +315,54 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 	} else {
 		data.SubnetID = types.StringNull()
 	}
+	if !data.InstanceSize.IsNull() || instance.InstanceSize != "" {
+		data.InstanceSize = types.StringValue(instance.InstanceSize)
+	} else {
+		data.InstanceSize = types.StringNull()
+	}
+	if !data.GPUType.IsNull() || instance.GPUType != "" {
+		data.GPUType = types.StringValue(instance.GPUType)
+	} else {
+		data.GPUType = types.StringNull()
+	}
+	if !data.GPUCount.IsNull() || instance.GPUCount != 0 {
+		data.GPUCount = types.Int64Value(int64(instance.GPUCount))
+	} else {
+		data.GPUCount = types.Int64Null()
+	}
+	if !data.SSHKeyName.IsNull() || instance.SSHKeyName != "" {
+		data.SSHKeyName = types.StringValue(instance.SSHKeyName)
+	} else {
+		data.SSHKeyName = types.StringNull()
+	}
 	data.Status = types.StringValue(instance.Status)
 	data.IPAddress = types.StringValue(instance.IPAddress)
+	resp.Diagnostics.Append(data.setLabels(ctx, instance.Labels)...)
+
+	securityGroupIDs, diags := flattenSecurityGroupIDs(ctx, instance.SecurityGroupIDs)
+	resp.Diagnostics.Append(diags...)
+	data.SecurityGroupIDs = securityGroupIDs
+
+	var configured types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security_group_ids"), &configured)...)
+	resp.Diagnostics.Append(setSecurityGroupsConfigured(ctx, resp.Private, !configured.IsNull())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DesiredState.ValueString() == instanceStateStopped {
+		stopped, diags := transitionInstance(ctx, r.client, instance.ID, instanceStateStopped, "Create Timeout", createTimeout)
+		resp.Diagnostics.Append(diags...)
+
+		// Save the instance even when it didn't stop, so it's tainted rather than left untracked
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		data.Status = types.StringValue(stopped.Status)
+		data.IPAddress = types.StringValue(stopped.IPAddress)
+	}
 
 	tflog.Trace(ctx, "created an Instance resource")
 
@@ -175,7 +380,7 @@ func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	instance, err := r.client.GetInstance(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read instance, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read instance", err)
 		return
 	}
 
@@ -197,15 +402,134 @@ func (r *InstanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	} else {
 		data.VpcID = types.StringNull()
 	}
+	if !data.SubnetID.IsNull() || instance.SubnetID != "" {
+		data.SubnetID = types.StringValue(instance.SubnetID)
+	} else {
+		data.SubnetID = types.StringNull()
+	}
+	if !data.InstanceSize.IsNull() || instance.InstanceSize != "" {
+		data.InstanceSize = types.StringValue(instance.InstanceSize)
+	} else {
+		data.InstanceSize = types.StringNull()
+	}
+	if !data.GPUType.IsNull() || instance.GPUType != "" {
+		data.GPUType = types.StringValue(instance.GPUType)
+	} else {
+		data.GPUType = types.StringNull()
+	}
+	if !data.GPUCount.IsNull() || instance.GPUCount != 0 {
+		data.GPUCount = types.Int64Value(int64(instance.GPUCount))
+	} else {
+		data.GPUCount = types.Int64Null()
+	}
+	if !data.SSHKeyName.IsNull() || instance.SSHKeyName != "" {
+		data.SSHKeyName = types.StringValue(instance.SSHKeyName)
+	} else {
+		data.SSHKeyName = types.StringNull()
+	}
 	data.Status = types.StringValue(instance.Status)
+	data.DesiredState = desiredStateFromStatus(data.DesiredState, instance.Status)
+
+	securityGroupIDs, diags := flattenSecurityGroupIDs(ctx, instance.SecurityGroupIDs)
+	resp.Diagnostics.Append(diags...)
+	data.SecurityGroupIDs = securityGroupIDs
 	data.IPAddress = types.StringValue(instance.IPAddress)
+	resp.Diagnostics.Append(data.setLabels(ctx, instance.Labels)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Instance update not supported yet, but we'll mark it as No-Op for now
-	resp.Diagnostics.AddWarning("Update Not Supported", "Updating an instance is not currently supported by the API.")
+	var plan, state InstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultInstanceStateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DesiredState.Equal(state.DesiredState) {
+		instance, diags := transitionInstance(ctx, r.client, state.ID.ValueString(), plan.DesiredState.ValueString(), "Update Timeout", updateTimeout)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.DesiredState = plan.DesiredState
+		state.Status = types.StringValue(instance.Status)
+		state.IPAddress = types.StringValue(instance.IPAddress)
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		labels := map[string]string{}
+		if !plan.Labels.IsNull() {
+			resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		instance, err := r.client.SetInstanceLabels(ctx, state.ID.ValueString(), labels)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update instance labels", err)
+			return
+		}
+
+		state.Labels = plan.Labels
+		resp.Diagnostics.Append(state.setLabels(ctx, instance.Labels)...)
+	}
+
+	// An unknown plan means the groups were removed from the configuration
+	if !plan.SecurityGroupIDs.Equal(state.SecurityGroupIDs) {
+		var current, desired []string
+		resp.Diagnostics.Append(state.SecurityGroupIDs.ElementsAs(ctx, &current, false)...)
+		if !plan.SecurityGroupIDs.IsUnknown() {
+			resp.Diagnostics.Append(plan.SecurityGroupIDs.ElementsAs(ctx, &desired, false)...)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(updateInstanceSecurityGroups(ctx, r.client, state.ID.ValueString(), current, desired)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		instance, err := r.client.GetInstance(ctx, state.ID.ValueString())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to read instance", err)
+			return
+		}
+		if instance == nil {
+			resp.Diagnostics.AddError("Instance Not Found", fmt.Sprintf("Instance %s disappeared while its security groups were being updated.", state.ID.ValueString()))
+			return
+		}
+
+		securityGroupIDs, diags := flattenSecurityGroupIDs(ctx, instance.SecurityGroupIDs)
+		resp.Diagnostics.Append(diags...)
+		state.SecurityGroupIDs = securityGroupIDs
+	}
+
+	var configured types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security_group_ids"), &configured)...)
+	resp.Diagnostics.Append(setSecurityGroupsConfigured(ctx, resp.Private, !configured.IsNull())...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NamePrefix = plan.NamePrefix
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -219,11 +543,79 @@ func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	err := r.client.DeleteInstance(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete instance, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete instance", err)
 		return
 	}
 }
 
+func (r *InstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var image, size, gpuType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("image"), &image)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_size"), &size)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("gpu_type"), &gpuType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed, diags := imageOrSizeChanged(ctx, req, image, size)
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(validateImageSizeCompatibility(ctx, r.client, image, size)...)
+	}
+
+	changed, diags = gpuTypeChanged(ctx, req, gpuType)
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(validateGPUType(ctx, r.client, gpuType)...)
+	}
+
+	// Detach the groups when security_group_ids is removed from the configuration
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var configuredGroups types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("security_group_ids"), &configuredGroups)...)
+
+	configured, diags := securityGroupsConfigured(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configuredGroups.IsNull() && configured {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("security_group_ids"), types.SetUnknown(types.StringType))...)
+	}
+}
+
+func (r *InstanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: upgradeStateByName(),
+	}
+}
+
 func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setLabels copies the labels reported by the API into the model. Labels stay null when
+// none are configured and the instance has none.
+func (m *InstanceResourceModel) setLabels(ctx context.Context, labels map[string]string) diag.Diagnostics {
+	if len(labels) == 0 && m.Labels.IsNull() {
+		return nil
+	}
+
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	value, diags := types.MapValueFrom(ctx, types.StringType, labels)
+	m.Labels = value
+	return diags
+}
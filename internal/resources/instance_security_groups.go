@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// instanceSecurityGroupsPrivateKey is the private state key recording that
+// security_group_ids was set in the configuration. The attribute is computed, so removing
+// it from the configuration would otherwise keep the groups in the plan; the record lets
+// ModifyPlan detach them instead.
+const instanceSecurityGroupsPrivateKey = "security_groups_configured"
+
+// setSecurityGroupsConfigured records whether security_group_ids is set in the configuration.
+func setSecurityGroupsConfigured(ctx context.Context, private privateState, configured bool) diag.Diagnostics {
+	if !configured {
+		return private.SetKey(ctx, instanceSecurityGroupsPrivateKey, nil)
+	}
+	return private.SetKey(ctx, instanceSecurityGroupsPrivateKey, []byte("true"))
+}
+
+// securityGroupsConfigured reports whether security_group_ids was set in the configuration
+// when Terraform last wrote the instance.
+func securityGroupsConfigured(ctx context.Context, private privateState) (bool, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, instanceSecurityGroupsPrivateKey)
+	return len(raw) > 0, diags
+}
+
+// flattenSecurityGroupIDs converts the security groups reported by the API into the
+// security_group_ids attribute. An instance without groups gets an empty set.
+func flattenSecurityGroupIDs(ctx context.Context, ids []string) (types.Set, diag.Diagnostics) {
+	if ids == nil {
+		ids = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, ids)
+}
+
+// updateInstanceSecurityGroups attaches and detaches security groups so the instance ends
+// up in exactly desired. New groups are attached before old ones are detached, so the
+// instance is never left without a group in between.
+func updateInstanceSecurityGroups(ctx context.Context, c *client.Client, id string, current, desired []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, sgID := range desired {
+		if slices.Contains(current, sgID) {
+			continue
+		}
+		if err := c.AttachInstanceSecurityGroup(ctx, id, sgID); err != nil {
+			apierror.AddClientError(&diags, fmt.Sprintf("Unable to attach security group %s to instance", sgID), err)
+			return diags
+		}
+	}
+
+	for _, sgID := range current {
+		if slices.Contains(desired, sgID) {
+			continue
+		}
+		if err := c.DetachInstanceSecurityGroup(ctx, id, sgID); err != nil {
+			apierror.AddClientError(&diags, fmt.Sprintf("Unable to detach security group %s from instance", sgID), err)
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateInstanceSecurityGroups(t *testing.T) {
+	var calls []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+
+	diags := updateInstanceSecurityGroups(context.Background(), c, "inst-1", []string{"sg-a", "sg-b"}, []string{"sg-b", "sg-c"})
+	assert.False(t, diags.HasError())
+
+	// New groups are attached before old ones are detached
+	assert.Equal(t, []string{
+		"POST /instances/inst-1/security-groups/sg-c",
+		"DELETE /instances/inst-1/security-groups/sg-a",
+	}, calls)
+}
+
+func TestFlattenSecurityGroupIDs(t *testing.T) {
+	ctx := context.Background()
+
+	set, diags := flattenSecurityGroupIDs(ctx, nil)
+	assert.False(t, diags.HasError())
+	assert.False(t, set.IsNull())
+	assert.Empty(t, set.Elements())
+
+	set, diags = flattenSecurityGroupIDs(ctx, []string{"sg-a"})
+	assert.False(t, diags.HasError())
+	assert.Len(t, set.Elements(), 1)
+}
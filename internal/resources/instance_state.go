@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const (
+	instanceStateRunning = "running"
+	instanceStateStopped = "stopped"
+
+	defaultInstanceStateTimeout = 10 * time.Minute
+)
+
+// instanceDesiredStates are the values accepted by the desired_state attribute.
+var instanceDesiredStates = []string{instanceStateRunning, instanceStateStopped}
+
+// desiredStateFromStatus maps an instance status reported by the API onto desired_state.
+// Transient statuses such as provisioning or stopping keep the current value so they are
+// not reported as drift; an unset value (e.g. after import) defaults to running.
+func desiredStateFromStatus(current types.String, status string) types.String {
+	switch {
+	case strings.EqualFold(status, instanceStateRunning):
+		return types.StringValue(instanceStateRunning)
+	case strings.EqualFold(status, instanceStateStopped):
+		return types.StringValue(instanceStateStopped)
+	case current.IsNull() || current.IsUnknown():
+		return types.StringValue(instanceStateRunning)
+	default:
+		return current
+	}
+}
+
+// transitionInstance stops or starts an instance and waits until its status matches
+// desired. The instance as last observed is returned. timeoutSummary names the operation
+// in the diagnostic reported when the wait times out.
+func transitionInstance(ctx context.Context, c *client.Client, id, desired, timeoutSummary string, timeout time.Duration) (*client.Instance, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var err error
+	var pending []string
+	if desired == instanceStateStopped {
+		err = c.StopInstance(ctx, id)
+		pending = []string{instanceStateRunning, "stopping", "provisioning", "pending"}
+	} else {
+		err = c.StartInstance(ctx, id)
+		pending = []string{instanceStateStopped, "starting", "provisioning", "pending"}
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Unable to change instance %s to %s", id, desired), err)
+		return nil, diags
+	}
+
+	var instance *client.Instance
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetInstance(ctx, id)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		instance = current
+		return current.Status, false, nil
+	}, []string{desired}, pending, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(timeoutSummary, fmt.Sprintf("Timed out waiting for instance %s to become %s. Last observed status: %q.", id, desired, timeoutErr.LastStatus))
+		return nil, diags
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Instance %s did not become %s", id, desired), err)
+		return nil, diags
+	}
+
+	return instance, diags
+}
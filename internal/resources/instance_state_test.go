@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesiredStateFromStatus(t *testing.T) {
+	running := types.StringValue(instanceStateRunning)
+	stopped := types.StringValue(instanceStateStopped)
+
+	assert.Equal(t, stopped, desiredStateFromStatus(running, "STOPPED"))
+	assert.Equal(t, running, desiredStateFromStatus(stopped, "running"))
+
+	// Transient statuses are not drift
+	assert.Equal(t, stopped, desiredStateFromStatus(stopped, "stopping"))
+	assert.Equal(t, running, desiredStateFromStatus(running, "provisioning"))
+
+	// Imported instances default to running
+	assert.Equal(t, running, desiredStateFromStatus(types.StringNull(), "provisioning"))
+}
+
+func TestTransitionInstance(t *testing.T) {
+	statuses := map[string]string{
+		"inst-stop":  "stopped",
+		"inst-start": "running",
+		"inst-stuck": "stopping",
+	}
+	var actions []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/instances/"), "/")[0]
+		if r.Method == http.MethodPost {
+			actions = append(actions, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		raw, err := json.Marshal(client.Instance{ID: id, Status: statuses[id], IPAddress: "10.0.0.5"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	instance, diags := transitionInstance(ctx, c, "inst-stop", instanceStateStopped, "Update Timeout", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "stopped", instance.Status)
+
+	instance, diags = transitionInstance(ctx, c, "inst-start", instanceStateRunning, "Update Timeout", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "running", instance.Status)
+
+	_, diags = transitionInstance(ctx, c, "inst-stuck", instanceStateStopped, "Update Timeout", time.Millisecond)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Update Timeout", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "stopping")
+
+	assert.Equal(t, []string{"/instances/inst-stop/stop", "/instances/inst-start/start", "/instances/inst-stuck/stop"}, actions)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const instanceResourceName = "thecloud_instance.test"
@@ -51,3 +52,81 @@ resource "thecloud_instance" "test" {
 		},
 	})
 }
+
+func TestAccInstanceResourceSecurityGroups(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceSecurityGroupsConfig(rName, "[thecloud_security_group.web.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceResourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(instanceResourceName, "security_group_ids.*", "thecloud_security_group.web", "id"),
+				),
+			},
+			// Adding a group happens in place
+			{
+				Config: testAccInstanceSecurityGroupsConfig(rName, "[thecloud_security_group.web.id, thecloud_security_group.ssh.id]"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(instanceResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceResourceName, "security_group_ids.#", "2"),
+				),
+			},
+			// Removing the attribute detaches the groups and leaves the VPC's default group
+			{
+				Config: testAccInstanceSecurityGroupsConfig(rName, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(instanceResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(instanceResourceName, "security_group_ids.#", "1"),
+				),
+			},
+			// The default group is not reported as drift
+			{
+				Config:   testAccInstanceSecurityGroupsConfig(rName, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceSecurityGroupsConfig(rName, securityGroupIDs string) string {
+	attachment := ""
+	if securityGroupIDs != "" {
+		attachment = "security_group_ids = " + securityGroupIDs
+	}
+
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "inst_vpc" {
+  name       = "inst-vpc-%[1]s"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_security_group" "web" {
+  name   = "web-%[1]s"
+  vpc_id = thecloud_vpc.inst_vpc.id
+}
+
+resource "thecloud_security_group" "ssh" {
+  name   = "ssh-%[1]s"
+  vpc_id = thecloud_vpc.inst_vpc.id
+}
+
+resource "thecloud_instance" "test" {
+  name   = "test-instance-%[1]s"
+  image  = "ubuntu-20.04"
+  vpc_id = thecloud_vpc.inst_vpc.id
+  %[2]s
+}
+`, rName, attachment)
+}
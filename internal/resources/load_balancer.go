@@ -12,8 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -21,6 +23,12 @@ import (
 var _ resource.Resource = &LoadBalancerResource{}
 var _ resource.ResourceWithImportState = &LoadBalancerResource{}
 
+// loadBalancerAlgorithms are the load balancing algorithms the API supports.
+var loadBalancerAlgorithms = []string{"round-robin", "least-connections"}
+
+// loadBalancerTLSVersions are the minimum TLS versions a TLS listener can require.
+var loadBalancerTLSVersions = []string{"1.2", "1.3"}
+
 func NewLoadBalancerResource() resource.Resource {
 	return &LoadBalancerResource{}
 }
@@ -32,12 +40,19 @@ type LoadBalancerResource struct {
 
 // LoadBalancerResourceModel describes the resource data model.
 type LoadBalancerResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	VpcID     types.String `tfsdk:"vpc_id"`
-	Port      types.Int64  `tfsdk:"port"`
-	Algorithm types.String `tfsdk:"algorithm"`
-	Status    types.String `tfsdk:"status"`
+	ID        types.String          `tfsdk:"id"`
+	Name      types.String          `tfsdk:"name"`
+	VpcID     types.String          `tfsdk:"vpc_id"`
+	Port      types.Int64           `tfsdk:"port"`
+	Algorithm types.String          `tfsdk:"algorithm"`
+	Status    types.String          `tfsdk:"status"`
+	TLS       *LoadBalancerTLSModel `tfsdk:"tls"`
+}
+
+// LoadBalancerTLSModel describes the tls block.
+type LoadBalancerTLSModel struct {
+	CertificateID types.String `tfsdk:"certificate_id"`
+	MinTLSVersion types.String `tfsdk:"min_tls_version"`
 }
 
 func (r *LoadBalancerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,6 +88,9 @@ func (r *LoadBalancerResource) Schema(ctx context.Context, req resource.SchemaRe
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Int64{
+					portValidator,
+				},
 			},
 			"algorithm": schema.StringAttribute{
 				Optional:            true,
@@ -81,10 +99,40 @@ func (r *LoadBalancerResource) Schema(ctx context.Context, req resource.SchemaRe
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: loadBalancerAlgorithms, ignoreCase: true},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the load balancer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"tls": schema.SingleNestedBlock{
+				MarkdownDescription: "Terminates TLS on the load balancer's port instead of serving plaintext. " +
+					"Changing `certificate_id` rotates the certificate in place; removing the block switches back to plaintext.",
+				Attributes: map[string]schema.Attribute{
+					"certificate_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The ID of the `thecloud_certificate` served to clients.",
+					},
+					"min_tls_version": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The oldest TLS version clients may negotiate (1.2, 1.3). Defaults to the API's minimum.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringOneOfValidator{values: loadBalancerTLSVersions},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -124,9 +172,10 @@ func (r *LoadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 		data.VpcID.ValueString(),
 		int(data.Port.ValueInt64()),
 		data.Algorithm.ValueString(),
+		data.TLS.expand(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create load balancer, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create load balancer", err)
 		return
 	}
 
@@ -134,8 +183,9 @@ func (r *LoadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 	data.Name = types.StringValue(lb.Name)
 	data.VpcID = types.StringValue(lb.VpcID)
 	data.Port = types.Int64Value(int64(lb.Port))
-	data.Algorithm = types.StringValue(lb.Algorithm)
+	data.Algorithm = stringValueIgnoringCase(data.Algorithm, lb.Algorithm)
 	data.Status = types.StringValue(lb.Status)
+	data.setTLS(lb.TLS)
 
 	tflog.Trace(ctx, "created a Load Balancer resource")
 
@@ -153,7 +203,7 @@ func (r *LoadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 
 	lb, err := r.client.GetLoadBalancer(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read load balancer, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read load balancer", err)
 		return
 	}
 
@@ -166,14 +216,40 @@ func (r *LoadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 	data.Name = types.StringValue(lb.Name)
 	data.VpcID = types.StringValue(lb.VpcID)
 	data.Port = types.Int64Value(int64(lb.Port))
-	data.Algorithm = types.StringValue(lb.Algorithm)
+	data.Algorithm = stringValueIgnoringCase(data.Algorithm, lb.Algorithm)
 	data.Status = types.StringValue(lb.Status)
+	data.setTLS(lb.TLS)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *LoadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update Not Supported", "Updating a load balancer is not currently supported by the API.")
+	var plan, state LoadBalancerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.AddWarning("Update Not Supported", "Renaming a load balancer is not currently supported by the API.")
+	}
+
+	// Only the TLS listener can be changed in place
+	if !plan.TLS.equal(state.TLS) {
+		lb, err := r.client.UpdateLoadBalancerTLS(ctx, plan.ID.ValueString(), plan.TLS.expand())
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to update load balancer TLS", err)
+			return
+		}
+		// The status keeps its planned value from state, or the apply would be
+		// inconsistent with the plan; the next read refreshes it
+		plan.setTLS(lb.TLS)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *LoadBalancerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -187,7 +263,7 @@ func (r *LoadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	err := r.client.DeleteLoadBalancer(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete load balancer, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete load balancer", err)
 		return
 	}
 
@@ -219,3 +295,34 @@ func (r *LoadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *LoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (m *LoadBalancerTLSModel) expand() *client.LBTLS {
+	if m == nil {
+		return nil
+	}
+	return &client.LBTLS{
+		CertificateID: m.CertificateID.ValueString(),
+		MinTLSVersion: m.MinTLSVersion.ValueString(),
+	}
+}
+
+// equal compares the configured TLS settings. An unknown min_tls_version is left to the
+// API, so it doesn't count as a change.
+func (m *LoadBalancerTLSModel) equal(other *LoadBalancerTLSModel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.CertificateID.Equal(other.CertificateID) &&
+		(m.MinTLSVersion.IsUnknown() || m.MinTLSVersion.Equal(other.MinTLSVersion))
+}
+
+func (m *LoadBalancerResourceModel) setTLS(tls *client.LBTLS) {
+	if tls == nil {
+		m.TLS = nil
+		return
+	}
+	m.TLS = &LoadBalancerTLSModel{
+		CertificateID: types.StringValue(tls.CertificateID),
+		MinTLSVersion: types.StringValue(tls.MinTLSVersion),
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -35,6 +37,8 @@ type LoadBalancerTargetResourceModel struct {
 	InstanceID     types.String `tfsdk:"instance_id"`
 	Port           types.Int64  `tfsdk:"port"`
 	Weight         types.Int64  `tfsdk:"weight"`
+	Healthy        types.Bool   `tfsdk:"healthy"`
+	HealthStatus   types.String `tfsdk:"health_status"`
 }
 
 func (r *LoadBalancerTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,6 +77,9 @@ func (r *LoadBalancerTargetResource) Schema(ctx context.Context, req resource.Sc
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Int64{
+					portValidator,
+				},
 			},
 			"weight": schema.Int64Attribute{
 				Optional:            true,
@@ -82,6 +89,14 @@ func (r *LoadBalancerTargetResource) Schema(ctx context.Context, req resource.Sc
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the target is currently passing health checks.",
+			},
+			"health_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The health check status reported for the target.",
+			},
 		},
 	}
 }
@@ -122,11 +137,38 @@ func (r *LoadBalancerTargetResource) Create(ctx context.Context, req resource.Cr
 
 	err := r.client.AddLBTarget(ctx, data.LoadBalancerID.ValueString(), target)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to add target to load balancer, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to add target to load balancer", err)
 		return
 	}
 
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.LoadBalancerID.ValueString(), data.InstanceID.ValueString()))
+	data.Healthy = types.BoolValue(false)
+	data.HealthStatus = types.StringValue("")
+
+	// Health is reported asynchronously, pick it up if the target is already listed. The
+	// target is added by now, so a failed read only leaves health to the next refresh.
+	targets, err := r.client.ListLBTargets(ctx, data.LoadBalancerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read load balancer targets",
+			fmt.Sprintf("The target was added, but its health could not be read and will be refreshed on the next plan: %s", err),
+		)
+	}
+
+	for _, t := range targets {
+		if t.InstanceID == data.InstanceID.ValueString() {
+			if data.Weight.IsUnknown() {
+				data.Weight = types.Int64Value(int64(t.Weight))
+			}
+			data.Healthy = types.BoolValue(t.Healthy)
+			data.HealthStatus = types.StringValue(t.Status)
+			break
+		}
+	}
+
+	if data.Weight.IsUnknown() {
+		data.Weight = types.Int64Value(int64(target.Weight))
+	}
 
 	tflog.Trace(ctx, "added a Load Balancer Target resource")
 
@@ -144,7 +186,7 @@ func (r *LoadBalancerTargetResource) Read(ctx context.Context, req resource.Read
 
 	targets, err := r.client.ListLBTargets(ctx, data.LoadBalancerID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read load balancer targets, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read load balancer targets", err)
 		return
 	}
 
@@ -153,6 +195,8 @@ func (r *LoadBalancerTargetResource) Read(ctx context.Context, req resource.Read
 		if t.InstanceID == data.InstanceID.ValueString() {
 			data.Port = types.Int64Value(int64(t.Port))
 			data.Weight = types.Int64Value(int64(t.Weight))
+			data.Healthy = types.BoolValue(t.Healthy)
+			data.HealthStatus = types.StringValue(t.Status)
 			found = true
 			break
 		}
@@ -181,7 +225,7 @@ func (r *LoadBalancerTargetResource) Delete(ctx context.Context, req resource.De
 
 	err := r.client.RemoveLBTarget(ctx, data.LoadBalancerID.ValueString(), data.InstanceID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to remove target from load balancer, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to remove target from load balancer", err)
 		return
 	}
 }
@@ -50,6 +50,7 @@ resource "thecloud_load_balancer_target" "test" {
 					resource.TestCheckResourceAttrSet(lbTargetResourceName, "id"),
 					resource.TestCheckResourceAttr(lbTargetResourceName, "port", "80"),
 					resource.TestCheckResourceAttr(lbTargetResourceName, "weight", "100"),
+					resource.TestCheckResourceAttrSet(lbTargetResourceName, "healthy"),
 				),
 			},
 		},
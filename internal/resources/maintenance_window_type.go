@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = maintenanceWindowType{}
+
+// maintenanceWindowType is a string holding a ddd:hh24:mi-ddd:hh24:mi window. The API
+// returns the window in its normalized form, so values that only differ by the case of
+// the day names are semantically equal.
+type maintenanceWindowType struct {
+	basetypes.StringType
+}
+
+func (t maintenanceWindowType) Equal(o attr.Type) bool {
+	other, ok := o.(maintenanceWindowType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t maintenanceWindowType) String() string {
+	return "maintenanceWindowType"
+}
+
+func (t maintenanceWindowType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return maintenanceWindowValue{StringValue: in}, nil
+}
+
+func (t maintenanceWindowType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t maintenanceWindowType) ValueType(ctx context.Context) attr.Value {
+	return maintenanceWindowValue{}
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = maintenanceWindowValue{}
+
+// maintenanceWindowValue is a value of maintenanceWindowType.
+type maintenanceWindowValue struct {
+	basetypes.StringValue
+}
+
+// newMaintenanceWindowValue returns the window reported by the API, or a null value
+// when the API reports none.
+func newMaintenanceWindowValue(value string) maintenanceWindowValue {
+	if value == "" {
+		return maintenanceWindowValue{StringValue: basetypes.NewStringNull()}
+	}
+	return maintenanceWindowValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v maintenanceWindowValue) Equal(o attr.Value) bool {
+	other, ok := o.(maintenanceWindowValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v maintenanceWindowValue) Type(ctx context.Context) attr.Type {
+	return maintenanceWindowType{}
+}
+
+func (v maintenanceWindowValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(maintenanceWindowValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal := func(a, b string) bool {
+		ok, diags := newMaintenanceWindowValue(a).StringSemanticEquals(ctx, newMaintenanceWindowValue(b))
+		assert.False(t, diags.HasError())
+		return ok
+	}
+
+	assert.True(t, equal("Sun:03:00-Sun:04:00", "sun:03:00-sun:04:00"))
+	assert.True(t, equal("sun:03:00-sun:04:00", "sun:03:00-sun:04:00"))
+	assert.False(t, equal("sun:03:00-sun:04:00", "sat:03:00-sat:04:00"))
+	assert.True(t, newMaintenanceWindowValue("").IsNull())
+}
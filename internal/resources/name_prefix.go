@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// uniqueNameSuffixAlphabet and uniqueNameSuffixLength make up the random part of a name
+// generated from a name_prefix. Lowercase letters and digits are valid in every name.
+const (
+	uniqueNameSuffixAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	uniqueNameSuffixLength   = 10
+)
+
+// uniqueName returns prefix followed by a random suffix.
+func uniqueName(prefix string) string {
+	b := make([]byte, uniqueNameSuffixLength)
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(b)
+	for i := range b {
+		b[i] = uniqueNameSuffixAlphabet[int(b[i])%len(uniqueNameSuffixAlphabet)]
+	}
+	return prefix + string(b)
+}
+
+// namePrefixRequiresReplace replaces the object when name_prefix changes, unless its
+// current name already starts with the new prefix. That keeps imported objects, which
+// have no name_prefix in state, and objects switched from name to a matching prefix.
+func namePrefixRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			// Removing the prefix leaves the name to decide
+			if req.PlanValue.IsNull() {
+				return
+			}
+			if req.PlanValue.IsUnknown() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			var name types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath().AtName("name"), &name)...)
+			resp.RequiresReplace = !strings.HasPrefix(name.ValueString(), req.PlanValue.ValueString())
+		},
+		"Changing name_prefix replaces the resource unless its current name already starts with the new prefix.",
+		"Changing `name_prefix` replaces the resource unless its current name already starts with the new prefix.",
+	)
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueName(t *testing.T) {
+	name := uniqueName("web-")
+	assert.True(t, strings.HasPrefix(name, "web-"))
+	assert.Len(t, name, len("web-")+uniqueNameSuffixLength)
+	assert.Regexp(t, `^web-[a-z0-9]+$`, name)
+	assert.NotEqual(t, name, uniqueName("web-"))
+}
+
+func TestNamePrefixRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+	r := NewInstanceResource()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	raw := func(name string, prefix types.String) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for attrName, attrType := range objectType.AttributeTypes {
+			values[attrName] = tftypes.NewValue(attrType, nil)
+		}
+		values["id"] = tftypes.NewValue(tftypes.String, "inst-1")
+		values["name"] = tftypes.NewValue(tftypes.String, name)
+		if !prefix.IsNull() {
+			values["name_prefix"] = tftypes.NewValue(tftypes.String, prefix.ValueString())
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	tests := map[string]struct {
+		stateName   string
+		statePrefix types.String
+		planPrefix  types.String
+		want        bool
+	}{
+		"imported with matching prefix": {stateName: "web-abc123", statePrefix: types.StringNull(), planPrefix: types.StringValue("web-"), want: false},
+		"prefix changed":                {stateName: "web-abc123", statePrefix: types.StringValue("web-"), planPrefix: types.StringValue("api-"), want: true},
+		"prefix removed":                {stateName: "web-abc123", statePrefix: types.StringValue("web-"), planPrefix: types.StringNull(), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:        path.Root("name_prefix"),
+				State:       tfsdk.State{Schema: schemaResp.Schema, Raw: raw(tt.stateName, tt.statePrefix)},
+				Plan:        tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw(tt.stateName, tt.planPrefix)},
+				StateValue:  tt.statePrefix,
+				PlanValue:   tt.planPrefix,
+				ConfigValue: tt.planPrefix,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.planPrefix}
+			namePrefixRequiresReplace().PlanModifyString(ctx, req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.want, resp.RequiresReplace)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const natGatewayStatusAvailable = "available"
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &NATGatewayResource{}
+var _ resource.ResourceWithImportState = &NATGatewayResource{}
+
+func NewNATGatewayResource() resource.Resource {
+	return &NATGatewayResource{}
+}
+
+// NATGatewayResource defines the resource implementation.
+type NATGatewayResource struct {
+	client *client.Client
+}
+
+// NATGatewayResourceModel describes the resource data model.
+type NATGatewayResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	SubnetID    types.String   `tfsdk:"subnet_id"`
+	ElasticIPID types.String   `tfsdk:"elastic_ip_id"`
+	PrivateIP   types.String   `tfsdk:"private_ip"`
+	Status      types.String   `tfsdk:"status"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *NATGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nat_gateway"
+}
+
+func (r *NATGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "NAT Gateway resource gives instances in private subnets outbound internet access through an Elastic IP. " +
+			"Destroying the gateway waits until it is gone, so the Elastic IP it holds can be released in the same apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the NAT gateway.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the subnet to place the NAT gateway in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"elastic_ip_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Elastic IP that outbound traffic leaves from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The private IP address of the NAT gateway in its subnet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the NAT gateway.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *NATGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NATGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NATGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nat, err := r.client.CreateNATGateway(ctx, data.SubnetID.ValueString(), data.ElasticIPID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create NAT gateway", err)
+		return
+	}
+
+	// Routes that point at a gateway which is still pending are rejected
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := r.client.GetNATGateway(ctx, nat.ID)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		nat = current
+		return current.Status, false, nil
+	}, []string{natGatewayStatusAvailable}, []string{"pending", "creating", "provisioning"}, client.WaitOpts{Timeout: createTimeout})
+
+	data.ID = types.StringValue(nat.ID)
+	data.setNATGateway(nat)
+
+	// The gateway is saved even when it didn't become available, so it's tainted
+	// rather than left untracked
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Create Timeout", fmt.Sprintf("Timed out waiting for NAT gateway %s to become available. Last observed status: %q.", nat.ID, timeoutErr.LastStatus))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, fmt.Sprintf("NAT gateway %s did not become available", nat.ID), err)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a NAT Gateway resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NATGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NATGatewayResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nat, err := r.client.GetNATGateway(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read NAT gateway", err)
+		return
+	}
+
+	if nat == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(nat.ID)
+	data.setNATGateway(nat)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NATGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddWarning("Update Not Supported", "Updating a NAT gateway is not supported. It will be recreated if changed.")
+}
+
+func (r *NATGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NATGatewayResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNATGateway(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete NAT gateway", err)
+		return
+	}
+
+	// The Elastic IP cannot be released until the gateway is gone
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		nat, err := r.client.GetNATGateway(ctx, data.ID.ValueString())
+		if err != nil || nat == nil {
+			return "", nat == nil, err
+		}
+		return nat.Status, false, nil
+	}, nil, nil, client.WaitOpts{Timeout: deleteTimeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for NAT gateway %s to be deleted. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking NAT gateway status", err)
+		return
+	}
+
+	tflog.Trace(ctx, "NAT gateway successfully deleted")
+}
+
+func (r *NATGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *NATGatewayResourceModel) setNATGateway(nat *client.NATGateway) {
+	m.SubnetID = types.StringValue(nat.SubnetID)
+	m.ElasticIPID = types.StringValue(nat.ElasticIPID)
+	m.PrivateIP = types.StringValue(nat.PrivateIP)
+	m.Status = types.StringValue(nat.Status)
+}
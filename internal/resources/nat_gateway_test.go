@@ -0,0 +1,56 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const natGatewayResourceName = "thecloud_nat_gateway.test"
+
+func TestAccNATGatewayResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	// The final destroy deletes the gateway and releases its Elastic IP in one apply
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "nat_vpc" {
+  name       = "nat-vpc-%[1]s"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_subnet" "nat_subnet" {
+  vpc_id     = thecloud_vpc.nat_vpc.id
+  name       = "nat-subnet-%[1]s"
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "thecloud_elastic_ip" "nat_eip" {}
+
+resource "thecloud_nat_gateway" "test" {
+  subnet_id     = thecloud_subnet.nat_subnet.id
+  elastic_ip_id = thecloud_elastic_ip.nat_eip.id
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(natGatewayResourceName, "status", "available"),
+					resource.TestCheckResourceAttrPair(natGatewayResourceName, "elastic_ip_id", "thecloud_elastic_ip.nat_eip", "id"),
+					resource.TestCheckResourceAttrSet(natGatewayResourceName, "private_ip"),
+					resource.TestCheckResourceAttrSet(natGatewayResourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      natGatewayResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
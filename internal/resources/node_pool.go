@@ -0,0 +1,268 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &NodePoolResource{}
+var _ resource.ResourceWithImportState = &NodePoolResource{}
+
+func NewNodePoolResource() resource.Resource {
+	return &NodePoolResource{}
+}
+
+// NodePoolResource defines the resource implementation.
+type NodePoolResource struct {
+	client *client.Client
+}
+
+// NodePoolResourceModel describes the resource data model.
+type NodePoolResourceModel struct {
+	ID          types.String `tfsdk:"id"` // Format: {cluster_id}:{pool_id}
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	PoolID      types.String `tfsdk:"pool_id"`
+	Name        types.String `tfsdk:"name"`
+	WorkerCount types.Int64  `tfsdk:"worker_count"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (r *NodePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_pool"
+}
+
+func (r *NodePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Node Pool resource manages a group of worker nodes in a cluster. " +
+			"Once a cluster has node pools, its workers are scaled through the pools rather than the cluster's `worker_count`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composite ID of the node pool (cluster_id:pool_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the cluster the node pool belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pool_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the node pool within the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the node pool.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"worker_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of worker nodes in the pool. Changing it scales the pool in place.",
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(0),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Kubernetes labels applied to every node in the pool. Changing them relabels the nodes in place.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the node pool.",
+			},
+		},
+	}
+}
+
+func (r *NodePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NodePoolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := client.NodePool{
+		Name:        data.Name.ValueString(),
+		WorkerCount: int(data.WorkerCount.ValueInt64()),
+	}
+	if !data.Labels.IsNull() {
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &pool.Labels, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNodePool(ctx, data.ClusterID.ValueString(), pool)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Node Pool", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.ClusterID.ValueString(), created.ID))
+	resp.Diagnostics.Append(data.setNodePool(ctx, created)...)
+
+	tflog.Trace(ctx, "created a Node Pool resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NodePoolResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.GetNodePool(ctx, data.ClusterID.ValueString(), data.PoolID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Node Pool", err)
+		return
+	}
+
+	if pool == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(pool.Name)
+	data.WorkerCount = types.Int64Value(int64(pool.WorkerCount))
+	resp.Diagnostics.Append(data.setNodePool(ctx, pool)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NodePoolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdateNodePoolRequest{Labels: map[string]string{}}
+	if !plan.WorkerCount.Equal(state.WorkerCount) {
+		workers := int(plan.WorkerCount.ValueInt64())
+		updateReq.WorkerCount = &workers
+	}
+	if !plan.Labels.IsNull() {
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &updateReq.Labels, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.UpdateNodePool(ctx, state.ClusterID.ValueString(), state.PoolID.ValueString(), updateReq)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Node Pool", err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setNodePool(ctx, pool)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NodePoolResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNodePool(ctx, data.ClusterID.ValueString(), data.PoolID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Node Pool", err)
+		return
+	}
+}
+
+func (r *NodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import requires cluster_id:pool_id
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: cluster_id:pool_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setNodePool copies the computed attributes and labels reported by the API into the
+// model. Labels stay null when none are configured and the pool has none.
+func (m *NodePoolResourceModel) setNodePool(ctx context.Context, pool *client.NodePool) diag.Diagnostics {
+	m.PoolID = types.StringValue(pool.ID)
+	m.Status = types.StringValue(pool.Status)
+
+	if len(pool.Labels) == 0 && m.Labels.IsNull() {
+		return nil
+	}
+
+	labels := pool.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	value, diags := types.MapValueFrom(ctx, types.StringType, labels)
+	m.Labels = value
+	return diags
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWorkerCountChange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var pools []client.NodePool
+		if r.URL.Path == "/clusters/cl-pools/node-pools" {
+			pools = []client.NodePool{{ID: "np-1", Name: "workers", WorkerCount: 3}}
+		}
+
+		raw, err := json.Marshal(pools)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	r := &ClusterResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+	ctx := context.Background()
+
+	assert.False(t, r.validateWorkerCountChange(ctx, "cl-plain", types.Int64Value(5)).HasError())
+
+	diags := r.validateWorkerCountChange(ctx, "cl-pools", types.Int64Value(5))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Conflicting Worker Count", diags[0].Summary())
+
+	// An unset worker_count follows the node pools
+	assert.False(t, r.validateWorkerCountChange(ctx, "cl-pools", types.Int64Unknown()).HasError())
+	assert.False(t, r.validateWorkerCountChange(ctx, "cl-pools", types.Int64Null()).HasError())
+
+	// Without a configured provider there is nothing to check against
+	assert.False(t, (&ClusterResource{}).validateWorkerCountChange(ctx, "cl-pools", types.Int64Value(5)).HasError())
+}
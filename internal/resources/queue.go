@@ -7,16 +7,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &QueueResource{}
 var _ resource.ResourceWithImportState = &QueueResource{}
+var _ resource.ResourceWithConfigValidators = &QueueResource{}
 
 func NewQueueResource() resource.Resource {
 	return &QueueResource{}
@@ -36,6 +41,8 @@ type QueueResourceModel struct {
 	RetentionDays     types.Int64  `tfsdk:"retention_days"`
 	MaxMessageSize    types.Int64  `tfsdk:"max_message_size"`
 	Status            types.String `tfsdk:"status"`
+	DeadLetterQueueID types.String `tfsdk:"dead_letter_queue_id"`
+	MaxReceiveCount   types.Int64  `tfsdk:"max_receive_count"`
 }
 
 func (r *QueueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,25 +71,51 @@ func (r *QueueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"arn": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The Amazon Resource Name (ARN) of the queue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"visibility_timeout": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Seconds a message remains hidden after retrieval.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"retention_days": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Days before non-deleted messages are purged.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"max_message_size": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Maximum payload size in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the queue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dead_letter_queue_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the `thecloud_queue` that receives messages which failed processing `max_receive_count` times. Must be set with `max_receive_count`.",
+			},
+			"max_receive_count": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How many times a message is received without being deleted before it's moved to the dead-letter queue. Must be set with `dead_letter_queue_id`.",
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
 			},
 		},
 	}
@@ -107,6 +140,12 @@ func (r *QueueResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+func (r *QueueResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		requiredTogether(path.Root("dead_letter_queue_id"), path.Root("max_receive_count")),
+	}
+}
+
 func (r *QueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data QueueResourceModel
 
@@ -129,10 +168,11 @@ func (r *QueueResource) Create(ctx context.Context, req resource.CreateRequest,
 		v := int(data.MaxMessageSize.ValueInt64())
 		opts.MaxMessageSize = &v
 	}
+	opts.RedrivePolicy = data.redrivePolicy()
 
 	q, err := r.client.CreateQueue(ctx, data.Name.ValueString(), opts)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Queue, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Queue", err)
 		return
 	}
 
@@ -143,6 +183,8 @@ func (r *QueueResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.RetentionDays = types.Int64Value(int64(q.RetentionDays))
 	data.MaxMessageSize = types.Int64Value(int64(q.MaxMessageSize))
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, q.ETag)...)
+
 	tflog.Trace(ctx, "created a Queue resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -159,7 +201,7 @@ func (r *QueueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	q, err := r.client.GetQueue(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Queue, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Queue", err)
 		return
 	}
 
@@ -175,12 +217,58 @@ func (r *QueueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.RetentionDays = types.Int64Value(int64(q.RetentionDays))
 	data.MaxMessageSize = types.Int64Value(int64(q.MaxMessageSize))
 	data.Status = types.StringValue(q.Status)
+	data.setRedrivePolicy(q.RedrivePolicy)
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, q.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *QueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported by current API, use RequiresReplace
+	var data QueueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.UpdateQueueOptions{RedrivePolicy: data.redrivePolicy()}
+	if !data.VisibilityTimeout.IsUnknown() {
+		v := int(data.VisibilityTimeout.ValueInt64())
+		opts.VisibilityTimeout = &v
+	}
+	if !data.RetentionDays.IsUnknown() {
+		v := int(data.RetentionDays.ValueInt64())
+		opts.RetentionDays = &v
+	}
+	if !data.MaxMessageSize.IsUnknown() {
+		v := int(data.MaxMessageSize.ValueInt64())
+		opts.MaxMessageSize = &v
+	}
+
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opts.ETag = etag
+
+	q, err := r.client.UpdateQueue(ctx, data.ID.ValueString(), opts)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Queue", err)
+		return
+	}
+
+	data.ARN = types.StringValue(q.ARN)
+	data.Status = types.StringValue(q.Status)
+	data.VisibilityTimeout = types.Int64Value(int64(q.VisibilityTimeout))
+	data.RetentionDays = types.Int64Value(int64(q.RetentionDays))
+	data.MaxMessageSize = types.Int64Value(int64(q.MaxMessageSize))
+
+	tflog.Trace(ctx, "updated a Queue resource")
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, q.ETag)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *QueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -194,11 +282,35 @@ func (r *QueueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteQueue(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete Queue, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Queue", err)
 		return
 	}
 }
 
 func (r *QueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrName(ctx, req, resp, "Queue", r.client.ListQueues, func(queue client.Queue) (string, string) {
+		return queue.ID, queue.Name
+	})
+}
+
+// redrivePolicy returns the configured redrive policy, or nil when there's no
+// dead-letter queue.
+func (m QueueResourceModel) redrivePolicy() *client.RedrivePolicy {
+	if m.DeadLetterQueueID.IsNull() {
+		return nil
+	}
+	return &client.RedrivePolicy{
+		DeadLetterQueueID: m.DeadLetterQueueID.ValueString(),
+		MaxReceiveCount:   int(m.MaxReceiveCount.ValueInt64()),
+	}
+}
+
+func (m *QueueResourceModel) setRedrivePolicy(policy *client.RedrivePolicy) {
+	if policy == nil {
+		m.DeadLetterQueueID = types.StringNull()
+		m.MaxReceiveCount = types.Int64Null()
+		return
+	}
+	m.DeadLetterQueueID = types.StringValue(policy.DeadLetterQueueID)
+	m.MaxReceiveCount = types.Int64Value(int64(policy.MaxReceiveCount))
 }
@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const (
+	queueRedriveStatusCompleted    = "completed"
+	queueRedriveStatusFailed       = "failed"
+	defaultQueueRedriveWaitTimeout = 30 * time.Minute
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &QueueRedriveTaskResource{}
+var _ resource.ResourceWithValidateConfig = &QueueRedriveTaskResource{}
+
+func NewQueueRedriveTaskResource() resource.Resource {
+	return &QueueRedriveTaskResource{}
+}
+
+// QueueRedriveTaskResource defines the resource implementation.
+type QueueRedriveTaskResource struct {
+	client *client.Client
+}
+
+// QueueRedriveTaskResourceModel describes the resource data model.
+type QueueRedriveTaskResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	SourceQueueID      types.String   `tfsdk:"source_queue_id"`
+	DestinationQueueID types.String   `tfsdk:"destination_queue_id"`
+	Status             types.String   `tfsdk:"status"`
+	MovedMessageCount  types.Int64    `tfsdk:"moved_message_count"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	CompletedAt        types.String   `tfsdk:"completed_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *QueueRedriveTaskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_redrive_task"
+}
+
+func (r *QueueRedriveTaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queue Redrive Task resource moves the messages of a queue, usually a dead-letter queue, to another queue, " +
+			"and waits for the move to complete. The task is run once, when the resource is created; changing either queue starts a new task. " +
+			"Destroying the resource only removes it from the state, as finished tasks are kept by the API as history.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the redrive task.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_queue_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the queue to move the messages from, e.g. a dead-letter queue.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_queue_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the queue to move the messages to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the redrive task.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"moved_message_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of messages moved to the destination queue.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the redrive task was started.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the redrive task finished.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *QueueRedriveTaskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueRedriveTaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var source, destination types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_queue_id"), &source)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destination_queue_id"), &destination)...)
+
+	if resp.Diagnostics.HasError() || source.IsNull() || source.IsUnknown() || destination.IsUnknown() {
+		return
+	}
+
+	if source.Equal(destination) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination_queue_id"),
+			"Invalid Redrive Destination",
+			"The destination queue must differ from the source queue.",
+		)
+	}
+}
+
+func (r *QueueRedriveTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data QueueRedriveTaskResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultQueueRedriveWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.StartQueueRedrive(ctx, data.SourceQueueID.ValueString(), data.DestinationQueueID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to start queue redrive", err)
+		return
+	}
+
+	data.ID = types.StringValue(task.ID)
+	data.setTask(task)
+
+	tflog.Trace(ctx, "started a Queue Redrive Task")
+
+	task, diags = waitForQueueRedriveCompleted(ctx, r.client, task, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	data.setTask(task)
+
+	// The task is saved even when it failed, so the resource is tainted and the next
+	// apply starts a new task for the messages that weren't moved
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueRedriveTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data QueueRedriveTaskResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.client.GetQueueRedriveTask(ctx, data.SourceQueueID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read queue redrive task", err)
+		return
+	}
+
+	if task == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setTask(task)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only records new timeouts. Every other change replaces the task.
+func (r *QueueRedriveTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data QueueRedriveTaskResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the task from the state. Redrive tasks are kept by the API as history
+// and the moved messages aren't moved back.
+func (r *QueueRedriveTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed Queue Redrive Task resource from state, the task was left in place")
+}
+
+func (m *QueueRedriveTaskResourceModel) setTask(task *client.QueueRedriveTask) {
+	m.SourceQueueID = types.StringValue(task.SourceQueueID)
+	m.DestinationQueueID = types.StringValue(task.DestinationQueueID)
+	m.Status = types.StringValue(task.Status)
+	m.MovedMessageCount = types.Int64Value(int64(task.MovedMessageCount))
+	m.CreatedAt = types.StringValue(task.CreatedAt)
+	m.CompletedAt = stringValueOrNull(task.CompletedAt)
+}
+
+// waitForQueueRedriveCompleted polls a redrive task until it finishes and returns it.
+// A task that fails midway is reported with how far it got, as the messages it moved
+// stay in the destination queue. The last observed task is returned with any error.
+func waitForQueueRedriveCompleted(ctx context.Context, c *client.Client, task *client.QueueRedriveTask, timeout time.Duration) (*client.QueueRedriveTask, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	last := task
+
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetQueueRedriveTask(ctx, task.SourceQueueID, task.ID)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		last = current
+		return current.Status, false, nil
+	}, []string{queueRedriveStatusCompleted, queueRedriveStatusFailed}, []string{"pending", "running"}, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError("Create Timeout", fmt.Sprintf("Timed out waiting for queue redrive task %s to complete. %s Last observed status: %q.",
+			task.ID, describeRedriveProgress(last), timeoutErr.LastStatus))
+		return last, diags
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Queue redrive task %s did not complete", task.ID), err)
+		return last, diags
+	}
+
+	if strings.EqualFold(last.Status, queueRedriveStatusFailed) {
+		reason := last.FailureReason
+		if reason == "" {
+			reason = "no reason was given"
+		}
+		diags.AddError(
+			"Queue Redrive Failed",
+			fmt.Sprintf("Queue redrive task %s from queue %s to queue %s failed: %s. %s The moved messages are not moved back, and the rest remain in queue %s.",
+				last.ID, last.SourceQueueID, last.DestinationQueueID, reason, describeRedriveProgress(last), last.SourceQueueID),
+		)
+	}
+
+	return last, diags
+}
+
+// describeRedriveProgress tells how many messages a redrive task has moved so far.
+func describeRedriveProgress(task *client.QueueRedriveTask) string {
+	if task.TotalMessageCount > 0 {
+		return fmt.Sprintf("%d of %d messages were moved.", task.MovedMessageCount, task.TotalMessageCount)
+	}
+	return fmt.Sprintf("%d messages were moved.", task.MovedMessageCount)
+}
@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// redriveServer answers for the redrive tasks in tasks, keyed by their GET path, and
+// starts task rd-new on POST.
+func redriveServer(t *testing.T, tasks map[string]client.QueueRedriveTask) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if r.Method == http.MethodPost {
+			assert.Equal(t, "/queues/q-dlq/redrive", path)
+			path = "/queues/q-dlq/redrive/rd-new"
+		}
+		task, ok := tasks[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(task)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+}
+
+func TestWaitForQueueRedriveCompleted(t *testing.T) {
+	server := redriveServer(t, map[string]client.QueueRedriveTask{
+		"/queues/q-dlq/redrive/rd-done":    {ID: "rd-done", SourceQueueID: "q-dlq", Status: "COMPLETED", MovedMessageCount: 42},
+		"/queues/q-dlq/redrive/rd-running": {ID: "rd-running", SourceQueueID: "q-dlq", Status: "running", MovedMessageCount: 3, TotalMessageCount: 10},
+		"/queues/q-dlq/redrive/rd-failed": {
+			ID: "rd-failed", SourceQueueID: "q-dlq", DestinationQueueID: "q-main", Status: "failed",
+			MovedMessageCount: 7, TotalMessageCount: 12, FailureReason: "destination queue is full",
+		},
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	task, diags := waitForQueueRedriveCompleted(ctx, c, &client.QueueRedriveTask{ID: "rd-done", SourceQueueID: "q-dlq"}, time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 42, task.MovedMessageCount)
+
+	task, diags = waitForQueueRedriveCompleted(ctx, c, &client.QueueRedriveTask{ID: "rd-running", SourceQueueID: "q-dlq"}, time.Millisecond)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Create Timeout", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "3 of 10 messages were moved")
+	assert.Equal(t, "rd-running", task.ID)
+
+	// A task that fails midway is reported with how far it got
+	task, diags = waitForQueueRedriveCompleted(ctx, c, &client.QueueRedriveTask{ID: "rd-failed", SourceQueueID: "q-dlq"}, time.Minute)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Queue Redrive Failed", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "destination queue is full")
+	assert.Contains(t, diags[0].Detail(), "7 of 12 messages were moved")
+	assert.Equal(t, 7, task.MovedMessageCount)
+}
+
+func TestQueueRedriveTaskCreateFailedKeepsState(t *testing.T) {
+	server := redriveServer(t, map[string]client.QueueRedriveTask{
+		"/queues/q-dlq/redrive/rd-new": {
+			ID: "rd-new", SourceQueueID: "q-dlq", DestinationQueueID: "q-main", Status: "failed",
+			MovedMessageCount: 7, TotalMessageCount: 12, FailureReason: "destination queue is full",
+		},
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &QueueRedriveTaskResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, plan.Set(ctx, QueueRedriveTaskResourceModel{
+		ID:                 types.StringUnknown(),
+		SourceQueueID:      types.StringValue("q-dlq"),
+		DestinationQueueID: types.StringValue("q-main"),
+		Status:             types.StringUnknown(),
+		MovedMessageCount:  types.Int64Unknown(),
+		CreatedAt:          types.StringUnknown(),
+		CompletedAt:        types.StringUnknown(),
+		Timeouts:           timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})},
+	}).HasError())
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Queue Redrive Failed", resp.Diagnostics[0].Summary())
+
+	// The failed task is kept, tainted, so the next apply retries the rest of the messages
+	var data QueueRedriveTaskResourceModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "rd-new", data.ID.ValueString())
+	assert.Equal(t, "failed", data.Status.ValueString())
+	assert.Equal(t, int64(7), data.MovedMessageCount.ValueInt64())
+	assert.True(t, data.CompletedAt.IsNull())
+}
@@ -0,0 +1,70 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const queueResourceName = "thecloud_queue.test"
+
+func TestAccQueueResourceUpdate(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	queueName := fmt.Sprintf("test-queue-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig(queueName, 30, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "visibility_timeout", "30"),
+					resource.TestCheckNoResourceAttr(queueResourceName, "dead_letter_queue_id"),
+				),
+			},
+			// Tuning the queue and adding a dead-letter queue happen in place
+			{
+				Config: testAccQueueConfig(queueName, 60, `
+  dead_letter_queue_id = thecloud_queue.dlq.id
+  max_receive_count    = 5
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(queueResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(queueResourceName, "visibility_timeout", "60"),
+					resource.TestCheckResourceAttrPair(queueResourceName, "dead_letter_queue_id", "thecloud_queue.dlq", "id"),
+					resource.TestCheckResourceAttr(queueResourceName, "max_receive_count", "5"),
+				),
+			},
+			// Removing it does too
+			{
+				Config: testAccQueueConfig(queueName, 60, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(queueResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckNoResourceAttr(queueResourceName, "dead_letter_queue_id"),
+			},
+		},
+	})
+}
+
+func testAccQueueConfig(name string, visibilityTimeout int, extra string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "thecloud_queue" "test" {
+  name               = "%[1]s"
+  visibility_timeout = %[2]d
+%[3]s}
+`, name, visibilityTimeout, extra)
+}
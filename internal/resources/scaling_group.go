@@ -2,23 +2,31 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &ScalingGroupResource{}
 var _ resource.ResourceWithImportState = &ScalingGroupResource{}
+var _ resource.ResourceWithModifyPlan = &ScalingGroupResource{}
+var _ resource.ResourceWithConfigValidators = &ScalingGroupResource{}
 
 func NewScalingGroupResource() resource.Resource {
 	return &ScalingGroupResource{}
@@ -37,12 +45,32 @@ type ScalingGroupResourceModel struct {
 	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
 	Image          types.String `tfsdk:"image"`
 	Ports          types.String `tfsdk:"ports"`
+	InstanceSize   types.String `tfsdk:"instance_size"`
+	GPUType        types.String `tfsdk:"gpu_type"`
+	GPUCount       types.Int64  `tfsdk:"gpu_count"`
 	MinInstances   types.Int64  `tfsdk:"min_instances"`
 	MaxInstances   types.Int64  `tfsdk:"max_instances"`
 	DesiredCount   types.Int64  `tfsdk:"desired_count"`
 	Status         types.String `tfsdk:"status"`
+	InstanceIDs    types.List   `tfsdk:"instance_ids"`
+	Instances      types.List   `tfsdk:"instances"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ScalingGroupInstanceModel describes an instance currently in a scaling group.
+type ScalingGroupInstanceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	Status    types.String `tfsdk:"status"`
 }
 
+var scalingGroupInstanceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":         types.StringType,
+	"ip_address": types.StringType,
+	"status":     types.StringType,
+}}
+
 func (r *ScalingGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_scaling_group"
 }
@@ -94,6 +122,30 @@ func (r *ScalingGroupResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"instance_size": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The instance size for instances in the group. Must support the architecture of the selected image.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gpu_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The GPU accelerator type to attach to each instance in the group, e.g. `nvidia-a100`. Must be set together with `gpu_count`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gpu_count": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of GPUs to attach to each instance in the group, from 1 to 8. Must be set together with `gpu_type`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64BetweenValidator{min: 1, max: maxGPUCount},
+				},
+			},
 			"min_instances": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
@@ -123,10 +175,44 @@ func (r *ScalingGroupResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"instance_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the instances currently in the group. The group scales on its own, so the list is refreshed on every read.",
+			},
+			"instances": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The instances currently in the group.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the instance.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of the instance.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the instance.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
 
+func (r *ScalingGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		requiredTogether(path.Root("gpu_type"), path.Root("gpu_count")),
+	}
+}
+
 func (r *ScalingGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -155,6 +241,19 @@ func (r *ScalingGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultImageWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(waitForImageAvailable(ctx, r.client, data.Image.ValueString(), createTimeout)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := map[string]interface{}{
 		"name":          data.Name.ValueString(),
 		"vpc_id":        data.VpcID.ValueString(),
@@ -168,10 +267,17 @@ func (r *ScalingGroupResource) Create(ctx context.Context, req resource.CreateRe
 	if !data.LoadBalancerID.IsNull() {
 		params["load_balancer_id"] = data.LoadBalancerID.ValueString()
 	}
+	if !data.InstanceSize.IsNull() {
+		params["instance_size"] = data.InstanceSize.ValueString()
+	}
+	if !data.GPUType.IsNull() {
+		params["gpu_type"] = data.GPUType.ValueString()
+		params["gpu_count"] = int(data.GPUCount.ValueInt64())
+	}
 
 	group, err := r.client.CreateScalingGroup(ctx, params)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create scaling group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create scaling group", err)
 		return
 	}
 
@@ -181,6 +287,21 @@ func (r *ScalingGroupResource) Create(ctx context.Context, req resource.CreateRe
 	} else {
 		data.Ports = types.StringNull()
 	}
+	if !data.InstanceSize.IsNull() || group.InstanceSize != "" {
+		data.InstanceSize = types.StringValue(group.InstanceSize)
+	} else {
+		data.InstanceSize = types.StringNull()
+	}
+	if !data.GPUType.IsNull() || group.GPUType != "" {
+		data.GPUType = types.StringValue(group.GPUType)
+	} else {
+		data.GPUType = types.StringNull()
+	}
+	if !data.GPUCount.IsNull() || group.GPUCount != 0 {
+		data.GPUCount = types.Int64Value(int64(group.GPUCount))
+	} else {
+		data.GPUCount = types.Int64Null()
+	}
 	if !data.LoadBalancerID.IsNull() || group.LoadBalancerID != "" {
 		data.LoadBalancerID = types.StringValue(group.LoadBalancerID)
 	} else {
@@ -188,6 +309,17 @@ func (r *ScalingGroupResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	data.Status = types.StringValue(group.Status)
 
+	// The group exists at this point, so a failed listing must not fail the create and
+	// leave it untracked; the next refresh fills the list in
+	instances, err := r.client.ListScalingGroupInstances(ctx, group.ID)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to List Scaling Group Instances", fmt.Sprintf("Unable to list the instances of scaling group %s, got error: %s", group.ID, err))
+		instances = []client.ScalingGroupInstance{}
+	}
+	resp.Diagnostics.Append(data.setInstances(ctx, instances)...)
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, group.ETag)...)
+
 	tflog.Trace(ctx, "created a Scaling Group resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -204,7 +336,7 @@ func (r *ScalingGroupResource) Read(ctx context.Context, req resource.ReadReques
 
 	group, err := r.client.GetScalingGroup(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read scaling group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read scaling group", err)
 		return
 	}
 
@@ -227,11 +359,34 @@ func (r *ScalingGroupResource) Read(ctx context.Context, req resource.ReadReques
 	} else {
 		data.Ports = types.StringNull()
 	}
+	if !data.InstanceSize.IsNull() || group.InstanceSize != "" {
+		data.InstanceSize = types.StringValue(group.InstanceSize)
+	} else {
+		data.InstanceSize = types.StringNull()
+	}
+	if !data.GPUType.IsNull() || group.GPUType != "" {
+		data.GPUType = types.StringValue(group.GPUType)
+	} else {
+		data.GPUType = types.StringNull()
+	}
+	if !data.GPUCount.IsNull() || group.GPUCount != 0 {
+		data.GPUCount = types.Int64Value(int64(group.GPUCount))
+	} else {
+		data.GPUCount = types.Int64Null()
+	}
 	data.MinInstances = types.Int64Value(int64(group.MinInstances))
 	data.MaxInstances = types.Int64Value(int64(group.MaxInstances))
 	data.DesiredCount = types.Int64Value(int64(group.DesiredCount))
 	data.Status = types.StringValue(group.Status)
 
+	instances, err := r.client.ListScalingGroupInstances(ctx, group.ID)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list scaling group instances", err)
+		return
+	}
+	resp.Diagnostics.Append(data.setInstances(ctx, instances)...)
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, group.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -250,36 +405,81 @@ func (r *ScalingGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	err := r.client.DeleteScalingGroup(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete scaling group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete scaling group", err)
 		return
 	}
 
 	// Wait for group to be gone from API (async deletion in backend)
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
-	defer cancel()
-
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			resp.Diagnostics.AddError("Delete Timeout", "Timed out waiting for scaling group to be deleted.")
-			return
-		case <-ticker.C:
-			group, err := r.client.GetScalingGroup(ctx, data.ID.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddError(errClient, fmt.Sprintf("Error checking scaling group status: %s", err))
-				return
-			}
-			if group == nil {
-				tflog.Trace(ctx, "scaling group successfully deleted")
-				return
-			}
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		group, err := r.client.GetScalingGroup(ctx, data.ID.ValueString())
+		if err != nil || group == nil {
+			return "", group == nil, err
 		}
+		return group.Status, false, nil
+	}, nil, nil, client.WaitOpts{Timeout: 10 * time.Minute, MinInterval: 5 * time.Second})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for scaling group to be deleted. Last observed status: %q.", timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking scaling group status", err)
+		return
+	}
+
+	tflog.Trace(ctx, "scaling group successfully deleted")
+}
+
+func (r *ScalingGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var image, size, gpuType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("image"), &image)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance_size"), &size)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("gpu_type"), &gpuType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed, diags := imageOrSizeChanged(ctx, req, image, size)
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(validateImageSizeCompatibility(ctx, r.client, image, size)...)
+	}
+
+	changed, diags = gpuTypeChanged(ctx, req, gpuType)
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(validateGPUType(ctx, r.client, gpuType)...)
 	}
 }
 
 func (r *ScalingGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setInstances stores the instances currently in the group in the model.
+func (m *ScalingGroupResourceModel) setInstances(ctx context.Context, instances []client.ScalingGroupInstance) diag.Diagnostics {
+	ids := make([]string, 0, len(instances))
+	models := make([]ScalingGroupInstanceModel, 0, len(instances))
+	for _, inst := range instances {
+		ids = append(ids, inst.ID)
+		models = append(models, ScalingGroupInstanceModel{
+			ID:        types.StringValue(inst.ID),
+			IPAddress: types.StringValue(inst.IPAddress),
+			Status:    types.StringValue(inst.Status),
+		})
+	}
+
+	var diags, d diag.Diagnostics
+	m.InstanceIDs, d = types.ListValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	m.Instances, d = types.ListValueFrom(ctx, scalingGroupInstanceType, models)
+	diags.Append(d...)
+	return diags
+}
@@ -14,13 +14,7 @@ func TestAccScalingGroupResource(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	vpcName := fmt.Sprintf("sg-test-vpc-%s", rName)
 	asgName := fmt.Sprintf("test-asg-%s", rName)
-
-	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			// Create and Read testing
-			{
-				Config: providerConfig() + fmt.Sprintf(`
+	config := providerConfig() + fmt.Sprintf(`
 resource "thecloud_vpc" "asg_vpc" {
   name       = "%s"
   cidr_block = "10.0.0.0/16"
@@ -34,7 +28,14 @@ resource "thecloud_scaling_group" "test" {
   max_instances = 3
   desired_count = 2
 }
-`, vpcName, asgName),
+`, vpcName, asgName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(scalingGroupResourceName, "name", asgName),
 					resource.TestCheckResourceAttr(scalingGroupResourceName, "image", "ubuntu-20.04"),
@@ -43,6 +44,20 @@ resource "thecloud_scaling_group" "test" {
 					resource.TestCheckResourceAttr(scalingGroupResourceName, "desired_count", "2"),
 					resource.TestCheckResourceAttrSet(scalingGroupResourceName, "id"),
 					resource.TestCheckResourceAttrSet(scalingGroupResourceName, "status"),
+					resource.TestCheckResourceAttrSet(scalingGroupResourceName, "instance_ids.#"),
+				),
+			},
+			// Data source lookup by name
+			{
+				Config: config + `
+data "thecloud_scaling_group" "by_name" {
+  name = thecloud_scaling_group.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.thecloud_scaling_group.by_name", "id", scalingGroupResourceName, "id"),
+					resource.TestCheckResourceAttr("data.thecloud_scaling_group.by_name", "desired_count", "2"),
+					resource.TestCheckResourceAttrSet("data.thecloud_scaling_group.by_name", "instances.#"),
 				),
 			},
 			// ImportState testing
@@ -50,6 +65,8 @@ resource "thecloud_scaling_group" "test" {
 				ResourceName:      scalingGroupResourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Instances come and go while the group converges on desired_count
+				ImportStateVerifyIgnore: []string{"instance_ids", "instances"},
 			},
 		},
 	})
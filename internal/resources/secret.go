@@ -2,21 +2,30 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &SecretResource{}
 var _ resource.ResourceWithImportState = &SecretResource{}
+var _ resource.ResourceWithValidateConfig = &SecretResource{}
 
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
@@ -29,10 +38,22 @@ type SecretResource struct {
 
 // SecretResourceModel describes the resource data model.
 type SecretResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Value       types.String `tfsdk:"value"`
-	Description types.String `tfsdk:"description"`
+	ID                types.String         `tfsdk:"id"`
+	Name              types.String         `tfsdk:"name"`
+	Value             types.String         `tfsdk:"value"`
+	Description       types.String         `tfsdk:"description"`
+	Version           types.Int64          `tfsdk:"version"`
+	ValueHash         types.String         `tfsdk:"value_hash"`
+	AdoptExisting     types.Bool           `tfsdk:"adopt_existing"`
+	Generate          *SecretGenerateModel `tfsdk:"generate"`
+	GeneratedValue    types.String         `tfsdk:"generated_value"`
+	RotateWhenChanged types.Map            `tfsdk:"rotate_when_changed"`
+}
+
+// SecretGenerateModel describes the generate block.
+type SecretGenerateModel struct {
+	Length         types.Int64 `tfsdk:"length"`
+	IncludeSpecial types.Bool  `tfsdk:"include_special"`
 }
 
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,18 +80,69 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"value": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The sensitive value of the secret.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The sensitive value of the secret. Changing the value stores a new version of the secret in place. Exactly one of `value` and `generate` is required, unless `adopt_existing` is set.",
 			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The description of the secret.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The current version of the secret, incremented each time it is updated.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Adopt an existing secret with the same name instead of failing when the name is taken. " +
+					"The adopted secret's value is replaced when `value` is set, and left as is otherwise, in which case the secret must already exist. " +
+					"The secret is still deleted on destroy. Defaults to `false`.",
+			},
+			"value_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the secret value, used to detect changes made outside of Terraform.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					valueHashFromPlan{},
+				},
+			},
+			"generated_value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The value generated by the API when `generate` is set. It is not available for imported secrets.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_when_changed": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Arbitrary values that, when changed, replace the secret with a newly generated value. " +
+					"Only used with `generate`.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"generate": schema.SingleNestedBlock{
+				MarkdownDescription: "Has the API generate the secret value instead of passing it in. " +
+					"Changing the block replaces the secret with a newly generated value.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The number of characters to generate. Defaults to the API's default length.",
+						Validators: []validator.Int64{
+							validators.Int64AtLeast(8),
+						},
+					},
+					"include_special": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Whether the value may contain special characters. Defaults to `false`.",
+					},
 				},
 			},
 		},
@@ -96,6 +168,50 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *SecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Generate != nil {
+		if !data.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("generate"),
+				"Invalid Attribute Combination",
+				"Only one of value and generate can be set.",
+			)
+		}
+		if data.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("generate"),
+				"Invalid Attribute Combination",
+				"A generated secret cannot adopt an existing one; remove adopt_existing or set value instead.",
+			)
+		}
+		return
+	}
+
+	if !data.RotateWhenChanged.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_when_changed"),
+			"Invalid Attribute Combination",
+			"rotate_when_changed only applies to generated secrets; add a generate block or remove it.",
+		)
+	}
+
+	if data.Value.IsNull() && !data.AdoptExisting.IsUnknown() && !data.AdoptExisting.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Missing Secret Value",
+			"Exactly one of value and generate is required, unless adopt_existing is true.",
+		)
+	}
+}
+
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SecretResourceModel
 
@@ -105,13 +221,24 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	secret, err := r.client.CreateSecret(ctx, data.Name.ValueString(), data.Value.ValueString(), data.Description.ValueString())
+	secret, err := r.createOrAdopt(ctx, data)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create secret, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create secret", err)
 		return
 	}
 
 	data.ID = types.StringValue(secret.ID)
+	data.Version = types.Int64Value(secret.Version)
+	data.GeneratedValue = types.StringNull()
+	switch {
+	case !data.Value.IsNull():
+		data.ValueHash = types.StringValue(secretValueHash(data.Value.ValueString()))
+	case data.Generate != nil:
+		data.GeneratedValue = types.StringValue(secret.Value)
+		data.ValueHash = types.StringValue(secretValueHash(secret.Value))
+	default:
+		data.ValueHash = types.StringValue(strings.ToLower(secret.ValueSHA256))
+	}
 
 	tflog.Trace(ctx, "created a Secret resource")
 
@@ -129,7 +256,7 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	secret, err := r.client.GetSecret(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read secret, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read secret", err)
 		return
 	}
 
@@ -140,14 +267,43 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	data.ID = types.StringValue(secret.ID)
 	data.Name = types.StringValue(secret.Name)
-	data.Description = types.StringValue(secret.Description)
-	// Value is not returned by Read for security, we keep the one from state/plan
+	if !data.Description.IsNull() || secret.Description != "" {
+		data.Description = types.StringValue(secret.Description)
+	}
+	data.Version = types.Int64Value(secret.Version)
+	// Value is not returned by Read for security, we keep the one from state/plan.
+	// A changed hash means the value was changed outside of Terraform; the plan then
+	// differs from state on value_hash, which updates the secret back to the config.
+	if secret.ValueSHA256 != "" && !strings.EqualFold(secret.ValueSHA256, data.ValueHash.ValueString()) {
+		data.ValueHash = types.StringValue(strings.ToLower(secret.ValueSHA256))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update Not Supported", "Updating a secret is not currently supported by the API. It will be recreated.")
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.UpdateSecret(ctx, data.ID.ValueString(), data.configuredValue(), data.Description.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update secret", err)
+		return
+	}
+
+	data.Version = types.Int64Value(secret.Version)
+	if !data.Value.IsNull() {
+		data.ValueHash = types.StringValue(secretValueHash(data.Value.ValueString()))
+	}
+
+	tflog.Trace(ctx, "updated a Secret resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -161,7 +317,7 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteSecret(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete secret, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete secret", err)
 		return
 	}
 }
@@ -169,3 +325,125 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// createOrAdopt creates the secret, generating its value when generate is set. With
+// adopt_existing, a name conflict adopts the existing secret instead, replacing its value
+// when one is configured. Without a value there is nothing to create, so the secret must
+// already exist.
+func (r *SecretResource) createOrAdopt(ctx context.Context, data SecretResourceModel) (*client.Secret, error) {
+	name := data.Name.ValueString()
+
+	if data.Generate != nil {
+		return r.client.GenerateSecret(ctx, client.GenerateSecretRequest{
+			Name:           name,
+			Description:    data.Description.ValueString(),
+			Length:         int(data.Generate.Length.ValueInt64()),
+			IncludeSpecial: data.Generate.IncludeSpecial.ValueBool(),
+		})
+	}
+
+	if data.Value.IsNull() {
+		existing, err := r.findSecret(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, fmt.Errorf("no secret named %q exists to adopt, so value is required to create it", name)
+		}
+
+		tflog.Info(ctx, "adopting existing secret", map[string]interface{}{"id": existing.ID, "name": name})
+		return existing, nil
+	}
+
+	secret, err := r.client.CreateSecret(ctx, name, data.Value.ValueString(), data.Description.ValueString())
+	if err == nil || !data.AdoptExisting.ValueBool() || !isAlreadyExists(err) {
+		return secret, err
+	}
+
+	existing, listErr := r.findSecret(ctx, name)
+	if listErr != nil {
+		return nil, listErr
+	}
+	if existing == nil {
+		// The conflict wasn't with a secret we can see, so report the original error
+		return nil, err
+	}
+
+	tflog.Info(ctx, "adopting existing secret", map[string]interface{}{"id": existing.ID, "name": name})
+
+	return r.client.UpdateSecret(ctx, existing.ID, data.configuredValue(), data.Description.ValueString())
+}
+
+// findSecret returns the secret named name, or nil if there is none.
+func (r *SecretResource) findSecret(ctx context.Context, name string) (*client.Secret, error) {
+	secrets, err := r.client.ListSecrets(ctx, client.ListFilter{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range secrets {
+		if existing.Name == name {
+			return &existing, nil
+		}
+	}
+	return nil, nil
+}
+
+// secretValueHash is the hex SHA-256 of a secret value, as returned by the API.
+// configuredValue returns the configured value to send to the API, or nil when value is
+// not set so the stored value is kept. An empty value is still sent.
+func (m SecretResourceModel) configuredValue() *string {
+	if m.Value.IsNull() || m.Value.IsUnknown() {
+		return nil
+	}
+	value := m.Value.ValueString()
+	return &value
+}
+
+func secretValueHash(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+var _ planmodifier.String = valueHashFromPlan{}
+
+// valueHashFromPlan plans value_hash as the hash of the configured value, so only the
+// hash, never the value itself, shows up in the plan when they differ.
+type valueHashFromPlan struct{}
+
+func (m valueHashFromPlan) Description(ctx context.Context) string {
+	return "The hash of the planned secret value."
+}
+
+func (m valueHashFromPlan) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m valueHashFromPlan) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to plan when the secret is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var value types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("value"), &value)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopted or generated: the hash tracks the existing value
+	if value.IsNull() {
+		if !req.StateValue.IsNull() {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+
+	if value.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	resp.PlanValue = types.StringValue(secretValueHash(value.ValueString()))
+}
@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSecretAPI holds one existing secret and rejects creating another with its name.
+type fakeSecretAPI struct {
+	t        *testing.T
+	existing client.Secret
+	calls    []string
+	updated  map[string]string
+}
+
+func (f *fakeSecretAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.calls = append(f.calls, r.Method+" "+r.URL.Path)
+
+	var data interface{}
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/secrets":
+		w.WriteHeader(http.StatusConflict)
+		assert.NoError(f.t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": map[string]string{"type": "conflict", "message": "secret name is taken", "code": "ALREADY_EXISTS"},
+		}))
+		return
+	case r.Method == http.MethodGet && r.URL.Path == "/secrets":
+		// Names are matched loosely, as by the API, so an exact match is left to the caller
+		secrets := []client.Secret{}
+		for _, secret := range []client.Secret{{ID: "secret-other", Name: "other"}, f.existing} {
+			if strings.Contains(strings.ToLower(secret.Name), strings.ToLower(r.URL.Query().Get("name"))) {
+				secrets = append(secrets, secret)
+			}
+		}
+		data = secrets
+	case r.Method == http.MethodPut && r.URL.Path == "/secrets/"+f.existing.ID:
+		assert.NoError(f.t, json.NewDecoder(r.Body).Decode(&f.updated))
+		updated := f.existing
+		updated.Version++
+		data = updated
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	raw, err := json.Marshal(data)
+	assert.NoError(f.t, err)
+	assert.NoError(f.t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+}
+
+func newFakeSecretAPI(t *testing.T) (*fakeSecretAPI, *SecretResource, func()) {
+	api := &fakeSecretAPI{t: t, existing: client.Secret{ID: "secret-1", Name: "BOOTSTRAP_TOKEN", ValueSHA256: "ABC123", Version: 3}}
+	server := httptest.NewServer(api)
+	return api, &SecretResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}, server.Close
+}
+
+func TestSecretAdoptExistingWithUpdate(t *testing.T) {
+	api, r, done := newFakeSecretAPI(t)
+	defer done()
+
+	secret, err := r.createOrAdopt(context.Background(), SecretResourceModel{
+		Name:          types.StringValue("BOOTSTRAP_TOKEN"),
+		Value:         types.StringValue("new-token"),
+		Description:   types.StringNull(),
+		AdoptExisting: types.BoolValue(true),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-1", secret.ID)
+	assert.Equal(t, int64(4), secret.Version)
+	assert.Equal(t, "new-token", api.updated["value"])
+	assert.Equal(t, []string{"POST /secrets", "GET /secrets", "PUT /secrets/secret-1"}, api.calls)
+}
+
+func TestSecretAdoptExistingReadOnly(t *testing.T) {
+	api, r, done := newFakeSecretAPI(t)
+	defer done()
+
+	secret, err := r.createOrAdopt(context.Background(), SecretResourceModel{
+		Name:          types.StringValue("BOOTSTRAP_TOKEN"),
+		Value:         types.StringNull(),
+		Description:   types.StringNull(),
+		AdoptExisting: types.BoolValue(true),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-1", secret.ID)
+	assert.Equal(t, int64(3), secret.Version)
+	assert.Equal(t, "ABC123", secret.ValueSHA256)
+	assert.Nil(t, api.updated)
+	assert.Equal(t, []string{"GET /secrets"}, api.calls)
+}
+
+func TestSecretAdoptMissingWithoutValue(t *testing.T) {
+	api, r, done := newFakeSecretAPI(t)
+	defer done()
+
+	_, err := r.createOrAdopt(context.Background(), SecretResourceModel{
+		Name:          types.StringValue("MISSING_TOKEN"),
+		Value:         types.StringNull(),
+		Description:   types.StringNull(),
+		AdoptExisting: types.BoolValue(true),
+	})
+
+	assert.ErrorContains(t, err, "value is required")
+	assert.Equal(t, []string{"GET /secrets"}, api.calls)
+}
+
+func TestSecretNameConflictWithoutAdopt(t *testing.T) {
+	api, r, done := newFakeSecretAPI(t)
+	defer done()
+
+	_, err := r.createOrAdopt(context.Background(), SecretResourceModel{
+		Name:          types.StringValue("BOOTSTRAP_TOKEN"),
+		Value:         types.StringValue("new-token"),
+		Description:   types.StringNull(),
+		AdoptExisting: types.BoolNull(),
+	})
+
+	assert.True(t, isAlreadyExists(err))
+	assert.Equal(t, []string{"POST /secrets"}, api.calls)
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretGenerate(t *testing.T) {
+	var body client.GenerateSecretRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST /secrets/generate", r.Method+" "+r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		raw, err := json.Marshal(client.Secret{ID: "secret-1", Name: body.Name, Value: "s3cr3t!generated", Version: 1})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	r := &SecretResource{client: client.NewClient(server.URL, "test-key", client.WithRetryMax(0))}
+
+	secret, err := r.createOrAdopt(context.Background(), SecretResourceModel{
+		Name:        types.StringValue("BOOTSTRAP_TOKEN"),
+		Value:       types.StringNull(),
+		Description: types.StringValue("bootstrap"),
+		Generate:    &SecretGenerateModel{Length: types.Int64Value(16), IncludeSpecial: types.BoolValue(true)},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t!generated", secret.Value)
+	assert.Equal(t, client.GenerateSecretRequest{Name: "BOOTSTRAP_TOKEN", Description: "bootstrap", Length: 16, IncludeSpecial: true}, body)
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretValueHash(t *testing.T) {
+	// sha256 of "super-secret-value"
+	assert.Equal(t, "03767fbe485736bb40cc5d85e4c9bb10b12a415674b46faf005aa22188a39a10", secretValueHash("super-secret-value"))
+}
+
+func TestValueHashFromPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewSecretResource().(*SecretResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	plan := func(value tftypes.Value) tfsdk.Plan {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+		}
+		values["value"] = value
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	modify := func(p tfsdk.Plan) types.String {
+		resp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+		valueHashFromPlan{}.PlanModifyString(ctx, planmodifier.StringRequest{Path: path.Root("value_hash"), Plan: p}, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		return resp.PlanValue
+	}
+
+	assert.Equal(t, types.StringValue(secretValueHash("rotated")), modify(plan(tftypes.NewValue(tftypes.String, "rotated"))))
+	assert.True(t, modify(plan(tftypes.NewValue(tftypes.String, tftypes.UnknownValue))).IsUnknown())
+	assert.True(t, modify(tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}).IsUnknown())
+}
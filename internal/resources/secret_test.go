@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const secretResourceName = "thecloud_secret.test"
@@ -32,6 +34,8 @@ resource "thecloud_secret" "test" {
 					resource.TestCheckResourceAttr(secretResourceName, "value", secretValue),
 					resource.TestCheckResourceAttr(secretResourceName, "description", "test secret"),
 					resource.TestCheckResourceAttrSet(secretResourceName, "id"),
+					resource.TestCheckResourceAttr(secretResourceName, "version", "1"),
+					resource.TestCheckResourceAttr(secretResourceName, "value_hash", "03767fbe485736bb40cc5d85e4c9bb10b12a415674b46faf005aa22188a39a10"),
 				),
 			},
 			// ImportState testing
@@ -45,3 +49,110 @@ resource "thecloud_secret" "test" {
 		},
 	})
 }
+
+func TestAccSecretResourceRotation(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	secretName := fmt.Sprintf("test-secret-%s", rName)
+
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretConfig(secretName, "first-value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(secretResourceName, "version", "1"),
+					func(s *terraform.State) error {
+						secretID = s.RootModule().Resources[secretResourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Rotating the value updates the secret in place and keeps its ID
+			{
+				Config: testAccSecretConfig(secretName, "second-value"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(secretResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(secretResourceName, "value", "second-value"),
+					resource.TestCheckResourceAttr(secretResourceName, "version", "2"),
+					resource.TestCheckResourceAttrWith(secretResourceName, "id", func(id string) error {
+						if id != secretID {
+							return fmt.Errorf("expected secret ID %s to be kept, got %s", secretID, id)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretConfig(name, value string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_secret" "test" {
+  name        = "%s"
+  value       = "%s"
+  description = "test secret"
+}
+`, name, value)
+}
+
+func TestAccSecretResourceGenerate(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	secretName := fmt.Sprintf("test-secret-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretGenerateConfig(secretName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(secretResourceName, "generated_value", func(value string) error {
+						if len(value) != 32 {
+							return fmt.Errorf("expected a 32 character generated value, got %d characters", len(value))
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet(secretResourceName, "value_hash"),
+					resource.TestCheckNoResourceAttr(secretResourceName, "value"),
+				),
+			},
+			// Changing rotate_when_changed generates a new value
+			{
+				Config: testAccSecretGenerateConfig(secretName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(secretResourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			{
+				Config:   testAccSecretGenerateConfig(secretName, "2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccSecretGenerateConfig(name, rotation string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_secret" "test" {
+  name        = "%s"
+  description = "generated test secret"
+
+  generate {
+    length          = 32
+    include_special = true
+  }
+
+  rotate_when_changed = {
+    rotation = "%s"
+  }
+}
+`, name, rotation)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -100,7 +101,7 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 	sg, err := r.client.CreateSecurityGroup(ctx, data.VpcID.ValueString(), data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create security group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create security group", err)
 		return
 	}
 
@@ -113,6 +114,9 @@ func (r *SecurityGroupResource) Create(ctx context.Context, req resource.CreateR
 		data.Description = types.StringNull()
 	}
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, sg.ETag)...)
+	resp.Diagnostics.Append(setPrivateCreatedAt(ctx, resp.Private)...)
+
 	tflog.Trace(ctx, "created a Security Group resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -127,9 +131,11 @@ func (r *SecurityGroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	sg, err := r.client.GetSecurityGroup(ctx, data.ID.ValueString())
+	sg, err := readCreated(ctx, r.client, req.Private, func(ctx context.Context) (*client.SecurityGroup, error) {
+		return r.client.GetSecurityGroup(ctx, data.ID.ValueString())
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read security group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read security group", err)
 		return
 	}
 
@@ -147,6 +153,7 @@ func (r *SecurityGroupResource) Read(ctx context.Context, req resource.ReadReque
 		data.Description = types.StringNull()
 	}
 
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, sg.ETag)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -165,7 +172,7 @@ func (r *SecurityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 
 	err := r.client.DeleteSecurityGroup(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete security group, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete security group", err)
 		return
 	}
 }
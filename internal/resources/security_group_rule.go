@@ -11,14 +11,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &SecurityGroupRuleResource{}
 var _ resource.ResourceWithImportState = &SecurityGroupRuleResource{}
+var _ resource.ResourceWithConfigValidators = &SecurityGroupRuleResource{}
+var _ resource.ResourceWithValidateConfig = &SecurityGroupRuleResource{}
+
+// securityGroupRuleDirections and securityGroupRuleProtocols are the rule directions and
+// protocols the API supports.
+var (
+	securityGroupRuleDirections = []string{"ingress", "egress"}
+	securityGroupRuleProtocols  = []string{"tcp", "udp", "icmp", "all"}
+)
 
 func NewSecurityGroupRuleResource() resource.Resource {
 	return &SecurityGroupRuleResource{}
@@ -35,6 +46,7 @@ type SecurityGroupRuleResourceModel struct {
 	SecurityGroupID types.String `tfsdk:"security_group_id"`
 	Direction       types.String `tfsdk:"direction"`
 	Protocol        types.String `tfsdk:"protocol"`
+	Port            types.Int64  `tfsdk:"port"`
 	PortMin         types.Int64  `tfsdk:"port_min"`
 	PortMax         types.Int64  `tfsdk:"port_max"`
 	CIDR            types.String `tfsdk:"cidr"`
@@ -70,6 +82,9 @@ func (r *SecurityGroupRuleResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: securityGroupRuleDirections, ignoreCase: true},
+				},
 			},
 			"protocol": schema.StringAttribute{
 				Required:            true,
@@ -77,19 +92,38 @@ func (r *SecurityGroupRuleResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringOneOfValidator{values: securityGroupRuleProtocols, ignoreCase: true},
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A single port number. Shorthand for setting `port_min` and `port_max` to the same value; conflicts with both.",
+				PlanModifiers: []planmodifier.Int64{
+					requiresReplaceIfPortRangeChanged(),
+				},
+				Validators: []validator.Int64{
+					portValidator,
+				},
 			},
 			"port_min": schema.Int64Attribute{
 				Optional:            true,
 				MarkdownDescription: "The minimum port number.",
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					requiresReplaceIfPortRangeChanged(),
+				},
+				Validators: []validator.Int64{
+					portValidator,
 				},
 			},
 			"port_max": schema.Int64Attribute{
 				Optional:            true,
 				MarkdownDescription: "The maximum port number.",
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					requiresReplaceIfPortRangeChanged(),
+				},
+				Validators: []validator.Int64{
+					portValidator,
 				},
 			},
 			"cidr": schema.StringAttribute{
@@ -98,12 +132,16 @@ func (r *SecurityGroupRuleResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"priority": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "The evaluation priority of the rule.",
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
@@ -111,6 +149,114 @@ func (r *SecurityGroupRuleResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *SecurityGroupRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		conflictsWith(path.Root("port"), path.Root("port_min"), path.Root("port_max")),
+	}
+}
+
+func (r *SecurityGroupRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ICMP has no ports
+	if strings.EqualFold(data.Protocol.ValueString(), "icmp") {
+		ports := []struct {
+			name  string
+			value types.Int64
+		}{{"port", data.Port}, {"port_min", data.PortMin}, {"port_max", data.PortMax}}
+
+		for _, p := range ports {
+			if p.value.IsNull() {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(p.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q cannot be specified when \"protocol\" is \"icmp\".", p.name),
+			)
+		}
+	}
+
+	if data.PortMin.IsNull() || data.PortMin.IsUnknown() || data.PortMax.IsNull() || data.PortMax.IsUnknown() {
+		return
+	}
+
+	if data.PortMin.ValueInt64() > data.PortMax.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("port_min"),
+			"Invalid Port Range",
+			fmt.Sprintf("Attribute \"port_min\" (%d) must not be greater than \"port_max\" (%d).", data.PortMin.ValueInt64(), data.PortMax.ValueInt64()),
+		)
+	}
+}
+
+// requiresReplaceIfPortRangeChanged replaces the rule only when the effective port range
+// changes, so switching between `port` and `port_min`/`port_max` is an in-place update.
+func requiresReplaceIfPortRangeChanged() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			var plan, state SecurityGroupRuleResourceModel
+
+			resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			planMin, planMax, known := plan.portRange()
+			stateMin, stateMax, _ := state.portRange()
+
+			resp.RequiresReplace = !known || planMin != stateMin || planMax != stateMax
+		},
+		"Changing the port range forces a new rule.",
+		"Changing the port range forces a new rule.",
+	)
+}
+
+// portRange returns the effective port range of the rule, expanding `port` to min = max.
+func (m SecurityGroupRuleResourceModel) portRange() (int64, int64, bool) {
+	if m.Port.IsUnknown() || m.PortMin.IsUnknown() || m.PortMax.IsUnknown() {
+		return 0, 0, false
+	}
+
+	if !m.Port.IsNull() {
+		return m.Port.ValueInt64(), m.Port.ValueInt64(), true
+	}
+
+	return m.PortMin.ValueInt64(), m.PortMax.ValueInt64(), true
+}
+
+// setPortRange stores the API port range in the same style the practitioner authored it:
+// collapsed to `port` when `port` was used and the range is a single port, otherwise as
+// `port_min`/`port_max`. Imported rules have neither set and use `port_min`/`port_max`.
+func (m *SecurityGroupRuleResourceModel) setPortRange(portMin, portMax int) {
+	if !m.Port.IsNull() && portMin == portMax {
+		m.Port = types.Int64Value(int64(portMin))
+		m.PortMin = types.Int64Null()
+		m.PortMax = types.Int64Null()
+		return
+	}
+
+	m.Port = types.Int64Null()
+	if !m.PortMin.IsNull() || portMin != 0 {
+		m.PortMin = types.Int64Value(int64(portMin))
+	} else {
+		m.PortMin = types.Int64Null()
+	}
+	if !m.PortMax.IsNull() || portMax != 0 {
+		m.PortMax = types.Int64Value(int64(portMax))
+	} else {
+		m.PortMax = types.Int64Null()
+	}
+}
+
 func (r *SecurityGroupRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -139,19 +285,21 @@ func (r *SecurityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	portMin, portMax, _ := data.portRange()
+
 	ruleReq := client.SecurityRule{
 		GroupID:   data.SecurityGroupID.ValueString(),
 		Direction: data.Direction.ValueString(),
 		Protocol:  data.Protocol.ValueString(),
-		PortMin:   int(data.PortMin.ValueInt64()),
-		PortMax:   int(data.PortMax.ValueInt64()),
+		PortMin:   int(portMin),
+		PortMax:   int(portMax),
 		CIDR:      data.CIDR.ValueString(),
 		Priority:  int(data.Priority.ValueInt64()),
 	}
 
 	rule, err := r.client.AddSecurityRule(ctx, data.SecurityGroupID.ValueString(), ruleReq)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create security group rule, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create security group rule", err)
 		return
 	}
 
@@ -176,7 +324,7 @@ func (r *SecurityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 	// We need to fetch the group and find our rule.
 	sg, err := r.client.GetSecurityGroup(ctx, data.SecurityGroupID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read security group for rule, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read security group for rule", err)
 		return
 	}
 
@@ -188,10 +336,9 @@ func (r *SecurityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 	found := false
 	for _, rule := range sg.Rules {
 		if rule.ID == data.ID.ValueString() {
-			data.Direction = types.StringValue(rule.Direction)
-			data.Protocol = types.StringValue(rule.Protocol)
-			data.PortMin = types.Int64Value(int64(rule.PortMin))
-			data.PortMax = types.Int64Value(int64(rule.PortMax))
+			data.Direction = stringValueIgnoringCase(data.Direction, rule.Direction)
+			data.Protocol = stringValueIgnoringCase(data.Protocol, rule.Protocol)
+			data.setPortRange(rule.PortMin, rule.PortMax)
 			data.CIDR = types.StringValue(rule.CIDR)
 			data.Priority = types.Int64Value(int64(rule.Priority))
 			found = true
@@ -208,7 +355,21 @@ func (r *SecurityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *SecurityGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update Not Supported", "Updating a security group rule is not currently supported by the API.")
+	var data, state SecurityGroupRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every change the API would see forces replacement, so the only in-place update
+	// is switching between `port` and `port_min`/`port_max` for the same range.
+	data.ID = state.ID
+	data.Priority = state.Priority
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecurityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,7 +383,7 @@ func (r *SecurityGroupRuleResource) Delete(ctx context.Context, req resource.Del
 
 	err := r.client.RemoveSecurityRule(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete security group rule, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete security group rule", err)
 		return
 	}
 }
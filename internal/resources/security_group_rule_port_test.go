@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityGroupRulePortRange(t *testing.T) {
+	single := SecurityGroupRuleResourceModel{Port: types.Int64Value(443), PortMin: types.Int64Null(), PortMax: types.Int64Null()}
+	min, max, known := single.portRange()
+	assert.True(t, known)
+	assert.Equal(t, int64(443), min)
+	assert.Equal(t, int64(443), max)
+
+	ranged := SecurityGroupRuleResourceModel{Port: types.Int64Null(), PortMin: types.Int64Value(8000), PortMax: types.Int64Value(8080)}
+	min, max, known = ranged.portRange()
+	assert.True(t, known)
+	assert.Equal(t, int64(8000), min)
+	assert.Equal(t, int64(8080), max)
+
+	unknown := SecurityGroupRuleResourceModel{Port: types.Int64Unknown(), PortMin: types.Int64Null(), PortMax: types.Int64Null()}
+	_, _, known = unknown.portRange()
+	assert.False(t, known)
+}
+
+func TestSecurityGroupRuleSetPortRange(t *testing.T) {
+	// Authored with `port`: a single-port range collapses back to `port`.
+	data := SecurityGroupRuleResourceModel{Port: types.Int64Value(443), PortMin: types.Int64Null(), PortMax: types.Int64Null()}
+	data.setPortRange(443, 443)
+	assert.Equal(t, types.Int64Value(443), data.Port)
+	assert.True(t, data.PortMin.IsNull())
+	assert.True(t, data.PortMax.IsNull())
+
+	// Authored with `port` but the rule drifted to a range: surface the real range.
+	data.setPortRange(443, 444)
+	assert.True(t, data.Port.IsNull())
+	assert.Equal(t, types.Int64Value(443), data.PortMin)
+	assert.Equal(t, types.Int64Value(444), data.PortMax)
+
+	// Authored with `port_min`/`port_max`: equal values are kept as a range.
+	data = SecurityGroupRuleResourceModel{Port: types.Int64Null(), PortMin: types.Int64Value(80), PortMax: types.Int64Value(80)}
+	data.setPortRange(80, 80)
+	assert.True(t, data.Port.IsNull())
+	assert.Equal(t, types.Int64Value(80), data.PortMin)
+	assert.Equal(t, types.Int64Value(80), data.PortMax)
+
+	// Imported: nothing is known about the authoring style.
+	data = SecurityGroupRuleResourceModel{Port: types.Int64Null(), PortMin: types.Int64Null(), PortMax: types.Int64Null()}
+	data.setPortRange(22, 22)
+	assert.True(t, data.Port.IsNull())
+	assert.Equal(t, types.Int64Value(22), data.PortMin)
+	assert.Equal(t, types.Int64Value(22), data.PortMax)
+
+	// Portless protocols keep omitted ports null.
+	data = SecurityGroupRuleResourceModel{Port: types.Int64Null(), PortMin: types.Int64Null(), PortMax: types.Int64Null()}
+	data.setPortRange(0, 0)
+	assert.True(t, data.Port.IsNull())
+	assert.True(t, data.PortMin.IsNull())
+	assert.True(t, data.PortMax.IsNull())
+}
+
+func TestSecurityGroupRulePortConflicts(t *testing.T) {
+	ctx := context.Background()
+	r := NewSecurityGroupRuleResource().(*SecurityGroupRuleResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(ports map[string]tftypes.Value) bool {
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		for name, value := range ports {
+			values[name] = value
+		}
+
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+		}
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, resp)
+		for _, v := range r.ConfigValidators(ctx) {
+			v.ValidateResource(ctx, req, resp)
+		}
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(map[string]tftypes.Value{"port": tftypes.NewValue(tftypes.Number, 443)}))
+	assert.False(t, validate(map[string]tftypes.Value{
+		"port_min": tftypes.NewValue(tftypes.Number, 80),
+		"port_max": tftypes.NewValue(tftypes.Number, 80),
+	}))
+	assert.True(t, validate(map[string]tftypes.Value{
+		"port":     tftypes.NewValue(tftypes.Number, 443),
+		"port_max": tftypes.NewValue(tftypes.Number, 443),
+	}))
+
+	// Ranges must be ordered.
+	assert.True(t, validate(map[string]tftypes.Value{
+		"port_min": tftypes.NewValue(tftypes.Number, 8080),
+		"port_max": tftypes.NewValue(tftypes.Number, 8000),
+	}))
+
+	// ICMP has no ports.
+	icmp := tftypes.NewValue(tftypes.String, "icmp")
+	assert.False(t, validate(map[string]tftypes.Value{"protocol": icmp}))
+	assert.True(t, validate(map[string]tftypes.Value{"protocol": icmp, "port": tftypes.NewValue(tftypes.Number, 443)}))
+}
@@ -6,11 +6,20 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const sgRuleResourceName = "thecloud_security_group_rule.test"
 
+func sgRuleImportStateID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[sgRuleResourceName]
+	if !ok {
+		return "", fmt.Errorf("Not found: %s", sgRuleResourceName)
+	}
+	return fmt.Sprintf("%s:%s", rs.Primary.Attributes["security_group_id"], rs.Primary.ID), nil
+}
+
 func TestAccSecurityGroupRuleResource(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	vpcName := fmt.Sprintf("sg-rule-vpc-%s", rName)
@@ -57,13 +66,89 @@ resource "thecloud_security_group_rule" "test" {
 				ResourceName:      sgRuleResourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateIdFunc: func(s *terraform.State) (string, error) {
-					rs, ok := s.RootModule().Resources[sgRuleResourceName]
-					if !ok {
-						return "", fmt.Errorf("Not found: %s", sgRuleResourceName)
+				ImportStateIdFunc: sgRuleImportStateID,
+			},
+		},
+	})
+}
+
+func testAccSecurityGroupRulePortConfig(vpcName, sgName, ports string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "rule_vpc" {
+  name       = "%s"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "thecloud_security_group" "rule_sg" {
+  name   = "%s"
+  vpc_id = thecloud_vpc.rule_vpc.id
+}
+
+resource "thecloud_security_group_rule" "test" {
+  security_group_id = thecloud_security_group.rule_sg.id
+  direction         = "ingress"
+  protocol          = "tcp"
+  cidr              = "0.0.0.0/0"
+  %s
+}
+`, vpcName, sgName, ports)
+}
+
+func TestAccSecurityGroupRuleResourcePort(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	vpcName := fmt.Sprintf("sg-rule-port-vpc-%s", rName)
+	sgName := fmt.Sprintf("test-sg-port-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Single port shorthand
+			{
+				Config: testAccSecurityGroupRulePortConfig(vpcName, sgName, "port = 443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(sgRuleResourceName, "port", "443"),
+					resource.TestCheckNoResourceAttr(sgRuleResourceName, "port_min"),
+					resource.TestCheckNoResourceAttr(sgRuleResourceName, "port_max"),
+				),
+			},
+			// Imported rules always use port_min/port_max
+			{
+				ResourceName:            sgRuleResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"port", "port_min", "port_max"},
+				ImportStateIdFunc:       sgRuleImportStateID,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attrs := states[0].Attributes
+					if attrs["port_min"] != "443" || attrs["port_max"] != "443" {
+						return fmt.Errorf("expected imported port range 443-443, got %s-%s", attrs["port_min"], attrs["port_max"])
 					}
-					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["security_group_id"], rs.Primary.ID), nil
+					return nil
+				},
+			},
+			// Switching to the explicit range for the same port is an in-place update
+			{
+				Config: testAccSecurityGroupRulePortConfig(vpcName, sgName, "port_min = 443\n  port_max = 443"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(sgRuleResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(sgRuleResourceName, "port"),
+					resource.TestCheckResourceAttr(sgRuleResourceName, "port_min", "443"),
+					resource.TestCheckResourceAttr(sgRuleResourceName, "port_max", "443"),
+				),
+			},
+			// Changing the port replaces the rule
+			{
+				Config: testAccSecurityGroupRulePortConfig(vpcName, sgName, "port = 8443"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(sgRuleResourceName, plancheck.ResourceActionReplace),
+					},
 				},
+				Check: resource.TestCheckResourceAttr(sgRuleResourceName, "port", "8443"),
 			},
 		},
 	})
@@ -2,18 +2,31 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
+const (
+	snapshotStatusCompleted    = "completed"
+	snapshotStatusError        = "error"
+	defaultSnapshotWaitTimeout = 10 * time.Minute
+)
+
 // Ensure implementation of interfaces
 var _ resource.Resource = &SnapshotResource{}
 var _ resource.ResourceWithImportState = &SnapshotResource{}
@@ -29,10 +42,13 @@ type SnapshotResource struct {
 
 // SnapshotResourceModel describes the resource data model.
 type SnapshotResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	VolumeID    types.String `tfsdk:"volume_id"`
-	Description types.String `tfsdk:"description"`
-	Status      types.String `tfsdk:"status"`
+	ID          types.String   `tfsdk:"id"`
+	VolumeID    types.String   `tfsdk:"volume_id"`
+	Description types.String   `tfsdk:"description"`
+	Status      types.String   `tfsdk:"status"`
+	SizeGB      types.Int64    `tfsdk:"size_gb"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *SnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,6 +88,23 @@ func (r *SnapshotResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"size_gb": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The size in GiB of the volume the snapshot was taken of. Volumes restored from the snapshot must be at least this large.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp when the snapshot was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -100,6 +133,9 @@ func (r *SnapshotResource) Create(ctx context.Context, req resource.CreateReques
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultSnapshotWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -110,18 +146,26 @@ func (r *SnapshotResource) Create(ctx context.Context, req resource.CreateReques
 		data.Description.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create snapshot, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create snapshot", err)
 		return
 	}
 
 	data.ID = types.StringValue(snapshot.ID)
-	data.VolumeID = types.StringValue(snapshot.VolumeID)
-	if !data.Description.IsNull() || snapshot.Description != "" {
-		data.Description = types.StringValue(snapshot.Description)
-	} else {
-		data.Description = types.StringNull()
+	data.setSnapshot(snapshot)
+
+	// Restoring from a snapshot that's still being taken fails, so wait for it to complete
+	snapshot, diags = waitForSnapshotCompleted(ctx, r.client, snapshot, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		// A snapshot that's still there is saved, so it's tainted rather than left untracked
+		if snapshot != nil {
+			data.setSnapshot(snapshot)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
 	}
-	data.Status = types.StringValue(snapshot.Status)
+
+	data.setSnapshot(snapshot)
 
 	tflog.Trace(ctx, "created a Snapshot resource")
 
@@ -139,7 +183,7 @@ func (r *SnapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	snapshot, err := r.client.GetSnapshot(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read snapshot, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read snapshot", err)
 		return
 	}
 
@@ -149,13 +193,7 @@ func (r *SnapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	data.ID = types.StringValue(snapshot.ID)
-	data.VolumeID = types.StringValue(snapshot.VolumeID)
-	if !data.Description.IsNull() || snapshot.Description != "" {
-		data.Description = types.StringValue(snapshot.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-	data.Status = types.StringValue(snapshot.Status)
+	data.setSnapshot(snapshot)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -175,7 +213,7 @@ func (r *SnapshotResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	err := r.client.DeleteSnapshot(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete snapshot, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete snapshot", err)
 		return
 	}
 }
@@ -183,3 +221,60 @@ func (r *SnapshotResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *SnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (m *SnapshotResourceModel) setSnapshot(snapshot *client.Snapshot) {
+	m.VolumeID = types.StringValue(snapshot.VolumeID)
+	if !m.Description.IsNull() || snapshot.Description != "" {
+		m.Description = types.StringValue(snapshot.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+	m.Status = types.StringValue(snapshot.Status)
+	m.SizeGB = types.Int64Value(int64(snapshot.SizeGB))
+	m.CreatedAt = types.StringValue(snapshot.CreatedAt)
+}
+
+// waitForSnapshotCompleted polls a new snapshot until it has completed and returns it.
+// A snapshot that fails is deleted, so it isn't left behind unmanaged, and reported
+// with the reason the API gives. On other errors the last observed snapshot is returned
+// with them; nil means the snapshot no longer exists.
+func waitForSnapshotCompleted(ctx context.Context, c *client.Client, created *client.Snapshot, timeout time.Duration) (*client.Snapshot, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id := created.ID
+	snapshot := created
+
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetSnapshot(ctx, id)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		snapshot = current
+		return current.Status, false, nil
+	}, []string{snapshotStatusCompleted, snapshotStatusError}, []string{"creating", "pending"}, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError("Create Timeout", fmt.Sprintf("Timed out waiting for snapshot %s to complete. Last observed status: %q.", id, timeoutErr.LastStatus))
+		return snapshot, diags
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Snapshot %s did not complete", id), err)
+		return snapshot, diags
+	}
+
+	if strings.EqualFold(snapshot.Status, snapshotStatusError) {
+		reason := snapshot.FailureReason
+		if reason == "" {
+			reason = "no reason was given"
+		}
+		diags.AddError("Snapshot Failed", fmt.Sprintf("Snapshot %s of volume %s failed: %s.", id, snapshot.VolumeID, reason))
+
+		if err := c.DeleteSnapshot(ctx, id); err != nil {
+			diags.AddWarning("Unable to Delete Failed Snapshot", fmt.Sprintf("Snapshot %s failed and could not be deleted, got error: %s. Delete it manually.", id, err))
+			return snapshot, diags
+		}
+		return nil, diags
+	}
+
+	return snapshot, diags
+}
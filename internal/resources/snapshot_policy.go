@@ -0,0 +1,227 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/validators"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &SnapshotPolicyResource{}
+var _ resource.ResourceWithImportState = &SnapshotPolicyResource{}
+
+func NewSnapshotPolicyResource() resource.Resource {
+	return &SnapshotPolicyResource{}
+}
+
+// SnapshotPolicyResource defines the resource implementation.
+type SnapshotPolicyResource struct {
+	client *client.Client
+}
+
+// SnapshotPolicyResourceModel describes the resource data model.
+type SnapshotPolicyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	VolumeID       types.String `tfsdk:"volume_id"`
+	Schedule       types.String `tfsdk:"schedule"`
+	RetentionCount types.Int64  `tfsdk:"retention_count"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *SnapshotPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_policy"
+}
+
+func (r *SnapshotPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Snapshot policy resource allows you to take snapshots of a volume on a schedule and keep a fixed number of them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the snapshot policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the volume to snapshot.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "When to take snapshots, as a five-field cron expression (e.g. `0 3 * * *`) or a shorthand such as `@daily`. Snapshots can be taken at most once an hour.",
+				Validators: []validator.String{
+					cronScheduleValidator{},
+				},
+			},
+			"retention_count": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of snapshots to keep. The oldest snapshot taken by the policy is deleted when a new one exceeds the count.",
+				Validators: []validator.Int64{
+					validators.Int64AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether snapshots are taken. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (r *SnapshotPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SnapshotPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnapshotPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.CreateSnapshotPolicy(ctx, client.SnapshotPolicy{
+		VolumeID:       data.VolumeID.ValueString(),
+		Schedule:       data.Schedule.ValueString(),
+		RetentionCount: int(data.RetentionCount.ValueInt64()),
+		Enabled:        data.Enabled.ValueBool(),
+	})
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create snapshot policy", err)
+		return
+	}
+
+	data.ID = types.StringValue(policy.ID)
+	data.setPolicy(policy)
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, policy.ETag)...)
+
+	tflog.Trace(ctx, "created a SnapshotPolicy resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnapshotPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetSnapshotPolicy(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read snapshot policy", err)
+		return
+	}
+
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(policy.ID)
+	data.setPolicy(policy)
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, policy.ETag)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnapshotPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnapshotPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etag, diags := privateETag(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := client.UpdateSnapshotPolicyRequest{
+		Schedule:       data.Schedule.ValueString(),
+		RetentionCount: int(data.RetentionCount.ValueInt64()),
+		Enabled:        data.Enabled.ValueBool(),
+	}
+	updateReq.ETag = etag
+
+	policy, err := r.client.UpdateSnapshotPolicy(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update snapshot policy", err)
+		return
+	}
+
+	data.setPolicy(policy)
+
+	tflog.Trace(ctx, "updated a SnapshotPolicy resource")
+
+	resp.Diagnostics.Append(setPrivateETag(ctx, resp.Private, policy.ETag)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnapshotPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnapshotPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSnapshotPolicy(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete snapshot policy", err)
+		return
+	}
+}
+
+func (r *SnapshotPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *SnapshotPolicyResourceModel) setPolicy(policy *client.SnapshotPolicy) {
+	m.VolumeID = types.StringValue(policy.VolumeID)
+	m.Schedule = types.StringValue(policy.Schedule)
+	m.RetentionCount = types.Int64Value(int64(policy.RetentionCount))
+	m.Enabled = types.BoolValue(policy.Enabled)
+}
@@ -0,0 +1,73 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const snapshotPolicyResourceName = "thecloud_snapshot_policy.test"
+
+func TestAccSnapshotPolicyResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	volName := fmt.Sprintf("test-vol-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSnapshotPolicyConfig(volName, "0 3 * * *", 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotPolicyResourceName, "schedule", "0 3 * * *"),
+					resource.TestCheckResourceAttr(snapshotPolicyResourceName, "retention_count", "7"),
+					resource.TestCheckResourceAttr(snapshotPolicyResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(snapshotPolicyResourceName, "id"),
+				),
+			},
+			// Schedule and retention change in place
+			{
+				Config: testAccSnapshotPolicyConfig(volName, "30 */6 * * *", 14),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(snapshotPolicyResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(snapshotPolicyResourceName, "schedule", "30 */6 * * *"),
+					resource.TestCheckResourceAttr(snapshotPolicyResourceName, "retention_count", "14"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      snapshotPolicyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Schedules more frequent than hourly are rejected at plan time
+			{
+				Config:      testAccSnapshotPolicyConfig(volName, "*/15 * * * *", 14),
+				ExpectError: regexp.MustCompile("Invalid Schedule"),
+			},
+		},
+	})
+}
+
+func testAccSnapshotPolicyConfig(volName, schedule string, retention int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_volume" "policy_vol" {
+  name    = "%s"
+  size_gb = 10
+}
+
+resource "thecloud_snapshot_policy" "test" {
+  volume_id       = thecloud_volume.policy_vol.id
+  schedule        = "%s"
+  retention_count = %d
+}
+`, volName, schedule, retention)
+}
@@ -35,7 +35,9 @@ resource "thecloud_snapshot" "test" {
 					resource.TestCheckResourceAttr(snapshotResourceName, "description", snapshotDesc),
 					resource.TestCheckResourceAttrSet(snapshotResourceName, "id"),
 					resource.TestCheckResourceAttrSet(snapshotResourceName, "volume_id"),
-					resource.TestCheckResourceAttrSet(snapshotResourceName, "status"),
+					resource.TestCheckResourceAttr(snapshotResourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(snapshotResourceName, "size_gb", "10"),
+					resource.TestCheckResourceAttrSet(snapshotResourceName, "created_at"),
 				),
 			},
 			// ImportState testing
@@ -43,8 +45,6 @@ resource "thecloud_snapshot" "test" {
 				ResourceName:      snapshotResourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// status might transition from CREATING to AVAILABLE
-				ImportStateVerifyIgnore: []string{"status"},
 			},
 		},
 	})
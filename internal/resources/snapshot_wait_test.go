@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForSnapshotCompleted(t *testing.T) {
+	snapshots := map[string]client.Snapshot{
+		"/snapshots/snap-done":    {ID: "snap-done", VolumeID: "vol-1", SizeGB: 10, Status: "COMPLETED"},
+		"/snapshots/snap-pending": {ID: "snap-pending", VolumeID: "vol-1", Status: "creating"},
+		"/snapshots/snap-failed":  {ID: "snap-failed", VolumeID: "vol-1", Status: "error", FailureReason: "volume is detached"},
+	}
+	var deleted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		snapshot, ok := snapshots[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(snapshot)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	snapshot, diags := waitForSnapshotCompleted(ctx, c, &client.Snapshot{ID: "snap-done"}, time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 10, snapshot.SizeGB)
+
+	// A snapshot that's still being taken is returned, so it can be saved to state
+	snapshot, diags = waitForSnapshotCompleted(ctx, c, &client.Snapshot{ID: "snap-pending"}, time.Millisecond)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "snap-pending", snapshot.ID)
+	assert.Equal(t, "Create Timeout", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "creating")
+
+	// A failed snapshot is reported with its reason and cleaned up
+	snapshot, diags = waitForSnapshotCompleted(ctx, c, &client.Snapshot{ID: "snap-failed"}, time.Minute)
+	assert.Nil(t, snapshot)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Snapshot Failed", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "volume is detached")
+	assert.Equal(t, []string{"/snapshots/snap-failed"}, deleted)
+}
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &SSHKeyResource{}
+var _ resource.ResourceWithImportState = &SSHKeyResource{}
+
+func NewSSHKeyResource() resource.Resource {
+	return &SSHKeyResource{}
+}
+
+// SSHKeyResource defines the resource implementation.
+type SSHKeyResource struct {
+	client *client.Client
+}
+
+// SSHKeyResourceModel describes the resource data model.
+type SSHKeyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (r *SSHKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *SSHKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SSH Key resource allows you to register a public key that instances can be launched with.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the SSH key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the SSH key. Instances refer to the key by this name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The public key in OpenSSH authorized_keys format, e.g. `ssh-ed25519 AAAA... user@host`. Keys cannot be changed, so changing it replaces the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					sshPublicKeyValidator{},
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA256 fingerprint of the public key, as printed by `ssh-keygen -l`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SSHKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fingerprint, err := sshKeyFingerprint(data.PublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid Public Key", err.Error())
+		return
+	}
+
+	key, err := r.client.CreateSSHKey(ctx, data.Name.ValueString(), data.PublicKey.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create SSH key", err)
+		return
+	}
+
+	data.ID = types.StringValue(key.ID)
+	data.Name = types.StringValue(key.Name)
+	data.Fingerprint = types.StringValue(fingerprint)
+
+	tflog.Trace(ctx, "created an SSH Key resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetSSHKey(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read SSH key", err)
+		return
+	}
+
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(key.ID)
+	data.Name = types.StringValue(key.Name)
+	data.setPublicKey(ctx, key.PublicKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddWarning("Update Not Supported", "Updating an SSH key is not supported. It will be recreated if changed.")
+}
+
+func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSSHKey(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete SSH key", err)
+		return
+	}
+}
+
+// ImportState imports an SSH key by its name.
+func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	keys, err := r.client.ListSSHKeys(ctx, client.ListFilter{Name: req.ID})
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to list SSH keys", err)
+		return
+	}
+
+	for _, key := range keys {
+		if key.Name == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), key.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("SSH Key Not Found", fmt.Sprintf("No SSH key named %q was found.", req.ID))
+}
+
+// setPublicKey stores the public key registered with the API. The fingerprint is computed
+// locally, and the configured key is kept as long as it is the same key, so a different
+// comment or whitespace is not reported as a change.
+func (m *SSHKeyResourceModel) setPublicKey(ctx context.Context, publicKey string) {
+	fingerprint, err := sshKeyFingerprint(publicKey)
+	if err != nil {
+		tflog.Warn(ctx, "unable to fingerprint the registered SSH key", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	if fingerprint != m.Fingerprint.ValueString() {
+		m.PublicKey = types.StringValue(publicKey)
+	}
+	m.Fingerprint = types.StringValue(fingerprint)
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of an OpenSSH public key in the format
+// printed by ssh-keygen, e.g. "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8".
+func sshKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", errors.New(`public key must be in OpenSSH format, e.g. "ssh-ed25519 AAAA... user@host"`)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("public key data is not valid base64: %w", err)
+	}
+
+	// The key data starts with its own length-prefixed key type
+	if len(blob) < 4 {
+		return "", errors.New("public key data is too short")
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(n) > uint64(len(blob)-4) || string(blob[4:4+n]) != fields[0] {
+		return "", fmt.Errorf("public key data does not match key type %q", fields[0])
+	}
+
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAdtuA5lqERI1T8MiwKODRVQ8rYf+NmfGC3VncXIWwrW test@example"
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	// Matches `ssh-keygen -lf`
+	fingerprint, err := sshKeyFingerprint(testSSHPublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "SHA256:1Tg9gKORlTUF2GhY9/QC6x3eE7NQxvLDsRxepwH8L7c", fingerprint)
+
+	// The comment is not part of the key
+	withoutComment, err := sshKeyFingerprint("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAdtuA5lqERI1T8MiwKODRVQ8rYf+NmfGC3VncXIWwrW\n")
+	assert.NoError(t, err)
+	assert.Equal(t, fingerprint, withoutComment)
+
+	_, err = sshKeyFingerprint("not a key")
+	assert.Error(t, err)
+	_, err = sshKeyFingerprint("ssh-ed25519")
+	assert.Error(t, err)
+	_, err = sshKeyFingerprint("ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIAdtuA5lqERI1T8MiwKODRVQ8rYf+NmfGC3VncXIWwrW")
+	assert.Error(t, err)
+	_, err = sshKeyFingerprint("ssh-ed25519 AAAA")
+	assert.Error(t, err)
+}
+
+func TestSSHKeySetPublicKey(t *testing.T) {
+	ctx := context.Background()
+	fingerprint, _ := sshKeyFingerprint(testSSHPublicKey)
+
+	// The same key with a different comment keeps the configured value
+	data := SSHKeyResourceModel{PublicKey: types.StringValue(testSSHPublicKey), Fingerprint: types.StringValue(fingerprint)}
+	data.setPublicKey(ctx, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAdtuA5lqERI1T8MiwKODRVQ8rYf+NmfGC3VncXIWwrW")
+	assert.Equal(t, testSSHPublicKey, data.PublicKey.ValueString())
+
+	// A different registered key is surfaced so the key is replaced
+	other := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ7S5w3x0m8qgm4cL3bH1N5w5oQ3G2yXo1M2uGc9mC1k other@example"
+	data.setPublicKey(ctx, other)
+	assert.Equal(t, other, data.PublicKey.ValueString())
+	assert.NotEqual(t, fingerprint, data.Fingerprint.ValueString())
+
+	// Imported keys take the registered key
+	data = SSHKeyResourceModel{PublicKey: types.StringNull(), Fingerprint: types.StringNull()}
+	data.setPublicKey(ctx, testSSHPublicKey)
+	assert.Equal(t, testSSHPublicKey, data.PublicKey.ValueString())
+	assert.Equal(t, fingerprint, data.Fingerprint.ValueString())
+}
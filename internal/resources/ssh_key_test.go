@@ -0,0 +1,52 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const sshKeyResourceName = "thecloud_ssh_key.test"
+
+func TestAccSSHKeyResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	keyName := fmt.Sprintf("test-key-%s", rName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSSHKeyConfig(keyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(sshKeyResourceName, "name", keyName),
+					resource.TestCheckResourceAttr(sshKeyResourceName, "fingerprint", "SHA256:1Tg9gKORlTUF2GhY9/QC6x3eE7NQxvLDsRxepwH8L7c"),
+					resource.TestCheckResourceAttrSet(sshKeyResourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.thecloud_ssh_key.test", "id", sshKeyResourceName, "id"),
+				),
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      sshKeyResourceName,
+				ImportState:       true,
+				ImportStateId:     keyName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSSHKeyConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_ssh_key" "test" {
+  name       = "%s"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAdtuA5lqERI1T8MiwKODRVQ8rYf+NmfGC3VncXIWwrW test@example"
+}
+
+data "thecloud_ssh_key" "test" {
+  name = thecloud_ssh_key.test.name
+}
+`, name)
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeStateByName returns a StateUpgrader for a prior schema version whose
+// attributes carry over to the current schema unchanged under the same names.
+// Attributes added since are set to null, for the next Read to fill in, and
+// attributes that were removed are dropped.
+//
+// Resources opt into it by bumping their schema Version and mapping every prior
+// version to it in UpgradeState. A version that renames an attribute or changes its
+// type needs an upgrader of its own, with a PriorSchema.
+func upgradeStateByName() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The prior state is missing.")
+				return
+			}
+
+			raw, err := req.RawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The prior state doesn't match the current schema: %s", err),
+				)
+				return
+			}
+
+			resp.State.Raw = raw
+		},
+	}
+}
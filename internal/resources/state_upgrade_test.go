@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upgradeState runs r's upgrader for version on rawJSON, the way Terraform does for
+// state written by an older provider.
+func upgradeState(t *testing.T, r resource.ResourceWithUpgradeState, version int64, rawJSON []byte) resource.UpgradeStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	require.Greater(t, schemaResp.Schema.Version, version, "the schema version must be bumped past upgraded versions")
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no upgrader for version %d", version)
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawJSON}}
+	if upgrader.PriorSchema != nil {
+		prior, err := req.RawState.Unmarshal(upgrader.PriorSchema.Type().TerraformType(ctx))
+		require.NoError(t, err)
+		req.State = &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior}
+	}
+
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	return resp
+}
+
+func readStateFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return b
+}
+
+func TestInstanceUpgradeStateV0(t *testing.T) {
+	resp := upgradeState(t, &InstanceResource{}, 0, readStateFixture(t, "instance_state_v0.json"))
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var data InstanceResourceModel
+	require.False(t, resp.State.Get(context.Background(), &data).HasError())
+
+	assert.Equal(t, "inst-123", data.ID.ValueString())
+	assert.Equal(t, "web-1", data.Name.ValueString())
+	assert.Equal(t, "ubuntu-22.04", data.Image.ValueString())
+	assert.Equal(t, "10.0.1.10", data.IPAddress.ValueString())
+
+	// Attributes added since version 0 are left for the next Read
+	assert.True(t, data.NamePrefix.IsNull())
+	assert.True(t, data.InstanceSize.IsNull())
+	assert.True(t, data.SecurityGroupIDs.IsNull())
+	assert.True(t, data.Labels.IsNull())
+}
+
+func TestClusterUpgradeStateV0(t *testing.T) {
+	resp := upgradeState(t, &ClusterResource{}, 0, readStateFixture(t, "cluster_state_v0.json"))
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var data ClusterResourceModel
+	require.False(t, resp.State.Get(context.Background(), &data).HasError())
+
+	assert.Equal(t, "cls-123", data.ID.ValueString())
+	assert.Equal(t, int64(3), data.WorkerCount.ValueInt64())
+	assert.True(t, data.NetworkIsolation.ValueBool())
+	assert.Equal(t, "203.0.113.10", data.APIServerLBAddress.ValueString())
+	assert.Nil(t, data.Autoscaling)
+}
+
+func TestUpgradeStateByName(t *testing.T) {
+	t.Run("drops removed attributes", func(t *testing.T) {
+		resp := upgradeState(t, &ClusterResource{}, 0, []byte(`{"id": "cls-123", "name": "prod", "kubeconfig": "apiVersion: v1"}`))
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var data ClusterResourceModel
+		require.False(t, resp.State.Get(context.Background(), &data).HasError())
+		assert.Equal(t, "prod", data.Name.ValueString())
+		assert.True(t, data.VpcID.IsNull())
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		resp := upgradeState(t, &ClusterResource{}, 0, []byte(`{"id": "cls-123", "worker_count": "three"}`))
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
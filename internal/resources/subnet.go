@@ -2,21 +2,27 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &SubnetResource{}
 var _ resource.ResourceWithImportState = &SubnetResource{}
+var _ resource.ResourceWithModifyPlan = &SubnetResource{}
 
 func NewSubnetResource() resource.Resource {
 	return &SubnetResource{}
@@ -29,11 +35,12 @@ type SubnetResource struct {
 
 // SubnetResourceModel describes the resource data model.
 type SubnetResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	VpcID            types.String `tfsdk:"vpc_id"`
-	Name             types.String `tfsdk:"name"`
-	CIDRBlock        types.String `tfsdk:"cidr_block"`
-	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	ID               types.String   `tfsdk:"id"`
+	VpcID            types.String   `tfsdk:"vpc_id"`
+	Name             types.String   `tfsdk:"name"`
+	CIDRBlock        types.String   `tfsdk:"cidr_block"`
+	AvailabilityZone types.String   `tfsdk:"availability_zone"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *SubnetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,19 +72,28 @@ func (r *SubnetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"cidr_block": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The CIDR block for the subnet.",
+				MarkdownDescription: "The CIDR block for the subnet, with a prefix length from /16 to /28. Must fall within the CIDR block of the VPC.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{minPrefix: 16, maxPrefix: 28},
+				},
 			},
 			"availability_zone": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The availability zone for the subnet.",
+				MarkdownDescription: "The availability zone for the subnet. Defaults to the provider's `default_availability_zone` when omitted.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					availabilityZoneValidator{},
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -115,10 +131,10 @@ func (r *SubnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.VpcID.ValueString(),
 		data.Name.ValueString(),
 		data.CIDRBlock.ValueString(),
-		data.AvailabilityZone.ValueString(),
+		r.client.AvailabilityZoneOrDefault(data.AvailabilityZone.ValueString()),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create subnet, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create subnet", err)
 		return
 	}
 
@@ -132,6 +148,8 @@ func (r *SubnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.AvailabilityZone = types.StringNull()
 	}
 
+	resp.Diagnostics.Append(setPrivateCreatedAt(ctx, resp.Private)...)
+
 	tflog.Trace(ctx, "created a Subnet resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -146,9 +164,11 @@ func (r *SubnetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	subnet, err := r.client.GetSubnet(ctx, data.ID.ValueString())
+	subnet, err := readCreated(ctx, r.client, req.Private, func(ctx context.Context) (*client.Subnet, error) {
+		return r.client.GetSubnet(ctx, data.ID.ValueString())
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read subnet, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read subnet", err)
 		return
 	}
 
@@ -183,11 +203,66 @@ func (r *SubnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteSubnet(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete subnet, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete subnet", err)
+		return
+	}
+
+	// Wait for subnet to be gone from API (async deletion in backend)
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		subnet, err := r.client.GetSubnet(ctx, data.ID.ValueString())
+		if err != nil || subnet == nil {
+			return "", subnet == nil, err
+		}
+		return subnet.Status, false, nil
+	}, nil, nil, client.WaitOpts{Timeout: deleteTimeout, MinInterval: 5 * time.Second, MaxInterval: 5 * time.Second})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for subnet %s to be deleted. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking subnet status", err)
 		return
 	}
+
+	tflog.Trace(ctx, "subnet successfully deleted")
+}
+
+func (r *SubnetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var vpcID, cidr types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("vpc_id"), &vpcID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cidr_block"), &cidr)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An existing subnet was already accepted by the API
+	if !req.State.Raw.IsNull() {
+		var stateVpcID, stateCIDR types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("vpc_id"), &stateVpcID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cidr_block"), &stateCIDR)...)
+		if stateVpcID.Equal(vpcID) && stateCIDR.Equal(cidr) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateSubnetWithinVPC(ctx, r.client, vpcID, cidr)...)
 }
 
 func (r *SubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// validateSubnetWithinVPC reports an error on the cidr_block attribute when a subnet's
+// CIDR block lies outside its VPC. The check is skipped while the VPC is not yet known,
+// for example when it's created in the same apply, or when it can't be read.
+func validateSubnetWithinVPC(ctx context.Context, c *client.Client, vpcID, cidr types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil || vpcID.IsNull() || vpcID.IsUnknown() || cidr.IsNull() || cidr.IsUnknown() {
+		return diags
+	}
+
+	vpc, err := c.GetVPC(ctx, vpcID.ValueString())
+	if err != nil || vpc == nil || vpc.CIDRBlock == "" {
+		tflog.Debug(ctx, "skipping subnet CIDR check, VPC unavailable", map[string]interface{}{"vpc_id": vpcID.ValueString()})
+		return diags
+	}
+
+	if err := checkSubnetWithinVPC(vpc.CIDRBlock, cidr.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("cidr_block"), "Subnet Outside VPC", fmt.Sprintf("VPC %s: %s", vpc.ID, err))
+	}
+
+	return diags
+}
+
+// checkSubnetWithinVPC returns an error when the subnet CIDR block is not contained in
+// the VPC CIDR block. Blocks that don't parse are left to the CIDR validators.
+func checkSubnetWithinVPC(vpcCIDR, subnetCIDR string) error {
+	_, vpcNet, err := net.ParseCIDR(vpcCIDR)
+	if err != nil {
+		return nil
+	}
+	_, subnetNet, err := net.ParseCIDR(subnetCIDR)
+	if err != nil {
+		return nil
+	}
+
+	vpcPrefix, vpcBits := vpcNet.Mask.Size()
+	subnetPrefix, subnetBits := subnetNet.Mask.Size()
+	if vpcBits == subnetBits && subnetPrefix >= vpcPrefix && vpcNet.Contains(subnetNet.IP) {
+		return nil
+	}
+
+	return fmt.Errorf("subnet CIDR block %s is not within the VPC CIDR block %s", subnetCIDR, vpcCIDR)
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSubnetWithinVPC(t *testing.T) {
+	tests := map[string]struct {
+		vpc, subnet string
+		wantError   bool
+	}{
+		"inside":            {"10.0.0.0/16", "10.0.1.0/24", false},
+		"same block":        {"10.0.0.0/16", "10.0.0.0/16", false},
+		"last block":        {"10.0.0.0/16", "10.0.255.240/28", false},
+		"outside":           {"10.0.0.0/16", "10.1.0.0/24", true},
+		"larger than vpc":   {"10.0.0.0/16", "10.0.0.0/8", true},
+		"different family":  {"10.0.0.0/16", "2001:db8::/64", true},
+		"unparseable vpc":   {"", "10.0.1.0/24", false},
+		"unparseable block": {"10.0.0.0/16", "10.0.1.0", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkSubnetWithinVPC(tt.vpc, tt.subnet)
+			assert.Equal(t, tt.wantError, err != nil, err)
+		})
+	}
+}
+
+func TestValidateSubnetWithinVPC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vpcs/vpc-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(client.VPC{ID: "vpc-1", CIDRBlock: "10.0.0.0/16"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	assert.False(t, validateSubnetWithinVPC(ctx, c, types.StringValue("vpc-1"), types.StringValue("10.0.1.0/24")).HasError())
+	assert.False(t, validateSubnetWithinVPC(ctx, c, types.StringUnknown(), types.StringValue("192.168.0.0/24")).HasError())
+	assert.False(t, validateSubnetWithinVPC(ctx, c, types.StringValue("vpc-missing"), types.StringValue("192.168.0.0/24")).HasError())
+
+	diags := validateSubnetWithinVPC(ctx, c, types.StringValue("vpc-1"), types.StringValue("192.168.0.0/24"))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Subnet Outside VPC", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "10.0.0.0/16")
+}
@@ -2,7 +2,9 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -68,6 +71,9 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"owner_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The user ID of the tenant owner.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"plan": schema.StringAttribute{
 				Computed:            true,
@@ -80,6 +86,9 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"created_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The timestamp when the tenant was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -115,7 +124,7 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tenant, err := r.client.CreateTenant(ctx, data.Name.ValueString(), data.Slug.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create Tenant, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create Tenant", err)
 		return
 	}
 
@@ -139,45 +148,129 @@ func (r *TenantResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Since GetTenant by ID is not yet supported, we'll try to find it in the list by Slug
-	tenants, err := r.client.ListTenants(ctx)
+	var tenant *client.Tenant
+	var err error
+
+	// Tenants imported by slug before the ID was resolved are looked up by slug once
+	if data.ID.ValueString() == "" {
+		tenant, err = lookupTenantBySlug(ctx, r.client, data.Slug.ValueString())
+	} else {
+		tenant, err = r.client.GetTenant(ctx, data.ID.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read Tenants, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read Tenant", err)
 		return
 	}
 
-	var found *client.Tenant
-	for _, t := range tenants {
-		if t.Slug == data.Slug.ValueString() {
-			found = &t
-			break
-		}
-	}
-
-	if found == nil {
+	if tenant == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	data.ID = types.StringValue(found.ID)
-	data.Name = types.StringValue(found.Name)
-	data.OwnerID = types.StringValue(found.OwnerID)
-	data.Plan = types.StringValue(found.Plan)
-	data.Status = types.StringValue(found.Status)
-	data.CreatedAt = types.StringValue(found.CreatedAt.String())
+	data.ID = types.StringValue(tenant.ID)
+	data.Name = types.StringValue(tenant.Name)
+	data.Slug = types.StringValue(tenant.Slug)
+	data.OwnerID = types.StringValue(tenant.OwnerID)
+	data.Plan = types.StringValue(tenant.Plan)
+	data.Status = types.StringValue(tenant.Status)
+	data.CreatedAt = types.StringValue(tenant.CreatedAt.String())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not supported
+	var data TenantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenant, err := r.client.UpdateTenant(ctx, data.ID.ValueString(), client.UpdateTenantRequest{
+		Name: data.Name.ValueString(),
+	})
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update Tenant", err)
+		return
+	}
+
+	data.Name = types.StringValue(tenant.Name)
+	data.OwnerID = types.StringValue(tenant.OwnerID)
+	data.Plan = types.StringValue(tenant.Plan)
+	data.Status = types.StringValue(tenant.Status)
+	data.CreatedAt = types.StringValue(tenant.CreatedAt.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Backend doesn't seem to have direct DeleteTenant yet based on handler
-	resp.Diagnostics.AddWarning("Delete Not Supported", "The backend does not support deleting tenants via API yet.")
+	var data TenantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTenant(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete Tenant", err)
+		return
+	}
+
+	// Wait for the tenant to be gone from API (its resources are torn down asynchronously)
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		tenant, err := r.client.GetTenant(ctx, data.ID.ValueString())
+		if err != nil || tenant == nil {
+			return "", tenant == nil, err
+		}
+		return tenant.Status, false, nil
+	}, nil, nil, client.WaitOpts{Timeout: 10 * time.Minute, MinInterval: 5 * time.Second, MaxInterval: 5 * time.Second})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for Tenant %s to be deleted. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking Tenant status", err)
+		return
+	}
+
+	tflog.Trace(ctx, "Tenant successfully deleted")
 }
 
+// ImportState accepts a tenant slug, which is resolved to the tenant's ID once. Anything
+// that isn't a known slug is taken to be an ID.
 func (r *TenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+	tenant, err := lookupTenantBySlug(ctx, r.client, req.ID)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to look up Tenant", err)
+		return
+	}
+
+	if tenant == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tenant.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), tenant.Slug)...)
+}
+
+// lookupTenantBySlug returns the tenant with the given slug, or nil when there is none.
+func lookupTenantBySlug(ctx context.Context, c *client.Client, slug string) (*client.Tenant, error) {
+	tenants, err := c.ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tenants {
+		if t.Slug == slug {
+			return &t, nil
+		}
+	}
+
+	return nil, nil // nolint:nilnil
 }
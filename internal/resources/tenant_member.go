@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &TenantMemberResource{}
+var _ resource.ResourceWithImportState = &TenantMemberResource{}
+
+// tenantMemberRoles are the roles a tenant member can have.
+var tenantMemberRoles = []string{"owner", "admin", "member"}
+
+func NewTenantMemberResource() resource.Resource {
+	return &TenantMemberResource{}
+}
+
+// TenantMemberResource defines the resource implementation.
+type TenantMemberResource struct {
+	client *client.Client
+}
+
+// TenantMemberResourceModel describes the resource data model.
+type TenantMemberResourceModel struct {
+	ID        types.String `tfsdk:"id"` // Format: {tenant_id}:{member_id}
+	TenantID  types.String `tfsdk:"tenant_id"`
+	MemberID  types.String `tfsdk:"member_id"`
+	UserEmail types.String `tfsdk:"user_email"`
+	UserID    types.String `tfsdk:"user_id"`
+	Role      types.String `tfsdk:"role"`
+}
+
+func (r *TenantMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_member"
+}
+
+func (r *TenantMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tenant Member resource allows you to manage the users of a tenant and their roles.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The composite ID of the membership (tenant_id:member_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the tenant.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the membership within the tenant.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address of the user to add to the tenant.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The role of the user in the tenant (owner, admin, member).",
+				Validators: []validator.String{
+					stringOneOfValidator{values: tenantMemberRoles},
+				},
+			},
+		},
+	}
+}
+
+func (r *TenantMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TenantMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TenantMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.AddTenantMember(ctx, data.TenantID.ValueString(), data.UserEmail.ValueString(), data.Role.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to add tenant member", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.TenantID.ValueString(), member.ID))
+	data.setMember(member)
+
+	tflog.Trace(ctx, "created a Tenant Member resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TenantMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListTenantMembers(ctx, data.TenantID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read tenant members", err)
+		return
+	}
+
+	var found *client.TenantMember
+	for _, m := range members {
+		if m.ID == data.MemberID.ValueString() {
+			found = &m
+			break
+		}
+	}
+
+	// The member was removed, or the tenant deleted, outside Terraform
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setMember(found)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TenantMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.UpdateTenantMember(ctx, data.TenantID.ValueString(), data.MemberID.ValueString(), data.Role.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to update tenant member", err)
+		return
+	}
+
+	data.setMember(member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TenantMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveTenantMember(ctx, data.TenantID.ValueString(), data.MemberID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to remove tenant member", err)
+		return
+	}
+}
+
+func (r *TenantMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import requires tenant_id:member_id
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: tenant_id:member_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setMember copies the API's view of a membership into the model. The configured email
+// is kept when the API only differs in case.
+func (m *TenantMemberResourceModel) setMember(member *client.TenantMember) {
+	m.MemberID = types.StringValue(member.ID)
+	m.UserID = types.StringValue(member.UserID)
+	m.Role = types.StringValue(member.Role)
+	if !strings.EqualFold(m.UserEmail.ValueString(), member.UserEmail) {
+		m.UserEmail = types.StringValue(member.UserEmail)
+	}
+}
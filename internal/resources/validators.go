@@ -0,0 +1,666 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+var _ resource.ConfigValidator = conflictingAttributesValidator{}
+
+// conflictingAttributesValidator rejects configurations that set an attribute together
+// with any of the attributes it conflicts with.
+type conflictingAttributesValidator struct {
+	attribute   path.Path
+	conflicting []path.Path
+}
+
+func conflictsWith(attribute path.Path, conflicting ...path.Path) resource.ConfigValidator {
+	return conflictingAttributesValidator{attribute: attribute, conflicting: conflicting}
+}
+
+func (v conflictingAttributesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s cannot be configured together with %v", v.attribute, v.conflicting)
+}
+
+func (v conflictingAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conflictingAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var value attr.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.attribute, &value)...)
+	if resp.Diagnostics.HasError() || value == nil || value.IsNull() {
+		return
+	}
+
+	for _, other := range v.conflicting {
+		var otherValue attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, other, &otherValue)...)
+		if otherValue == nil || otherValue.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			v.attribute,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q cannot be specified when %q is specified.", other, v.attribute),
+		)
+	}
+}
+
+var _ resource.ConfigValidator = requiredTogetherValidator{}
+
+// requiredTogetherValidator rejects configurations that set some, but not all, of a
+// group of attributes.
+type requiredTogetherValidator struct {
+	attributes []path.Path
+}
+
+func requiredTogether(attributes ...path.Path) resource.ConfigValidator {
+	return requiredTogetherValidator{attributes: attributes}
+}
+
+func (v requiredTogetherValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%v must be configured together", v.attributes)
+}
+
+func (v requiredTogetherValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requiredTogetherValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set, missing []path.Path
+	for _, p := range v.attributes {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+		switch {
+		case value == nil || value.IsNull():
+			missing = append(missing, p)
+		default:
+			set = append(set, p)
+		}
+	}
+
+	if len(set) == 0 || len(missing) == 0 {
+		return
+	}
+
+	for _, p := range missing {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Missing Attribute Configuration",
+			fmt.Sprintf("Attribute %q must be specified when %q is specified.", p, set[0]),
+		)
+	}
+}
+
+var _ resource.ConfigValidator = typeSpecificAttributesValidator{}
+
+// typeSpecificAttributesValidator checks attributes that only apply to some values of a
+// type attribute: required attributes of the chosen type must be set, and attributes of
+// other types must not be. With ignoreCase the type is matched case-insensitively.
+type typeSpecificAttributesValidator struct {
+	typeAttribute path.Path
+	required      map[string][]path.Path
+	optional      map[string][]path.Path
+	ignoreCase    bool
+}
+
+func (v typeSpecificAttributesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("only the attributes belonging to the chosen %s may be configured", v.typeAttribute)
+}
+
+func (v typeSpecificAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v typeSpecificAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var chosen types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.typeAttribute, &chosen)...)
+	if resp.Diagnostics.HasError() || chosen.IsNull() || chosen.IsUnknown() {
+		return
+	}
+
+	typ := v.typeName(chosen.ValueString())
+
+	for _, p := range v.required[typ] {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+		if value == nil || value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute %q must be specified when %q is %q.", p, v.typeAttribute, chosen.ValueString()),
+			)
+		}
+	}
+
+	// Each attribute is reported once, naming every type it belongs to
+	allowed := map[string][]string{}
+	paths := map[string]path.Path{}
+	for _, byType := range []map[string][]path.Path{v.required, v.optional} {
+		for typ, ps := range byType {
+			for _, p := range ps {
+				allowed[p.String()] = append(allowed[p.String()], typ)
+				paths[p.String()] = p
+			}
+		}
+	}
+
+	names := make([]string, 0, len(paths))
+	for name := range paths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if slices.Contains(allowed[name], typ) {
+			continue
+		}
+
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, paths[name], &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+
+		typs := allowed[name]
+		sort.Strings(typs)
+		resp.Diagnostics.AddAttributeError(
+			paths[name],
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %q can only be specified when %q is one of: %s.", name, v.typeAttribute, strings.Join(typs, ", ")),
+		)
+	}
+}
+
+// typeName returns the type the configured value selects, as it is spelled in required
+// and optional.
+func (v typeSpecificAttributesValidator) typeName(value string) string {
+	if !v.ignoreCase {
+		return value
+	}
+	for _, byType := range []map[string][]path.Path{v.required, v.optional} {
+		for typ := range byType {
+			if strings.EqualFold(typ, value) {
+				return typ
+			}
+		}
+	}
+	return value
+}
+
+var _ validator.Int64 = int64BetweenValidator{}
+
+// portValidator checks that an integer is a TCP or UDP port number.
+var portValidator = int64BetweenValidator{min: 1, max: 65535}
+
+// int64BetweenValidator checks that an integer is within an inclusive range.
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+func (v int64BetweenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if n := req.ConfigValue.ValueInt64(); n < v.min || n > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected a value between %d and %d, got %d.", v.min, v.max, n),
+		)
+	}
+}
+
+var _ validator.String = stringOneOfValidator{}
+
+// stringOneOfValidator checks that a string is one of a fixed set of values. With ignoreCase
+// set, values are compared case-insensitively for fields the API treats that way.
+type stringOneOfValidator struct {
+	values     []string
+	ignoreCase bool
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	if v.ignoreCase {
+		return fmt.Sprintf("value must be one of (case-insensitive): %s", strings.Join(v.values, ", "))
+	}
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	valid := slices.ContainsFunc(v.values, func(allowed string) bool {
+		if v.ignoreCase {
+			return strings.EqualFold(allowed, value)
+		}
+		return allowed == value
+	})
+
+	if !valid {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected one of %s, got %q.", strings.Join(v.values, ", "), value),
+		)
+	}
+}
+
+var _ validator.Set = stringSetOneOfValidator{}
+
+// stringSetOneOfValidator checks that every element of a set of strings is one of a fixed
+// set of values.
+type stringSetOneOfValidator struct {
+	values []string
+}
+
+func (v stringSetOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("each value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringSetOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringSetOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if !slices.Contains(v.values, value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(element),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Expected one of %s, got %q.", strings.Join(v.values, ", "), value.ValueString()),
+			)
+		}
+	}
+}
+
+var _ validator.Set = cidrSetValidator{}
+
+// cidrSetValidator checks that every element of a set of strings is a CIDR block.
+type cidrSetValidator struct{}
+
+func (v cidrSetValidator) Description(ctx context.Context) string {
+	return "each value must be a CIDR block, e.g. 10.0.1.0/24"
+}
+
+func (v cidrSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrSetValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(element),
+				"Invalid CIDR Block",
+				fmt.Sprintf("Expected a CIDR block such as \"10.0.1.0/24\", got %q.", value.ValueString()),
+			)
+		}
+	}
+}
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator checks that a string is a CIDR block, optionally with a prefix length
+// between minPrefix and maxPrefix. Zero bounds are not checked.
+type cidrValidator struct {
+	minPrefix, maxPrefix int
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	if v.minPrefix == 0 && v.maxPrefix == 0 {
+		return "value must be a CIDR block, e.g. 10.0.1.0/24"
+	}
+	return fmt.Sprintf("value must be a CIDR block with a prefix length from /%d to /%d", v.minPrefix, v.maxPrefix)
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, ipNet, err := net.ParseCIDR(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("Expected a CIDR block such as \"10.0.1.0/24\", got %q.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	prefix, _ := ipNet.Mask.Size()
+	if (v.minPrefix > 0 && prefix < v.minPrefix) || (v.maxPrefix > 0 && prefix > v.maxPrefix) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("Expected a prefix length from /%d to /%d, got %q.", v.minPrefix, v.maxPrefix, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator checks that a string is an IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IP address, e.g. 203.0.113.10"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(req.ConfigValue.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Expected an IP address such as \"203.0.113.10\", got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = availabilityZoneValidator{}
+
+// availabilityZoneValidator checks the format of an availability zone name. The API
+// silently places resources in a default zone when given one it doesn't know.
+type availabilityZoneValidator struct{}
+
+func (v availabilityZoneValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be an availability zone name matching %s", client.AvailabilityZonePattern)
+}
+
+func (v availabilityZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v availabilityZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !client.AvailabilityZonePattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Availability Zone",
+			fmt.Sprintf("Expected an availability zone name such as \"us-east-1a\", got %q. "+
+				"Use the thecloud_availability_zones data source to list the available zones.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = cronScheduleValidator{}
+
+// cronScheduleValidator checks that a string is a five-field cron expression that runs at
+// most once an hour, the most frequent schedule the API supports.
+type cronScheduleValidator struct{}
+
+func (v cronScheduleValidator) Description(ctx context.Context) string {
+	return "value must be a cron expression that runs at most once an hour"
+}
+
+func (v cronScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronScheduleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkCronSchedule(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Schedule",
+			fmt.Sprintf("Expected a cron expression such as \"0 3 * * *\", got %q: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// cronMacros are the shorthand schedules accepted in place of the five fields.
+var cronMacros = []string{"@hourly", "@daily", "@midnight", "@weekly", "@monthly", "@yearly", "@annually"}
+
+// cronFields names the fields of a cron expression with the values each accepts.
+var cronFields = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// checkCronSchedule parses a cron expression. Only a single minute is allowed, since
+// anything else would run more than once an hour.
+func checkCronSchedule(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		if !slices.Contains(cronMacros, expr) {
+			return fmt.Errorf("unknown schedule %s, expected one of %s", expr, strings.Join(cronMacros, ", "))
+		}
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d", len(fields))
+	}
+
+	for i, field := range fields {
+		if err := checkCronField(field, cronFields[i].min, cronFields[i].max); err != nil {
+			return fmt.Errorf("invalid %s field %q: %w", cronFields[i].name, field, err)
+		}
+	}
+
+	if _, err := strconv.Atoi(fields[0]); err != nil {
+		return fmt.Errorf("the schedule runs more than once an hour, the minute field must be a single value")
+	}
+
+	return nil
+}
+
+// checkCronField validates a comma-separated list of values, ranges and steps.
+func checkCronField(field string, min, max int) error {
+	for _, part := range strings.Split(field, ",") {
+		valueRange, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 {
+				return fmt.Errorf("step %q must be a positive number", step)
+			}
+		}
+
+		if valueRange == "*" {
+			continue
+		}
+
+		low, high, isRange := strings.Cut(valueRange, "-")
+		if !isRange {
+			high = low
+		}
+
+		lo, err := strconv.Atoi(low)
+		if err != nil || lo < min || lo > max {
+			return fmt.Errorf("%q must be a number from %d to %d", low, min, max)
+		}
+		hi, err := strconv.Atoi(high)
+		if err != nil || hi < min || hi > max {
+			return fmt.Errorf("%q must be a number from %d to %d", high, min, max)
+		}
+		if lo > hi {
+			return fmt.Errorf("range %q is reversed", valueRange)
+		}
+	}
+
+	return nil
+}
+
+var _ validator.String = sshPublicKeyValidator{}
+
+// sshPublicKeyValidator checks that a string is an OpenSSH public key.
+type sshPublicKeyValidator struct{}
+
+func (v sshPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be a public key in OpenSSH authorized_keys format"
+}
+
+func (v sshPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := sshKeyFingerprint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Public Key", err.Error())
+	}
+}
+
+// maintenanceWindowPattern matches a weekly window such as sun:03:00-sun:04:00. Day
+// names are matched case-insensitively.
+var maintenanceWindowPattern = regexp.MustCompile(`(?i)^(mon|tue|wed|thu|fri|sat|sun):([01][0-9]|2[0-3]):[0-5][0-9]-(mon|tue|wed|thu|fri|sat|sun):([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+var _ validator.String = maintenanceWindowValidator{}
+
+// maintenanceWindowValidator checks that a string is a ddd:hh24:mi-ddd:hh24:mi window.
+type maintenanceWindowValidator struct{}
+
+func (v maintenanceWindowValidator) Description(ctx context.Context) string {
+	return "value must be a weekly window formatted as ddd:hh24:mi-ddd:hh24:mi"
+}
+
+func (v maintenanceWindowValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v maintenanceWindowValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !maintenanceWindowPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Maintenance Window",
+			fmt.Sprintf("Expected a weekly window formatted as ddd:hh24:mi-ddd:hh24:mi, such as sun:03:00-sun:04:00. Got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.Map = routingWeightsValidator{}
+
+// routingWeightsValidator checks that a map of function versions to weights routes all
+// traffic: every key is a version number, every weight is a percentage and the weights
+// add up to 100.
+type routingWeightsValidator struct{}
+
+func (v routingWeightsValidator) Description(ctx context.Context) string {
+	return "keys must be function versions and weights must add up to 100"
+}
+
+func (v routingWeightsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v routingWeightsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	total, complete := int64(0), true
+	for key, element := range req.ConfigValue.Elements() {
+		if version, err := strconv.Atoi(key); err != nil || version < 1 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Function Version",
+				fmt.Sprintf("Expected a function version such as \"2\", got %q.", key),
+			)
+		}
+
+		weight, ok := element.(types.Int64)
+		if !ok || weight.IsNull() || weight.IsUnknown() {
+			complete = false
+			continue
+		}
+
+		if weight.ValueInt64() < 0 || weight.ValueInt64() > 100 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Routing Weight",
+				fmt.Sprintf("Expected a weight between 0 and 100, got %d.", weight.ValueInt64()),
+			)
+		}
+		total += weight.ValueInt64()
+	}
+
+	// The total can only be checked once every weight is known
+	if complete && total != 100 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Routing Weights",
+			fmt.Sprintf("The routing weights must add up to 100, got %d.", total),
+		)
+	}
+}
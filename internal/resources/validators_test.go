@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityZoneValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		availabilityZoneValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("availability_zone"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("us-east-1a")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("us-east-1")))
+	assert.True(t, validate(types.StringValue("")))
+}
+
+func TestInt64BetweenValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.Int64) bool {
+		resp := &validator.Int64Response{}
+		int64BetweenValidator{min: 1, max: 8}.ValidateInt64(ctx, validator.Int64Request{Path: path.Root("gpu_count"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.Int64Value(1)))
+	assert.False(t, validate(types.Int64Value(8)))
+	assert.False(t, validate(types.Int64Null()))
+	assert.True(t, validate(types.Int64Value(0)))
+	assert.True(t, validate(types.Int64Value(9)))
+}
+
+func TestStringOneOfValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		stringOneOfValidator{values: tenantMemberRoles}.ValidateString(ctx, validator.StringRequest{Path: path.Root("role"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("admin")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("Admin")))
+	assert.True(t, validate(types.StringValue("viewer")))
+}
+
+func TestStringOneOfValidatorIgnoreCase(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) diag.Diagnostics {
+		resp := &validator.StringResponse{}
+		stringOneOfValidator{values: loadBalancerAlgorithms, ignoreCase: true}.ValidateString(ctx, validator.StringRequest{Path: path.Root("algorithm"), ConfigValue: value}, resp)
+		return resp.Diagnostics
+	}
+
+	assert.False(t, validate(types.StringValue("round-robin")).HasError())
+	assert.False(t, validate(types.StringValue("Least-Connections")).HasError())
+
+	diags := validate(types.StringValue("round_robin"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "round-robin, least-connections")
+}
+
+func TestStringValueIgnoringCase(t *testing.T) {
+	assert.Equal(t, types.StringValue("Ingress"), stringValueIgnoringCase(types.StringValue("Ingress"), "ingress"))
+	assert.Equal(t, types.StringValue("egress"), stringValueIgnoringCase(types.StringValue("Ingress"), "egress"))
+	assert.Equal(t, types.StringValue("ingress"), stringValueIgnoringCase(types.StringNull(), "ingress"))
+	assert.Equal(t, types.StringValue("ingress"), stringValueIgnoringCase(types.StringUnknown(), "ingress"))
+}
+
+func TestStringSetOneOfValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(values ...string) bool {
+		set, diags := types.SetValueFrom(ctx, types.StringType, values)
+		assert.False(t, diags.HasError())
+
+		resp := &validator.SetResponse{}
+		stringSetOneOfValidator{values: httpMethods}.ValidateSet(ctx, validator.SetRequest{Path: path.Root("methods"), ConfigValue: set}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate("POST", "GET"))
+	assert.False(t, validate())
+	assert.True(t, validate("GET", "get"))
+	assert.True(t, validate("FETCH"))
+}
+
+func TestCheckCronSchedule(t *testing.T) {
+	for _, expr := range []string{"0 3 * * *", "30 */6 * * 1-5", "15 0,12 1 * *", "0 0 * 1-12/3 0", "@daily", " 5 * * * * "} {
+		assert.NoError(t, checkCronSchedule(expr), expr)
+	}
+
+	tests := map[string]string{
+		"* * * * *":    "more than once an hour",
+		"*/15 * * * *": "more than once an hour",
+		"0,30 * * * *": "more than once an hour",
+		"0-5 3 * * *":  "more than once an hour",
+		"0 3 * *":      "expected 5 fields",
+		"60 3 * * *":   "minute field",
+		"0 24 * * *":   "hour field",
+		"0 3 0 * *":    "day of month field",
+		"0 3 * 13 *":   "month field",
+		"0 3 * * 8":    "day of week field",
+		"0 5-1 * * *":  "reversed",
+		"0 */0 * * *":  "positive number",
+		"0 3 * * MON":  "day of week field",
+		"@every 1h":    "unknown schedule",
+	}
+	for expr, want := range tests {
+		err := checkCronSchedule(expr)
+		if assert.Error(t, err, expr) {
+			assert.Contains(t, err.Error(), want, expr)
+		}
+	}
+}
+
+func TestCronScheduleValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		cronScheduleValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("schedule"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("0 3 * * *")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("*/5 * * * *")))
+}
+
+func TestMaintenanceWindowValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		maintenanceWindowValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("maintenance_window"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("sun:03:00-sun:04:00")))
+	assert.False(t, validate(types.StringValue("Sat:23:30-Sun:00:30")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("sunday:03:00-sunday:04:00")))
+	assert.True(t, validate(types.StringValue("sun:24:00-mon:01:00")))
+	assert.True(t, validate(types.StringValue("sun:3:00-sun:4:00")))
+}
+
+func TestCIDRValidator(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		validator cidrValidator
+		value     types.String
+		wantError bool
+	}{
+		"valid":                {cidrValidator{}, types.StringValue("10.0.0.0/16"), false},
+		"host bits":            {cidrValidator{}, types.StringValue("10.0.1.5/24"), false},
+		"any address":          {cidrValidator{}, types.StringValue("0.0.0.0/0"), false},
+		"ipv6":                 {cidrValidator{}, types.StringValue("2001:db8::/32"), false},
+		"null":                 {cidrValidator{}, types.StringNull(), false},
+		"unknown":              {cidrValidator{}, types.StringUnknown(), false},
+		"no prefix":            {cidrValidator{}, types.StringValue("10.0.0.0"), true},
+		"bad address":          {cidrValidator{}, types.StringValue("10.0.0.256/24"), true},
+		"prefix too long":      {cidrValidator{}, types.StringValue("10.0.0.0/33"), true},
+		"empty":                {cidrValidator{}, types.StringValue(""), true},
+		"subnet lower bound":   {cidrValidator{minPrefix: 16, maxPrefix: 28}, types.StringValue("10.0.0.0/16"), false},
+		"subnet upper bound":   {cidrValidator{minPrefix: 16, maxPrefix: 28}, types.StringValue("10.0.0.0/28"), false},
+		"subnet too large":     {cidrValidator{minPrefix: 16, maxPrefix: 28}, types.StringValue("10.0.0.0/8"), true},
+		"subnet too small":     {cidrValidator{minPrefix: 16, maxPrefix: 28}, types.StringValue("10.0.0.0/30"), true},
+		"subnet not parseable": {cidrValidator{minPrefix: 16, maxPrefix: 28}, types.StringValue("subnet-a"), true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(ctx, validator.StringRequest{Path: path.Root("cidr_block"), ConfigValue: tt.value}, resp)
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestIPAddressValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		ipAddressValidator{}.ValidateString(ctx, validator.StringRequest{Path: path.Root("target_ip"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("203.0.113.10")))
+	assert.False(t, validate(types.StringValue("2001:db8::1")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("203.0.113.0/24")))
+	assert.True(t, validate(types.StringValue("lb-1")))
+	assert.True(t, validate(types.StringValue("")))
+}
+
+func TestRoutingWeightsValidator(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(weights map[string]types.Int64) bool {
+		elements := map[string]attr.Value{}
+		for version, weight := range weights {
+			elements[version] = weight
+		}
+		value := types.MapValueMust(types.Int64Type, elements)
+
+		resp := &validator.MapResponse{}
+		routingWeightsValidator{}.ValidateMap(ctx, validator.MapRequest{Path: path.Root("routing"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(map[string]types.Int64{"1": types.Int64Value(90), "2": types.Int64Value(10)}))
+	assert.False(t, validate(map[string]types.Int64{"3": types.Int64Value(100)}))
+	assert.False(t, validate(map[string]types.Int64{"1": types.Int64Value(90), "2": types.Int64Unknown()}))
+	assert.True(t, validate(map[string]types.Int64{"1": types.Int64Value(90), "2": types.Int64Value(20)}))
+	assert.True(t, validate(map[string]types.Int64{"latest": types.Int64Value(100)}))
+	assert.True(t, validate(map[string]types.Int64{"1": types.Int64Value(150), "2": types.Int64Value(-50)}))
+	assert.True(t, validate(map[string]types.Int64{}))
+}
@@ -10,14 +10,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
 // Ensure implementation of interfaces
 var _ resource.Resource = &VolumeResource{}
 var _ resource.ResourceWithImportState = &VolumeResource{}
+var _ resource.ResourceWithConfigValidators = &VolumeResource{}
+var _ resource.ResourceWithValidateConfig = &VolumeResource{}
+var _ resource.ResourceWithModifyPlan = &VolumeResource{}
 
 func NewVolumeResource() resource.Resource {
 	return &VolumeResource{}
@@ -30,10 +35,12 @@ type VolumeResource struct {
 
 // VolumeResourceModel describes the resource data model.
 type VolumeResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	SizeGB types.Int64  `tfsdk:"size_gb"`
-	Status types.String `tfsdk:"status"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	SizeGB           types.Int64  `tfsdk:"size_gb"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	SnapshotID       types.String `tfsdk:"snapshot_id"`
+	Status           types.String `tfsdk:"status"`
 }
 
 func (r *VolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,12 +64,33 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The name of the volume.",
 			},
 			"size_gb": schema.Int64Attribute{
-				Required:            true,
-				MarkdownDescription: "The size of the volume in GiB.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The size of the volume in GiB. Required unless `snapshot_id` is set, in which case it defaults to the size of the snapshot and must not be smaller.",
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"availability_zone": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The availability zone for the volume. A volume can only be attached to instances in the same zone. Defaults to the provider's `default_availability_zone`, or is chosen by the scheduler when neither is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					availabilityZoneValidator{},
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of a snapshot to restore the volume from. The volume is created in the zone of the snapshot. Conflicts with `availability_zone`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the volume.",
@@ -74,6 +102,30 @@ func (r *VolumeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *VolumeResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		conflictsWith(path.Root("snapshot_id"), path.Root("availability_zone")),
+	}
+}
+
+func (r *VolumeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VolumeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SizeGB.IsNull() && data.SnapshotID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size_gb"),
+			"Missing Attribute Configuration",
+			"Attribute \"size_gb\" must be specified unless the volume is restored from a \"snapshot_id\".",
+		)
+	}
+}
+
 func (r *VolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -102,15 +154,32 @@ func (r *VolumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	vol, err := r.client.CreateVolume(ctx, data.Name.ValueString(), int(data.SizeGB.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create volume, got error: %s", err))
-		return
+	var zone string
+	var vol *client.Volume
+	var err error
+	if !data.SnapshotID.IsNull() {
+		vol, err = r.client.RestoreSnapshot(ctx, data.SnapshotID.ValueString(), data.Name.ValueString(), int(data.SizeGB.ValueInt64()))
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to restore volume from snapshot", err)
+			return
+		}
+	} else {
+		zone = r.client.AvailabilityZoneOrDefault(data.AvailabilityZone.ValueString())
+		vol, err = r.client.CreateVolume(ctx, data.Name.ValueString(), int(data.SizeGB.ValueInt64()), zone)
+		if err != nil {
+			apierror.AddClientError(&resp.Diagnostics, "Unable to create volume", err)
+			return
+		}
 	}
 
 	data.ID = types.StringValue(vol.ID)
 	data.Name = types.StringValue(vol.Name)
 	data.SizeGB = types.Int64Value(int64(vol.SizeGB))
+	if vol.AvailabilityZone != "" {
+		data.AvailabilityZone = types.StringValue(vol.AvailabilityZone)
+	} else if data.AvailabilityZone.IsUnknown() {
+		data.AvailabilityZone = types.StringValue(zone)
+	}
 	data.Status = types.StringValue(vol.Status)
 
 	tflog.Trace(ctx, "created a Volume resource")
@@ -129,7 +198,7 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	vol, err := r.client.GetVolume(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read volume, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read volume", err)
 		return
 	}
 
@@ -141,6 +210,10 @@ func (r *VolumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.ID = types.StringValue(vol.ID)
 	data.Name = types.StringValue(vol.Name)
 	data.SizeGB = types.Int64Value(int64(vol.SizeGB))
+	// A zone the API doesn't report keeps the one in state, as changing it replaces the volume
+	if vol.AvailabilityZone != "" {
+		data.AvailabilityZone = types.StringValue(vol.AvailabilityZone)
+	}
 	data.Status = types.StringValue(vol.Status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -161,9 +234,36 @@ func (r *VolumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.client.DeleteVolume(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete volume, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete volume", err)
+		return
+	}
+}
+
+func (r *VolumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var snapshotID types.String
+	var sizeGB types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("snapshot_id"), &snapshotID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("size_gb"), &sizeGB)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Only a volume about to be restored needs its size checked against the snapshot
+	if !req.State.Raw.IsNull() {
+		var stateSnapshotID types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("snapshot_id"), &stateSnapshotID)...)
+		if stateSnapshotID.Equal(snapshotID) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validateRestoreSize(ctx, r.client, snapshotID, sizeGB)...)
 }
 
 func (r *VolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// validateRestoreSize reports an error on the size_gb attribute when a volume restored
+// from a snapshot would be smaller than the snapshotted volume. The check is skipped
+// when either value is not yet known or the snapshot cannot be read.
+func validateRestoreSize(ctx context.Context, c *client.Client, snapshotID types.String, sizeGB types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c == nil || snapshotID.IsNull() || snapshotID.IsUnknown() || sizeGB.IsNull() || sizeGB.IsUnknown() {
+		return diags
+	}
+
+	snapshot, err := c.GetSnapshot(ctx, snapshotID.ValueString())
+	if err != nil || snapshot == nil {
+		tflog.Debug(ctx, "skipping restore size check, snapshot unavailable", map[string]interface{}{"snapshot_id": snapshotID.ValueString()})
+		return diags
+	}
+
+	if err := checkRestoreSize(snapshot, sizeGB.ValueInt64()); err != nil {
+		diags.AddAttributeError(path.Root("size_gb"), "Volume Smaller Than Snapshot", err.Error())
+	}
+
+	return diags
+}
+
+// checkRestoreSize verifies a restored volume is at least as large as the snapshotted
+// volume. Snapshots without a reported size are not checked.
+func checkRestoreSize(snapshot *client.Snapshot, sizeGB int64) error {
+	if snapshot.SizeGB == 0 || sizeGB >= int64(snapshot.SizeGB) {
+		return nil
+	}
+
+	return fmt.Errorf("snapshot %s was taken of a %d GiB volume, so a volume restored from it must be at least %d GiB, got %d GiB",
+		snapshot.ID, snapshot.SizeGB, snapshot.SizeGB, sizeGB)
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRestoreSize(t *testing.T) {
+	snapshot := &client.Snapshot{ID: "snap-1", SizeGB: 20}
+
+	assert.NoError(t, checkRestoreSize(snapshot, 20))
+	assert.NoError(t, checkRestoreSize(snapshot, 50))
+	assert.NoError(t, checkRestoreSize(&client.Snapshot{ID: "snap-1"}, 5))
+
+	err := checkRestoreSize(snapshot, 10)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least 20 GiB")
+}
+
+func TestValidateRestoreSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/snapshots/snap-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(client.Snapshot{ID: "snap-1", SizeGB: 20})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	assert.False(t, validateRestoreSize(ctx, c, types.StringValue("snap-1"), types.Int64Value(30)).HasError())
+	assert.False(t, validateRestoreSize(ctx, c, types.StringValue("snap-1"), types.Int64Unknown()).HasError())
+	assert.False(t, validateRestoreSize(ctx, c, types.StringValue("snap-missing"), types.Int64Value(1)).HasError())
+	assert.False(t, validateRestoreSize(ctx, c, types.StringNull(), types.Int64Value(1)).HasError())
+
+	diags := validateRestoreSize(ctx, c, types.StringValue("snap-1"), types.Int64Value(10))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Volume Smaller Than Snapshot", diags[0].Summary())
+}
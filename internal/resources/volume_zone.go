@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// validateVolumeAttachmentZone is called before attaching a volume so that a zone
+// mismatch is reported with both zone names instead of as an opaque attach failure.
+func validateVolumeAttachmentZone(ctx context.Context, c *client.Client, volumeID, instanceID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vol, err := c.GetVolume(ctx, volumeID)
+	if err != nil {
+		apierror.AddClientError(&diags, "Unable to read volume", err)
+		return diags
+	}
+
+	inst, err := c.GetInstance(ctx, instanceID)
+	if err != nil {
+		apierror.AddClientError(&diags, "Unable to read instance", err)
+		return diags
+	}
+
+	if vol == nil || inst == nil {
+		// Let the attach call report which side is missing.
+		return diags
+	}
+
+	if err := checkVolumeAttachmentZone(vol, inst); err != nil {
+		diags.AddError("Availability Zone Mismatch", err.Error())
+	}
+
+	return diags
+}
+
+// checkVolumeAttachmentZone returns an error when the volume and instance are in
+// different zones. Resources without a reported zone are not checked.
+func checkVolumeAttachmentZone(vol *client.Volume, inst *client.Instance) error {
+	if vol.AvailabilityZone == "" || inst.AvailabilityZone == "" || vol.AvailabilityZone == inst.AvailabilityZone {
+		return nil
+	}
+
+	return fmt.Errorf("volume %s is in availability zone %q but instance %s is in %q; a volume can only be attached to an instance in the same zone",
+		vol.ID, vol.AvailabilityZone, inst.ID, inst.AvailabilityZone)
+}
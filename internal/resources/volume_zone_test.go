@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVolumeAttachmentZone(t *testing.T) {
+	vol := &client.Volume{ID: "vol-1", AvailabilityZone: "zone-a"}
+
+	assert.NoError(t, checkVolumeAttachmentZone(vol, &client.Instance{ID: "inst-1", AvailabilityZone: "zone-a"}))
+	assert.NoError(t, checkVolumeAttachmentZone(vol, &client.Instance{ID: "inst-1"}))
+	assert.NoError(t, checkVolumeAttachmentZone(&client.Volume{ID: "vol-1"}, &client.Instance{ID: "inst-1", AvailabilityZone: "zone-b"}))
+
+	err := checkVolumeAttachmentZone(vol, &client.Instance{ID: "inst-1", AvailabilityZone: "zone-b"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"zone-a"`)
+	assert.Contains(t, err.Error(), `"zone-b"`)
+}
+
+func TestValidateVolumeAttachmentZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data interface{}
+		switch r.URL.Path {
+		case "/volumes/vol-1":
+			data = client.Volume{ID: "vol-1", AvailabilityZone: "zone-a"}
+		case "/instances/inst-a":
+			data = client.Instance{ID: "inst-a", AvailabilityZone: "zone-a"}
+		case "/instances/inst-b":
+			data = client.Instance{ID: "inst-b", AvailabilityZone: "zone-b"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(data)
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key")
+	ctx := context.Background()
+
+	assert.False(t, validateVolumeAttachmentZone(ctx, c, "vol-1", "inst-a").HasError())
+	assert.False(t, validateVolumeAttachmentZone(ctx, c, "vol-1", "inst-missing").HasError())
+
+	diags := validateVolumeAttachmentZone(ctx, c, "vol-1", "inst-b")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Availability Zone Mismatch", diags[0].Summary())
+}
@@ -2,15 +2,20 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
 	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
 )
 
@@ -29,10 +34,14 @@ type VpcResource struct {
 
 // VpcResourceModel describes the resource data model.
 type VpcResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	CIDRBlock types.String `tfsdk:"cidr_block"`
-	Status    types.String `tfsdk:"status"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	CIDRBlock types.String   `tfsdk:"cidr_block"`
+	Status    types.String   `tfsdk:"status"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+
+	DefaultSecurityGroupID types.String `tfsdk:"default_security_group_id"`
+	DefaultSubnetID        types.String `tfsdk:"default_subnet_id"`
 }
 
 func (r *VpcResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,11 +71,31 @@ func (r *VpcResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					cidrValidator{},
+				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the VPC.",
 			},
+			"default_security_group_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the security group the platform created along with the VPC. Manage its rules with `thecloud_default_security_group` instead of creating a second group for the same purpose.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_subnet_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the subnet the platform created along with the VPC.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -101,7 +130,7 @@ func (r *VpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	vpc, err := r.client.CreateVPC(ctx, data.Name.ValueString(), data.CIDRBlock.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to create VPC, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create VPC", err)
 		return
 	}
 
@@ -109,6 +138,10 @@ func (r *VpcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	data.Name = types.StringValue(vpc.Name)
 	data.CIDRBlock = types.StringValue(vpc.CIDRBlock)
 	data.Status = types.StringValue(vpc.Status)
+	data.DefaultSecurityGroupID = types.StringValue(vpc.DefaultSecurityGroupID)
+	data.DefaultSubnetID = types.StringValue(vpc.DefaultSubnetID)
+
+	resp.Diagnostics.Append(setPrivateCreatedAt(ctx, resp.Private)...)
 
 	tflog.Trace(ctx, "created a VPC resource")
 
@@ -124,9 +157,11 @@ func (r *VpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	vpc, err := r.client.GetVPC(ctx, data.ID.ValueString())
+	vpc, err := readCreated(ctx, r.client, req.Private, func(ctx context.Context) (*client.VPC, error) {
+		return r.client.GetVPC(ctx, data.ID.ValueString())
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to read VPC, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read VPC", err)
 		return
 	}
 
@@ -139,6 +174,8 @@ func (r *VpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.Name = types.StringValue(vpc.Name)
 	data.CIDRBlock = types.StringValue(vpc.CIDRBlock)
 	data.Status = types.StringValue(vpc.Status)
+	data.DefaultSecurityGroupID = types.StringValue(vpc.DefaultSecurityGroupID)
+	data.DefaultSubnetID = types.StringValue(vpc.DefaultSubnetID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -158,11 +195,39 @@ func (r *VpcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteVPC(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(errClient, fmt.Sprintf("Unable to delete VPC, got error: %s", err))
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete VPC", err)
+		return
+	}
+
+	// Wait for VPC to be gone from API (dependencies are torn down asynchronously)
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		vpc, err := r.client.GetVPC(ctx, data.ID.ValueString())
+		if err != nil || vpc == nil {
+			return "", vpc == nil, err
+		}
+		return vpc.Status, false, nil
+	}, nil, nil, client.WaitOpts{Timeout: deleteTimeout, MinInterval: 5 * time.Second, MaxInterval: 5 * time.Second})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for VPC %s to be deleted. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking VPC status", err)
 		return
 	}
+
+	tflog.Trace(ctx, "VPC successfully deleted")
 }
 
 func (r *VpcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
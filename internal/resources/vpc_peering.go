@@ -0,0 +1,275 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &VPCPeeringResource{}
+var _ resource.ResourceWithImportState = &VPCPeeringResource{}
+
+func NewVPCPeeringResource() resource.Resource {
+	return &VPCPeeringResource{}
+}
+
+// VPCPeeringResource defines the resource implementation.
+type VPCPeeringResource struct {
+	client *client.Client
+}
+
+// VPCPeeringResourceModel describes the resource data model.
+type VPCPeeringResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	VpcID      types.String   `tfsdk:"vpc_id"`
+	PeerVpcID  types.String   `tfsdk:"peer_vpc_id"`
+	AutoAccept types.Bool     `tfsdk:"auto_accept"`
+	Status     types.String   `tfsdk:"status"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *VPCPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering"
+}
+
+func (r *VPCPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "VPC Peering resource requests a peering connection between two VPCs. " +
+			"Unless `auto_accept` is set, the connection waits in `pending-acceptance` until a `thecloud_vpc_peering_accepter` accepts it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the peering connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the VPC requesting the peering connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_vpc_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the VPC to peer with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_accept": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to accept the peering connection as soon as it is requested. Defaults to `false`. Setting it to `true` on a connection that is still pending acceptance accepts it.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the peering connection, e.g. `pending-acceptance` or `active`.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *VPCPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPCPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultVPCPeeringTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := r.client.CreateVPCPeering(ctx, data.VpcID.ValueString(), data.PeerVpcID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to create VPC peering", err)
+		return
+	}
+
+	data.ID = types.StringValue(peering.ID)
+	data.setVPCPeering(peering)
+
+	var current *client.VPCPeering
+	if data.AutoAccept.ValueBool() {
+		current, diags = acceptVPCPeering(ctx, r.client, peering.ID, "Create Timeout", createTimeout)
+	} else {
+		// The peer side may have accepted the connection already
+		current, diags = waitForVPCPeering(ctx, r.client, peering.ID, []string{vpcPeeringStatusPendingAcceptance, vpcPeeringStatusActive}, "Create Timeout", createTimeout)
+	}
+	resp.Diagnostics.Append(diags...)
+
+	if current != nil {
+		data.setVPCPeering(current)
+	}
+
+	// The connection is saved even when the wait failed, so it's tainted rather than
+	// left untracked
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a VPC Peering resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPCPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := r.client.GetVPCPeering(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read VPC peering", err)
+		return
+	}
+
+	if peering == nil || vpcPeeringGone(peering.Status) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(peering.ID)
+	data.setVPCPeering(peering)
+	if data.AutoAccept.IsNull() {
+		data.AutoAccept = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPCPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultVPCPeeringTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted connection cannot be taken back, so only a switch to true does anything
+	if plan.AutoAccept.ValueBool() && strings.EqualFold(state.Status.ValueString(), vpcPeeringStatusPendingAcceptance) {
+		peering, diags := acceptVPCPeering(ctx, r.client, state.ID.ValueString(), "Update Timeout", updateTimeout)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.setVPCPeering(peering)
+	}
+
+	state.AutoAccept = plan.AutoAccept
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VPCPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultVPCPeeringTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVPCPeering(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to delete VPC peering", err)
+		return
+	}
+
+	_, err = client.WaitForState(ctx, func() (string, bool, error) {
+		peering, err := r.client.GetVPCPeering(ctx, data.ID.ValueString())
+		if err != nil || peering == nil {
+			return "", peering == nil, err
+		}
+		return peering.Status, vpcPeeringGone(peering.Status), nil
+	}, nil, nil, client.WaitOpts{Timeout: deleteTimeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		resp.Diagnostics.AddError("Delete Timeout", fmt.Sprintf("Timed out waiting for VPC peering %s to be deleted. Last observed status: %q.", data.ID.ValueString(), timeoutErr.LastStatus))
+		return
+	}
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Error checking VPC peering status", err)
+		return
+	}
+
+	tflog.Trace(ctx, "VPC peering successfully deleted")
+}
+
+func (r *VPCPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *VPCPeeringResourceModel) setVPCPeering(peering *client.VPCPeering) {
+	m.VpcID = types.StringValue(peering.VpcID)
+	m.PeerVpcID = types.StringValue(peering.PeerVpcID)
+	m.Status = types.StringValue(peering.Status)
+}
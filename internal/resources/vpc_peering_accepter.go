@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+// Ensure implementation of interfaces
+var _ resource.Resource = &VPCPeeringAccepterResource{}
+var _ resource.ResourceWithImportState = &VPCPeeringAccepterResource{}
+
+func NewVPCPeeringAccepterResource() resource.Resource {
+	return &VPCPeeringAccepterResource{}
+}
+
+// VPCPeeringAccepterResource defines the resource implementation.
+type VPCPeeringAccepterResource struct {
+	client *client.Client
+}
+
+// VPCPeeringAccepterResourceModel describes the resource data model.
+type VPCPeeringAccepterResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	VPCPeeringID types.String   `tfsdk:"vpc_peering_id"`
+	VpcID        types.String   `tfsdk:"vpc_id"`
+	PeerVpcID    types.String   `tfsdk:"peer_vpc_id"`
+	Status       types.String   `tfsdk:"status"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *VPCPeeringAccepterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering_accepter"
+}
+
+func (r *VPCPeeringAccepterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "VPC Peering Accepter resource accepts a peering connection requested by a `thecloud_vpc_peering` resource, " +
+			"typically from the configuration that owns the peer VPC. Destroying the accepter only removes it from the Terraform state; " +
+			"the peering connection stays active until the `thecloud_vpc_peering` resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the peering connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_peering_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the peering connection to accept.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the VPC that requested the peering connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_vpc_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the accepting VPC.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the peering connection.",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *VPCPeeringAccepterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Data Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPCPeeringAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPCPeeringAccepterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultVPCPeeringTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.VPCPeeringID.ValueString()
+
+	peering, err := r.client.GetVPCPeering(ctx, id)
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read VPC peering", err)
+		return
+	}
+
+	if peering == nil || vpcPeeringGone(peering.Status) {
+		resp.Diagnostics.AddAttributeError(path.Root("vpc_peering_id"), "VPC Peering Not Found", fmt.Sprintf("VPC peering %s does not exist or has been rejected.", id))
+		return
+	}
+
+	// A connection requested with auto_accept, or accepted before, needs no further action
+	if strings.EqualFold(peering.Status, vpcPeeringStatusPendingAcceptance) {
+		peering, diags = acceptVPCPeering(ctx, r.client, id, "Create Timeout", createTimeout)
+	} else {
+		peering, diags = waitForVPCPeering(ctx, r.client, id, []string{vpcPeeringStatusActive}, "Create Timeout", createTimeout)
+	}
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(peering.ID)
+	data.setVPCPeering(peering)
+
+	tflog.Trace(ctx, "created a VPC Peering Accepter resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPCPeeringAccepterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPCPeeringAccepterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := r.client.GetVPCPeering(ctx, data.ID.ValueString())
+	if err != nil {
+		apierror.AddClientError(&resp.Diagnostics, "Unable to read VPC peering", err)
+		return
+	}
+
+	if peering == nil || vpcPeeringGone(peering.Status) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(peering.ID)
+	data.VPCPeeringID = types.StringValue(peering.ID)
+	data.setVPCPeering(peering)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPCPeeringAccepterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddWarning("Update Not Supported", "Updating a VPC peering accepter is not supported. It will be recreated if changed.")
+}
+
+// Delete removes the accepter from the state. An accepted connection cannot be taken back,
+// so the peering connection itself is left to the thecloud_vpc_peering resource.
+func (r *VPCPeeringAccepterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed VPC Peering Accepter resource from state, the peering connection was left in place")
+}
+
+func (r *VPCPeeringAccepterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *VPCPeeringAccepterResourceModel) setVPCPeering(peering *client.VPCPeering) {
+	m.VpcID = types.StringValue(peering.VpcID)
+	m.PeerVpcID = types.StringValue(peering.PeerVpcID)
+	m.Status = types.StringValue(peering.Status)
+}
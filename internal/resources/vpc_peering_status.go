@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/apierror"
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+)
+
+const (
+	vpcPeeringStatusActive            = "active"
+	vpcPeeringStatusPendingAcceptance = "pending-acceptance"
+
+	defaultVPCPeeringTimeout = 10 * time.Minute
+)
+
+// vpcPeeringGone reports whether a peering connection in status no longer exists as far as
+// Terraform is concerned. Rejected and deleted connections are kept around by the API for a
+// while but can never become active again.
+func vpcPeeringGone(status string) bool {
+	return strings.EqualFold(status, "rejected") || strings.EqualFold(status, "deleted")
+}
+
+// acceptVPCPeering accepts a peering connection and waits until it is active.
+func acceptVPCPeering(ctx context.Context, c *client.Client, id, timeoutSummary string, timeout time.Duration) (*client.VPCPeering, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := c.AcceptVPCPeering(ctx, id); err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("Unable to accept VPC peering %s", id), err)
+		return nil, diags
+	}
+
+	return waitForVPCPeering(ctx, c, id, []string{vpcPeeringStatusActive}, timeoutSummary, timeout)
+}
+
+// waitForVPCPeering waits until a peering connection reaches one of targets. The
+// connection as last observed is returned, also with an error, and is nil if it was never
+// observed. timeoutSummary names the operation in the diagnostic reported when the wait
+// times out.
+func waitForVPCPeering(ctx context.Context, c *client.Client, id string, targets []string, timeoutSummary string, timeout time.Duration) (*client.VPCPeering, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pending := []string{"initiating-request", "pending", "provisioning", "accepting"}
+	if !client.ContainsStatus(targets, vpcPeeringStatusPendingAcceptance) {
+		// The status can lag behind an accept for a moment
+		pending = append(pending, vpcPeeringStatusPendingAcceptance)
+	}
+	target := strings.Join(targets, " or ")
+
+	var peering *client.VPCPeering
+	_, err := client.WaitForState(ctx, func() (string, bool, error) {
+		current, err := c.GetVPCPeering(ctx, id)
+		if err != nil || current == nil {
+			return "", current == nil, err
+		}
+		peering = current
+		return current.Status, false, nil
+	}, targets, pending, client.WaitOpts{Timeout: timeout})
+
+	var timeoutErr *client.WaitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(timeoutSummary, fmt.Sprintf("Timed out waiting for VPC peering %s to become %s. Last observed status: %q.", id, target, timeoutErr.LastStatus))
+		return peering, diags
+	}
+	if err != nil {
+		apierror.AddClientError(&diags, fmt.Sprintf("VPC peering %s did not become %s", id, target), err)
+		return peering, diags
+	}
+
+	return peering, diags
+}
@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/poyrazk/terraform-provider-thecloud/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPCPeeringGone(t *testing.T) {
+	assert.True(t, vpcPeeringGone("rejected"))
+	assert.True(t, vpcPeeringGone("DELETED"))
+	assert.False(t, vpcPeeringGone("pending-acceptance"))
+	assert.False(t, vpcPeeringGone("active"))
+}
+
+func TestAcceptVPCPeering(t *testing.T) {
+	statuses := map[string]string{
+		"pcx-ok":       vpcPeeringStatusPendingAcceptance,
+		"pcx-rejected": vpcPeeringStatusPendingAcceptance,
+	}
+	afterAccept := map[string]string{
+		"pcx-ok":       vpcPeeringStatusActive,
+		"pcx-rejected": "rejected",
+	}
+	var accepted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/vpc-peerings/"), "/")[0]
+		if r.Method == http.MethodPost {
+			accepted = append(accepted, id)
+			statuses[id] = afterAccept[id]
+		}
+
+		raw, err := json.Marshal(client.VPCPeering{ID: id, VpcID: "vpc-a", PeerVpcID: "vpc-b", Status: statuses[id]})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+	ctx := context.Background()
+
+	peering, diags := acceptVPCPeering(ctx, c, "pcx-ok", "Create Timeout", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, vpcPeeringStatusActive, peering.Status)
+
+	_, diags = acceptVPCPeering(ctx, c, "pcx-rejected", "Create Timeout", time.Minute)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "rejected")
+
+	assert.Equal(t, []string{"pcx-ok", "pcx-rejected"}, accepted)
+}
+
+func TestWaitForVPCPeeringTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := json.Marshal(client.VPCPeering{ID: "pcx-1", Status: "provisioning"})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+
+	peering, diags := waitForVPCPeering(context.Background(), c, "pcx-1", []string{vpcPeeringStatusPendingAcceptance}, "Create Timeout", time.Millisecond)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Create Timeout", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "provisioning")
+	assert.Equal(t, "pcx-1", peering.ID)
+}
+
+func TestWaitForVPCPeeringAlreadyActive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := json.Marshal(client.VPCPeering{ID: "pcx-1", Status: vpcPeeringStatusActive})
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(client.APIResponse{Data: raw}))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-key", client.WithRetryMax(0))
+
+	peering, diags := waitForVPCPeering(context.Background(), c, "pcx-1", []string{vpcPeeringStatusPendingAcceptance, vpcPeeringStatusActive}, "Create Timeout", time.Minute)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, vpcPeeringStatusActive, peering.Status)
+}
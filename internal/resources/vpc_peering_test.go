@@ -0,0 +1,68 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const vpcPeeringResourceName = "thecloud_vpc_peering.test"
+
+func testAccVPCPeeringConfig(rName string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "thecloud_vpc" "requester" {
+  name       = "peer-a-%[1]s"
+  cidr_block = "10.10.0.0/16"
+}
+
+resource "thecloud_vpc" "accepter" {
+  name       = "peer-b-%[1]s"
+  cidr_block = "10.20.0.0/16"
+}
+
+resource "thecloud_vpc_peering" "test" {
+  vpc_id      = thecloud_vpc.requester.id
+  peer_vpc_id = thecloud_vpc.accepter.id
+}
+`, rName)
+}
+
+func TestAccVPCPeeringResource(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPCPeeringConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vpcPeeringResourceName, "status", "pending-acceptance"),
+					resource.TestCheckResourceAttr(vpcPeeringResourceName, "auto_accept", "false"),
+					resource.TestCheckResourceAttrPair(vpcPeeringResourceName, "peer_vpc_id", "thecloud_vpc.accepter", "id"),
+					resource.TestCheckResourceAttrSet(vpcPeeringResourceName, "id"),
+				),
+			},
+			// Accepting from the peer side
+			{
+				Config: testAccVPCPeeringConfig(rName) + `
+resource "thecloud_vpc_peering_accepter" "test" {
+  vpc_peering_id = thecloud_vpc_peering.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("thecloud_vpc_peering_accepter.test", "status", "active"),
+					resource.TestCheckResourceAttrPair("thecloud_vpc_peering_accepter.test", "vpc_id", "thecloud_vpc.requester", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      vpcPeeringResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+// Package validators contains attribute validators shared by the provider's resources
+// and data sources.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Int64 = int64AtLeastValidator{}
+
+// Int64AtLeast checks that an integer is not below min.
+func Int64AtLeast(min int64) validator.Int64 {
+	return int64AtLeastValidator{min: min}
+}
+
+// int64AtLeastValidator checks that an integer is not below a minimum.
+type int64AtLeastValidator struct {
+	min int64
+}
+
+func (v int64AtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+func (v int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if n := req.ConfigValue.ValueInt64(); n < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Expected a value of at least %d, got %d.", v.min, n),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// Duration checks that a string is a positive Go duration such as 15m.
+func Duration() validator.String {
+	return durationValidator{}
+}
+
+// durationValidator checks that a string is a positive Go duration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 15m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as 15m or 1h30m, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInt64AtLeast(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.Int64) bool {
+		resp := &validator.Int64Response{}
+		Int64AtLeast(1).ValidateInt64(ctx, validator.Int64Request{Path: path.Root("expiration_days"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.Int64Value(1)))
+	assert.False(t, validate(types.Int64Value(365)))
+	assert.False(t, validate(types.Int64Null()))
+	assert.False(t, validate(types.Int64Unknown()))
+	assert.True(t, validate(types.Int64Value(0)))
+	assert.True(t, validate(types.Int64Value(-1)))
+}
+
+func TestDuration(t *testing.T) {
+	ctx := context.Background()
+
+	validate := func(value types.String) bool {
+		resp := &validator.StringResponse{}
+		Duration().ValidateString(ctx, validator.StringRequest{Path: path.Root("since"), ConfigValue: value}, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	assert.False(t, validate(types.StringValue("15m")))
+	assert.False(t, validate(types.StringValue("1h30m")))
+	assert.False(t, validate(types.StringNull()))
+	assert.False(t, validate(types.StringUnknown()))
+	assert.True(t, validate(types.StringValue("0s")))
+	assert.True(t, validate(types.StringValue("-5m")))
+	assert.True(t, validate(types.StringValue("15")))
+}
@@ -13,6 +13,11 @@ import (
 // https://github.com/hashicorp/terraform-plugin-docs
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-name thecloud
 
+var (
+	// version is set by goreleaser at release time, see .goreleaser.yml
+	version string = "dev"
+)
+
 func main() {
 	var debug bool
 
@@ -24,7 +29,7 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New("dev"), opts)
+	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
 	if err != nil {
 		log.Fatal(err.Error())